@@ -39,32 +39,55 @@ function conformance(req: conf.ConformanceRequest): conf.ConformanceResponse {
       return resp;
   }
 
-  switch (req.payload.kind) {
-    case conf.ConformanceRequest.payload.protobuf_payload.kind:
-      break;
-    default:
-      resp.result = new conf.ConformanceResponse.result.skipped(
-        "unsupported payload type"
-      );
-      return resp;
-  }
-
-  if (req.requested_output_format != conf.WireFormat.PROTOBUF) {
-    resp.result = new conf.ConformanceResponse.result.skipped(
-      "unsupported output format"
-    );
+  let m = new tm3.TestAllTypesProto3();
+  try {
+    switch (req.payload.kind) {
+      case conf.ConformanceRequest.payload.protobuf_payload.kind:
+        pb.Unmarshal(
+          (req.payload as conf.ConformanceRequest.payload.protobuf_payload)
+            .value,
+          m
+        );
+        break;
+      case conf.ConformanceRequest.payload.json_payload.kind:
+        pb.UnmarshalJSON(
+          (req.payload as conf.ConformanceRequest.payload.json_payload).value,
+          m
+        );
+        break;
+      default:
+        resp.result = new conf.ConformanceResponse.result.skipped(
+          "unsupported payload type"
+        );
+        return resp;
+    }
+  } catch (e) {
+    resp.result = new conf.ConformanceResponse.result.parse_error(`${e}`);
+    log("parse error:" + e);
     return resp;
   }
+  log("after unmarshal:", m);
 
-  let m = new tm3.TestAllTypesProto3();
   try {
-    let payload = req.payload as conf.ConformanceRequest.payload.protobuf_payload;
-    resp.result = new conf.ConformanceResponse.result.protobuf_payload(
-      remarshal(m, payload.value)
-    );
+    switch (req.requested_output_format) {
+      case conf.WireFormat.PROTOBUF:
+        resp.result = new conf.ConformanceResponse.result.protobuf_payload(
+          pb.Marshal(m)
+        );
+        break;
+      case conf.WireFormat.JSON:
+        resp.result = new conf.ConformanceResponse.result.json_payload(
+          pb.MarshalJSON(m)
+        );
+        break;
+      default:
+        resp.result = new conf.ConformanceResponse.result.skipped(
+          "unsupported output format"
+        );
+    }
   } catch (e) {
-    resp.result = new conf.ConformanceResponse.result.parse_error(e);
-    log("parse error:" + e);
+    resp.result = new conf.ConformanceResponse.result.serialize_error(`${e}`);
+    log("serialize error:" + e);
   }
   return resp;
 }
@@ -1,5 +1,6 @@
 import * as Long from "long";
 import { fromBits as LongFromBits } from "long";
+import { fromString as LongFromString } from "long";
 
 export class ProtobufError extends Error {
   constructor(message: string) {
@@ -10,76 +11,2612 @@ export class ProtobufError extends Error {
 export interface Message {
   MergeFrom(d: Internal.Decoder): void;
   WriteTo(e: Internal.Encoder): void;
+  MergeFromJSON(j: JsonValue, o?: JsonOptions): void;
+  ToJSON(o?: JsonOptions): JsonValue;
+}
+
+// MessageClass is the class of a message. Generated classes record the fully
+// qualified name of their proto type in typeName, and describe their fields
+// in fields.
+export interface MessageClass<T extends Message = Message> {
+  new (): T;
+  readonly typeName: string;
+  readonly fields?: FieldInfo[];
+}
+
+// typeNameOf returns the fully qualified name of the proto type of m.
+export function typeNameOf(m: Message): string {
+  let name = (m.constructor as MessageClass).typeName;
+  if (name === undefined) {
+    throw new ProtobufError("message class has no typeName");
+  }
+  return name;
+}
+
+// TypeRegistry finds message classes and enums by their fully qualified
+// name, with or without a leading ".", e.g. to resolve the types packed in
+// google.protobuf.Any, and embedded file descriptors by their file name.
+export class TypeRegistry {
+  private types = new Map<string, MessageClass>();
+  private enums = new Map<string, EnumInfo>();
+  private files = new Map<string, FileDescriptor>();
+
+  constructor(...classes: MessageClass[]) {
+    for (let cls of classes) {
+      this.add(cls);
+    }
+  }
+
+  add(cls: MessageClass): void {
+    this.types.set(cls.typeName, cls);
+  }
+
+  addEnum(e: EnumInfo): void {
+    this.enums.set(e.typeName, e);
+  }
+
+  addFile(f: FileDescriptor): void {
+    this.files.set(f.name, f);
+  }
+
+  findMessage(typeName: string): MessageClass | undefined {
+    return this.types.get(typeName.replace(/^\./, ""));
+  }
+
+  findEnum(typeName: string): EnumInfo | undefined {
+    return this.enums.get(typeName.replace(/^\./, ""));
+  }
+
+  findFile(name: string): FileDescriptor | undefined {
+    return this.files.get(name);
+  }
+}
+
+// globalRegistry holds every generated message, enum and embedded file
+// descriptor, which register themselves when their module is loaded.
+export const globalRegistry = new TypeRegistry();
+
+// A value which JSON.stringify can represent, as produced by ToJSON.
+export type JsonValue =
+  | null
+  | boolean
+  | number
+  | string
+  | JsonValue[]
+  | JsonObject;
+
+export interface JsonObject {
+  [k: string]: JsonValue;
+}
+
+// FieldType is the type of a field, numbered as in
+// google.protobuf.FieldDescriptorProto.Type.
+export enum FieldType {
+  DOUBLE = 1,
+  FLOAT = 2,
+  INT64 = 3,
+  UINT64 = 4,
+  INT32 = 5,
+  FIXED64 = 6,
+  FIXED32 = 7,
+  BOOL = 8,
+  STRING = 9,
+  GROUP = 10,
+  MESSAGE = 11,
+  BYTES = 12,
+  UINT32 = 13,
+  ENUM = 14,
+  SFIXED32 = 15,
+  SFIXED64 = 16,
+  SINT32 = 17,
+  SINT64 = 18,
+}
+
+export enum FieldLabel {
+  OPTIONAL = 1,
+  REQUIRED = 2,
+  REPEATED = 3,
+}
+
+// FieldInfo describes a field of a generated message class.
+export interface FieldInfo {
+  // The field's name in the .proto file.
+  readonly name: string;
+  readonly number: number;
+  readonly type: FieldType;
+  readonly label: FieldLabel;
+  readonly jsonName: string;
+  // The class member holding the field's value. For members of a oneof, it
+  // is the oneof's member.
+  readonly member: string;
+  // The name of the oneof the field is a member of, and the class wrapping
+  // its value in the oneof.
+  readonly oneof?: string;
+  readonly oneofCase?: () => OneofCaseClass;
+  // The key and value types of a map field, which is a repeated message.
+  readonly map?: { key: FieldType; value: FieldType };
+  // The class of a message field, or of a map field's values. Well known
+  // types generated as library types reference the library class.
+  readonly messageType?: () => MessageClass;
+  // The enum of an enum field, or of a map field's values.
+  readonly enumType?: () => EnumInfo;
+}
+
+export interface OneofCaseClass {
+  new (value: any): { readonly kind: number; value: any };
+}
+
+// EnumInfo describes a generated enum, which is exported as <Enum>Info.
+export interface EnumInfo {
+  readonly typeName: string;
+  readonly values: { name: string; number: number }[];
+}
+
+// fieldsOf returns the fields of a generated message.
+export function fieldsOf(m: Message): FieldInfo[] {
+  let fields = (m.constructor as MessageClass).fields;
+  if (fields === undefined) {
+    throw new ProtobufError(`${typeNameOf(m)} has no field table`);
+  }
+  return fields;
+}
+
+// findField finds a field of m by its number, its name in the .proto file or
+// its JSON name.
+export function findField(
+  m: Message,
+  field: number | string
+): FieldInfo | undefined {
+  for (let f of fieldsOf(m)) {
+    if (f.number === field || f.name === field || f.jsonName === field) {
+      return f;
+    }
+  }
+  return undefined;
+}
+
+function mustFindField(m: Message, field: number | string): FieldInfo {
+  let f = findField(m, field);
+  if (f === undefined) {
+    throw new ProtobufError(`${typeNameOf(m)} has no field ${field}`);
+  }
+  return f;
+}
+
+// getField returns the value of a field of m, or undefined for a member of a
+// oneof which is not set.
+export function getField(m: Message, field: number | string): any {
+  let f = mustFindField(m, field);
+  let v = (m as any)[f.member];
+  if (f.oneofCase !== undefined) {
+    return v.kind == f.number ? v.value : undefined;
+  }
+  return v;
+}
+
+// setField sets the value of a field of m. Setting a member of a oneof sets
+// the oneof to that member.
+export function setField(
+  m: Message,
+  field: number | string,
+  value: any
+): void {
+  let f = mustFindField(m, field);
+  if (f.oneofCase !== undefined) {
+    value = new (f.oneofCase())(value);
+  }
+  (m as any)[f.member] = value;
+}
+
+// FileDescriptor is the google.protobuf.FileDescriptorProto of a generated
+// file, which is embedded in its module, and the descriptors of the files it
+// imports. Imports whose modules aren't loaded by the file, such as
+// google/api/annotations.proto when only its options are used, are referenced
+// by name and found in a TypeRegistry when needed.
+export class FileDescriptor {
+  readonly name: string;
+  // dependencyNames are the names of the files this file imports.
+  readonly dependencyNames: string[];
+  private encoded: string;
+  private bytes: Uint8Array | undefined;
+  private deps: (FileDescriptor | string)[];
+
+  constructor(
+    name: string,
+    encoded: string,
+    dependencies: (FileDescriptor | string)[]
+  ) {
+    this.name = name;
+    this.encoded = encoded;
+    this.deps = dependencies;
+    this.dependencyNames = dependencies.map(d =>
+      typeof d == "string" ? d : d.name
+    );
+  }
+
+  // serialized returns the encoded FileDescriptorProto.
+  serialized(): Uint8Array {
+    if (this.bytes === undefined) {
+      this.bytes = Internal.bytesFromJSON(this.encoded);
+    }
+    return this.bytes;
+  }
+
+  // dependencies returns the descriptors of the files this file imports.
+  // Those referenced by name must have been registered, by default in
+  // globalRegistry.
+  dependencies(registry: TypeRegistry = globalRegistry): FileDescriptor[] {
+    return this.deps.map(d => {
+      if (typeof d != "string") {
+        return d;
+      }
+      let f = registry.findFile(d);
+      if (f === undefined) {
+        throw new ProtobufError(
+          `${this.name} imports ${d}, which has no registered descriptor`
+        );
+      }
+      return f;
+    });
+  }
+
+  // transitiveDependencies returns this file and every file it imports,
+  // directly or indirectly, with each file after those it imports.
+  transitiveDependencies(
+    registry: TypeRegistry = globalRegistry
+  ): FileDescriptor[] {
+    let files: FileDescriptor[] = [];
+    let seen = new Set<string>();
+    let visit = (f: FileDescriptor) => {
+      if (seen.has(f.name)) {
+        return;
+      }
+      seen.add(f.name);
+      f.dependencies(registry).forEach(visit);
+      files.push(f);
+    };
+    visit(this);
+    return files;
+  }
+}
+
+// Options for the proto3 JSON mapping.
+export interface JsonOptions {
+  // Emit fields which have their default value, rather than omitting them.
+  emitDefaults?: boolean;
+  // Use the field names from the .proto file instead of their lowerCamelCase
+  // json_name.
+  useProtoNames?: boolean;
+  // Ignore unknown fields and enum value names instead of failing.
+  ignoreUnknown?: boolean;
+  // Resolves the types of google.protobuf.Any values, instead of
+  // globalRegistry.
+  typeRegistry?: TypeRegistry;
 }
 
 export function Unmarshal(raw: Uint8Array, m: Message): void {
   m.MergeFrom(new Internal.Decoder(raw));
 }
 
-export function Marshal(m: Message): Uint8Array {
-  let e = new Internal.Encoder();
-  m.WriteTo(e);
-  return e.buffer();
-}
+export function Marshal(m: Message): Uint8Array {
+  let e = new Internal.Encoder();
+  m.WriteTo(e);
+  return e.buffer();
+}
+
+export function UnmarshalJSON(
+  json: string,
+  m: Message,
+  o?: JsonOptions
+): void {
+  let j: JsonValue;
+  try {
+    j = JSON.parse(json);
+  } catch (e) {
+    throw new ProtobufError(`invalid JSON: ${e}`);
+  }
+  m.MergeFromJSON(j, o);
+}
+
+export function MarshalJSON(m: Message, o?: JsonOptions): string {
+  return JSON.stringify(m.ToJSON(o));
+}
+
+export class OneofNotSet {
+  static readonly singleton = new OneofNotSet();
+  static readonly kind = 0;
+  readonly kind = 0;
+  private constructor() {}
+}
+
+// Timestamp is google.protobuf.Timestamp, a point in time with nanosecond
+// precision. It is encoded identically to the generated class, and is used in
+// its place by the wkt_timestamp=helper and wkt_timestamp=date options.
+export class Timestamp implements Message {
+  static readonly typeName = "google.protobuf.Timestamp";
+
+  seconds: Long;
+  nanos: number;
+
+  constructor(seconds: Long = Long.ZERO, nanos: number = 0) {
+    this.seconds = seconds;
+    this.nanos = nanos;
+  }
+
+  static fromDate(d: Date): Timestamp {
+    let ms = d.getTime();
+    let s = Math.floor(ms / 1000);
+    return new Timestamp(Long.fromNumber(s), (ms - s * 1000) * 1000000);
+  }
+
+  static now(): Timestamp {
+    return Timestamp.fromDate(new Date());
+  }
+
+  // The result is truncated to millisecond precision.
+  toDate(): Date {
+    return new Date(
+      this.seconds.toNumber() * 1000 + Math.floor(this.nanos / 1000000)
+    );
+  }
+
+  MergeFrom(d: Internal.Decoder): void {
+    [this.seconds, this.nanos] = Internal.readSecondsNanos(
+      d,
+      this.seconds,
+      this.nanos
+    );
+  }
+
+  WriteTo(e: Internal.Encoder): void {
+    Internal.writeSecondsNanos(e, this.seconds, this.nanos);
+  }
+
+  // Timestamps are RFC 3339 strings in JSON, e.g. "1972-01-01T10:00:20.021Z".
+  MergeFromJSON(j: JsonValue, _o?: JsonOptions): void {
+    let m = /^(\d{4})-(\d{2})-(\d{2})T(\d{2}):(\d{2}):(\d{2})(?:\.(\d{1,9}))?(?:Z|([+-])(\d{2}):(\d{2}))$/.exec(
+      Internal.stringFromJSON(j)
+    );
+    if (m == null) {
+      throw new ProtobufError(`invalid timestamp: ${j}`);
+    }
+    let d = new Date(0);
+    d.setUTCFullYear(+m[1], +m[2] - 1, +m[3]);
+    d.setUTCHours(+m[4], +m[5], +m[6]);
+    let s = d.getTime() / 1000;
+    if (m[8] !== undefined) {
+      let offset = (+m[9] * 60 + +m[10]) * 60;
+      s += m[8] == "+" ? -offset : offset;
+    }
+    if (s < minTimestampSeconds || s > maxTimestampSeconds) {
+      throw new ProtobufError(`timestamp out of range: ${j}`);
+    }
+    this.seconds = Long.fromNumber(s);
+    this.nanos = Internal.nanosFromJSON(m[7]);
+  }
+
+  ToJSON(_o?: JsonOptions): JsonValue {
+    let s = this.seconds.toNumber();
+    if (s < minTimestampSeconds || s > maxTimestampSeconds) {
+      throw new ProtobufError(`timestamp out of range: ${s}`);
+    }
+    let iso = new Date(s * 1000).toISOString().slice(0, 19);
+    return iso + Internal.nanosToJSON(this.nanos) + "Z";
+  }
+}
+
+// The range of valid timestamps, 0001-01-01T00:00:00Z to
+// 9999-12-31T23:59:59Z.
+const minTimestampSeconds = -62135596800;
+const maxTimestampSeconds = 253402300799;
+
+// Duration is google.protobuf.Duration, a signed span of time with nanosecond
+// precision. It is encoded identically to the generated class, and is used in
+// its place by the wkt_duration=helper option.
+export class Duration implements Message {
+  static readonly typeName = "google.protobuf.Duration";
+
+  seconds: Long;
+  nanos: number;
+
+  constructor(seconds: Long = Long.ZERO, nanos: number = 0) {
+    this.seconds = seconds;
+    this.nanos = nanos;
+  }
+
+  static fromMillis(ms: number): Duration {
+    let s = Math.trunc(ms / 1000);
+    return new Duration(
+      Long.fromNumber(s),
+      Math.round((ms - s * 1000) * 1000000)
+    );
+  }
+
+  toMillis(): number {
+    return this.seconds.toNumber() * 1000 + this.nanos / 1000000;
+  }
+
+  MergeFrom(d: Internal.Decoder): void {
+    [this.seconds, this.nanos] = Internal.readSecondsNanos(
+      d,
+      this.seconds,
+      this.nanos
+    );
+  }
+
+  WriteTo(e: Internal.Encoder): void {
+    Internal.writeSecondsNanos(e, this.seconds, this.nanos);
+  }
+
+  // Durations are decimal seconds with an "s" suffix in JSON, e.g. "1.5s".
+  MergeFromJSON(j: JsonValue, _o?: JsonOptions): void {
+    let m = /^(-?)(\d+)(?:\.(\d{1,9}))?s$/.exec(Internal.stringFromJSON(j));
+    if (m == null) {
+      throw new ProtobufError(`invalid duration: ${j}`);
+    }
+    let seconds = LongFromString(m[2]);
+    if (seconds.greaterThan(maxDurationSeconds)) {
+      throw new ProtobufError(`duration out of range: ${j}`);
+    }
+    let nanos = Internal.nanosFromJSON(m[3]);
+    if (m[1] == "-") {
+      seconds = seconds.neg();
+      nanos = -nanos;
+    }
+    this.seconds = seconds;
+    this.nanos = nanos;
+  }
+
+  ToJSON(_o?: JsonOptions): JsonValue {
+    let neg = this.seconds.isNegative() || this.nanos < 0;
+    let seconds = neg ? this.seconds.neg() : this.seconds;
+    if (seconds.greaterThan(maxDurationSeconds)) {
+      throw new ProtobufError(`duration out of range: ${this.seconds}`);
+    }
+    let nanos = Internal.nanosToJSON(Math.abs(this.nanos));
+    return (neg ? "-" : "") + seconds.toString() + nanos + "s";
+  }
+}
+
+// The range of valid durations is +-10,000 years.
+const maxDurationSeconds = 315576000000;
+
+// Wrapper is one of the wrapper well known types, such as
+// google.protobuf.StringValue, which hold a single value. The classes below are
+// encoded identically to the generated classes, and are used in their place by
+// the wkt_wrappers=primitive option.
+export interface Wrapper<T> extends Message {
+  value: T;
+}
+
+export interface WrapperClass<T> {
+  new (value?: T): Wrapper<T>;
+  readonly typeName: string;
+}
+
+function wrapper<T>(
+  typeName: string,
+  zero: T,
+  wireType: number,
+  read: (d: Internal.Decoder) => T,
+  write: (e: Internal.Encoder, v: T) => void,
+  isZero: (v: T) => boolean,
+  fromJSON: (j: JsonValue) => T,
+  toJSON: (v: T) => JsonValue
+): WrapperClass<T> {
+  return class {
+    static readonly typeName = typeName;
+
+    value: T;
+
+    constructor(value: T = zero) {
+      this.value = value;
+    }
+
+    MergeFrom(d: Internal.Decoder): void {
+      while (!d.isEOF()) {
+        let [fn, wt] = d.readTag();
+        if (fn == 1) {
+          this.value = read(d);
+        } else {
+          d.skipWireType(wt, fn);
+        }
+      }
+    }
+
+    WriteTo(e: Internal.Encoder): void {
+      if (!isZero(this.value)) {
+        e.writeTag(1, wireType);
+        write(e, this.value);
+      }
+    }
+
+    // Wrappers are represented by their value in JSON.
+    MergeFromJSON(j: JsonValue, _o?: JsonOptions): void {
+      this.value = fromJSON(j);
+    }
+
+    ToJSON(_o?: JsonOptions): JsonValue {
+      return toJSON(this.value);
+    }
+  };
+}
+
+export const DoubleValue = wrapper<number>(
+  "google.protobuf.DoubleValue",
+  0,
+  1,
+  d => d.readDouble(),
+  (e, v) => e.writeDouble(v),
+  v => v == 0,
+  j => Internal.doubleFromJSON(j),
+  v => Internal.floatToJSON(v)
+);
+export type DoubleValue = Wrapper<number>;
+
+export const FloatValue = wrapper<number>(
+  "google.protobuf.FloatValue",
+  0,
+  5,
+  d => d.readFloat(),
+  (e, v) => e.writeFloat(v),
+  v => v == 0,
+  j => Internal.floatFromJSON(j),
+  v => Internal.floatToJSON(v)
+);
+export type FloatValue = Wrapper<number>;
+
+export const Int64Value = wrapper<Long>(
+  "google.protobuf.Int64Value",
+  Long.ZERO,
+  0,
+  d => d.readVarintSigned(),
+  (e, v) => e.writeVarint(v),
+  v => v.isZero(),
+  j => Internal.int64FromJSON(j),
+  v => v.toString()
+);
+export type Int64Value = Wrapper<Long>;
+
+export const UInt64Value = wrapper<Long>(
+  "google.protobuf.UInt64Value",
+  Long.UZERO,
+  0,
+  d => d.readVarint(),
+  (e, v) => e.writeVarint(v),
+  v => v.isZero(),
+  j => Internal.uint64FromJSON(j),
+  v => v.toString()
+);
+export type UInt64Value = Wrapper<Long>;
+
+export const Int32Value = wrapper<number>(
+  "google.protobuf.Int32Value",
+  0,
+  0,
+  d => d.readVarInt32(),
+  (e, v) => e.writeNumberAsVarint(v),
+  v => v == 0,
+  j => Internal.int32FromJSON(j),
+  v => v
+);
+export type Int32Value = Wrapper<number>;
+
+export const UInt32Value = wrapper<number>(
+  "google.protobuf.UInt32Value",
+  0,
+  0,
+  d => d.readVarUint32(),
+  (e, v) => e.writeNumberAsVarint(v),
+  v => v == 0,
+  j => Internal.uint32FromJSON(j),
+  v => v
+);
+export type UInt32Value = Wrapper<number>;
+
+export const BoolValue = wrapper<boolean>(
+  "google.protobuf.BoolValue",
+  false,
+  0,
+  d => d.readBool(),
+  (e, v) => e.writeBool(v),
+  v => !v,
+  j => Internal.boolFromJSON(j),
+  v => v
+);
+export type BoolValue = Wrapper<boolean>;
+
+export const StringValue = wrapper<string>(
+  "google.protobuf.StringValue",
+  "",
+  2,
+  d => d.readValidString(),
+  (e, v) => e.writeString(v),
+  v => v == "",
+  j => Internal.stringFromJSON(j),
+  v => v
+);
+export type StringValue = Wrapper<string>;
+
+export const BytesValue = wrapper<Uint8Array>(
+  "google.protobuf.BytesValue",
+  new Uint8Array(0),
+  2,
+  d => d.readBytes(),
+  (e, v) => e.writeBytes(v),
+  v => v.length == 0,
+  j => Internal.bytesFromJSON(j),
+  v => Internal.bytesToJSON(v)
+);
+export type BytesValue = Wrapper<Uint8Array>;
+
+// Struct is google.protobuf.Struct as a JSON object. It is encoded identically
+// to the generated class, and is used in its place by the wkt_struct=json
+// option, as are Value and ListValue.
+export class Struct implements Message {
+  static readonly typeName = "google.protobuf.Struct";
+
+  value: JsonObject;
+
+  constructor(value: JsonObject = {}) {
+    this.value = value;
+  }
+
+  MergeFrom(d: Internal.Decoder): void {
+    Internal.readStruct(d, this.value);
+  }
+
+  WriteTo(e: Internal.Encoder): void {
+    Internal.writeStruct(e, this.value);
+  }
+
+  MergeFromJSON(j: JsonValue, _o?: JsonOptions): void {
+    let obj = Internal.objectFromJSON(j);
+    for (let k of Object.keys(obj)) {
+      this.value[k] = obj[k];
+    }
+  }
+
+  ToJSON(_o?: JsonOptions): JsonValue {
+    return this.value;
+  }
+}
+
+// Value is google.protobuf.Value as any JSON value. A Value whose kind is not
+// set is decoded as null.
+export class Value implements Message {
+  static readonly typeName = "google.protobuf.Value";
+
+  value: JsonValue;
+
+  constructor(value: JsonValue = null) {
+    this.value = value;
+  }
+
+  MergeFrom(d: Internal.Decoder): void {
+    this.value = Internal.readValue(d);
+  }
+
+  WriteTo(e: Internal.Encoder): void {
+    Internal.writeValue(e, this.value);
+  }
+
+  MergeFromJSON(j: JsonValue, _o?: JsonOptions): void {
+    this.value = j;
+  }
+
+  ToJSON(_o?: JsonOptions): JsonValue {
+    return this.value;
+  }
+}
+
+// ListValue is google.protobuf.ListValue as a JSON array.
+export class ListValue implements Message {
+  static readonly typeName = "google.protobuf.ListValue";
+
+  value: JsonValue[];
+
+  constructor(value: JsonValue[] = []) {
+    this.value = value;
+  }
+
+  MergeFrom(d: Internal.Decoder): void {
+    Internal.readList(d, this.value);
+  }
+
+  WriteTo(e: Internal.Encoder): void {
+    Internal.writeList(e, this.value);
+  }
+
+  MergeFromJSON(j: JsonValue, _o?: JsonOptions): void {
+    for (let v of Internal.arrayFromJSON(j)) {
+      this.value.push(v);
+    }
+  }
+
+  ToJSON(_o?: JsonOptions): JsonValue {
+    return this.value;
+  }
+}
+
+// TODO move to a grpc package.
+export namespace Grpc {
+  export enum Code {
+    OK = 0,
+    Canceled = 1,
+    Unknown = 2,
+    InvalidArgument = 3,
+    DeadlineExceeded = 4,
+    NotFound = 5,
+    AlreadyExists = 6,
+    PermissionDenied = 7,
+    ResourceExhausted = 8,
+    FailedPrecondition = 9,
+    Aborted = 10,
+    OutOfRange = 11,
+    Unimplemented = 12,
+    Internal = 13,
+    Unavailable = 14,
+    DataLoss = 15,
+    Unauthenticated = 16
+  }
+
+  export class GrpcError extends Error {
+    public grpc_code: Code;
+    public grpc_message: string;
+    constructor(code: Code, msg: string) {
+      let name = Grpc.Code[code];
+      super(`grpc error: ${name} (${code}); ${msg}`);
+      this.grpc_code = code;
+      this.grpc_message = msg;
+    }
+  }
+
+  export interface CallOption {}
+
+  // BidiStream is a bidirectional streaming call. Requests are sent with
+  // send() until closeSend(), while responses are read by iterating it.
+  export interface BidiStream<In extends Message, Out extends Message>
+    extends AsyncIterable<Out> {
+    send(min: In): Promise<void>;
+    closeSend(): Promise<void>;
+  }
+
+  // MethodKind is whether either side of a method streams.
+  export enum MethodKind {
+    Unary,
+    ServerStreaming,
+    ClientStreaming,
+    BidiStreaming
+  }
+
+  // IdempotencyLevel is the idempotency_level of a method's options.
+  export enum IdempotencyLevel {
+    IdempotencyUnknown = 0,
+    NoSideEffects = 1,
+    Idempotent = 2
+  }
+
+  // MethodDescriptor describes a method of a service. Generated services
+  // export one per method, in <Service>Descriptor.methods, and generated
+  // clients pass it to the ClientConn after the call options, where
+  // methodDescriptor finds it.
+  export class MethodDescriptor<
+    In extends Message = Message,
+    Out extends Message = Message
+  > implements CallOption {
+    // path is the full method path, "/package.Service/Method".
+    readonly path: string;
+    // service is the fully qualified name of the service.
+    readonly service: string;
+    readonly name: string;
+    readonly input: MessageClass<In>;
+    readonly output: MessageClass<Out>;
+    readonly kind: MethodKind;
+    readonly idempotency: IdempotencyLevel;
+
+    constructor(d: {
+      service: string;
+      name: string;
+      input: MessageClass<In>;
+      output: MessageClass<Out>;
+      kind: MethodKind;
+      idempotency: IdempotencyLevel;
+    }) {
+      this.path = `/${d.service}/${d.name}`;
+      this.service = d.service;
+      this.name = d.name;
+      this.input = d.input;
+      this.output = d.output;
+      this.kind = d.kind;
+      this.idempotency = d.idempotency;
+    }
+  }
+
+  // methodDescriptor returns the descriptor of the method among the options
+  // of a call, or undefined if the caller passed none.
+  export function methodDescriptor(
+    co: CallOption[]
+  ): MethodDescriptor | undefined {
+    for (const o of co) {
+      if (o instanceof MethodDescriptor) {
+        return o;
+      }
+    }
+    return undefined;
+  }
+
+  // ServiceDescriptor describes a service, keyed by the method names.
+  export interface ServiceDescriptor {
+    readonly typeName: string;
+    readonly methods: { readonly [name: string]: MethodDescriptor };
+  }
+
+  // ClientConn carries the calls of generated clients to the full method
+  // path, "/package.Service/Method". Streaming calls create each response
+  // with newOut before decoding into it. Generated clients also pass the
+  // method's MethodDescriptor as the last call option. Transports without
+  // streaming may leave the streaming calls out, in which case the generated
+  // clients fail them with Unimplemented.
+  export interface ClientConn {
+    Invoke(
+      method: string,
+      min: Message,
+      mout: Message,
+      ...co: CallOption[]
+    ): Promise<void>;
+    ServerStream?<Out extends Message>(
+      method: string,
+      min: Message,
+      newOut: () => Out,
+      ...co: CallOption[]
+    ): AsyncIterable<Out>;
+    ClientStream?<In extends Message>(
+      method: string,
+      mins: AsyncIterable<In>,
+      mout: Message,
+      ...co: CallOption[]
+    ): Promise<void>;
+    BidiStream?<In extends Message, Out extends Message>(
+      method: string,
+      newOut: () => Out,
+      ...co: CallOption[]
+    ): BidiStream<In, Out>;
+  }
+
+  // ServerMethod is a method of a service over encoded messages, which reads
+  // the requests of a call and yields its responses. Unary and server
+  // streaming methods take exactly one request.
+  export interface ServerMethod {
+    readonly descriptor: MethodDescriptor;
+    call(reqs: AsyncIterable<Uint8Array>): AsyncIterable<Uint8Array>;
+  }
+
+  // Dispatcher routes calls by their full method path,
+  // "/package.Service/Method", to the methods of generated services. It is
+  // independent of the transport, which frames the encoded messages.
+  export class Dispatcher {
+    readonly methods: Map<string, ServerMethod>;
+
+    constructor(methods: ServerMethod[] = []) {
+      this.methods = new Map();
+      for (const m of methods) {
+        this.methods.set(m.descriptor.path, m);
+      }
+    }
+
+    // add registers the methods of other, so that one dispatcher serves
+    // several services.
+    add(other: Dispatcher): this {
+      for (const [path, m] of other.methods) {
+        this.methods.set(path, m);
+      }
+      return this;
+    }
+
+    // dispatch calls the method at path with the encoded requests. Errors,
+    // including Unimplemented for unknown methods, are thrown when the
+    // responses are read.
+    dispatch(
+      path: string,
+      reqs: AsyncIterable<Uint8Array>
+    ): AsyncIterable<Uint8Array> {
+      const m = this.methods.get(path);
+      if (m === undefined) {
+        return unknownMethod(path);
+      }
+      return m.call(reqs);
+    }
+  }
+
+  async function* unknownMethod(path: string): AsyncIterable<Uint8Array> {
+    throw new GrpcError(Code.Unimplemented, `unknown method ${path}`);
+  }
+
+  // single reads the only request of a unary or server streaming call.
+  async function single(reqs: AsyncIterable<Uint8Array>): Promise<Uint8Array> {
+    let req: Uint8Array | undefined;
+    for await (const r of reqs) {
+      if (req !== undefined) {
+        throw new GrpcError(Code.Unimplemented, "expected one request");
+      }
+      req = r;
+    }
+    if (req === undefined) {
+      throw new GrpcError(Code.Unimplemented, "expected one request");
+    }
+    return req;
+  }
+
+  // decode decodes a request, which fails with InvalidArgument if it is
+  // malformed.
+  function decode<T extends Message>(cls: MessageClass<T>, b: Uint8Array): T {
+    const m = new cls();
+    try {
+      Unmarshal(b, m);
+    } catch (e) {
+      throw new GrpcError(Code.InvalidArgument, `invalid request: ${e}`);
+    }
+    return m;
+  }
+
+  async function* decodeAll<T extends Message>(
+    cls: MessageClass<T>,
+    reqs: AsyncIterable<Uint8Array>
+  ): AsyncIterable<T> {
+    for await (const r of reqs) {
+      yield decode(cls, r);
+    }
+  }
+
+  // The ServerMethods of the four kinds of method, used by the generated
+  // dispatchers. d describes the method and h is the handler.
+
+  export function unaryMethod<In extends Message, Out extends Message>(
+    d: MethodDescriptor<In, Out>,
+    h: (min: In) => Promise<Out>
+  ): ServerMethod {
+    return {
+      descriptor: d,
+      async *call(reqs: AsyncIterable<Uint8Array>) {
+        yield Marshal(await h(decode(d.input, await single(reqs))));
+      },
+    };
+  }
+
+  export function serverStreamMethod<In extends Message, Out extends Message>(
+    d: MethodDescriptor<In, Out>,
+    h: (min: In) => AsyncIterable<Out>
+  ): ServerMethod {
+    return {
+      descriptor: d,
+      async *call(reqs: AsyncIterable<Uint8Array>) {
+        for await (const m of h(decode(d.input, await single(reqs)))) {
+          yield Marshal(m);
+        }
+      },
+    };
+  }
+
+  export function clientStreamMethod<In extends Message, Out extends Message>(
+    d: MethodDescriptor<In, Out>,
+    h: (mins: AsyncIterable<In>) => Promise<Out>
+  ): ServerMethod {
+    return {
+      descriptor: d,
+      async *call(reqs: AsyncIterable<Uint8Array>) {
+        yield Marshal(await h(decodeAll(d.input, reqs)));
+      },
+    };
+  }
+
+  export function bidiStreamMethod<In extends Message, Out extends Message>(
+    d: MethodDescriptor<In, Out>,
+    h: (mins: AsyncIterable<In>) => AsyncIterable<Out>
+  ): ServerMethod {
+    return {
+      descriptor: d,
+      async *call(reqs: AsyncIterable<Uint8Array>) {
+        for await (const m of h(decodeAll(d.input, reqs))) {
+          yield Marshal(m);
+        }
+      },
+    };
+  }
+}
+
+// Http holds what the transports over HTTP, Connect, Twirp and Rest, share.
+export namespace Http {
+  // Fetch is the part of the fetch API that the transports use. It may be
+  // replaced, e.g. by an in-process server in tests.
+  export type Fetch = (url: string, init: RequestInit) => Promise<Response>;
+
+  export interface Options {
+    // fetch sends the requests, by default the global fetch.
+    fetch?: Fetch;
+    // headers are added to every request.
+    headers?: { [name: string]: string };
+  }
+
+  // send sends a request with the options' fetch, adding their headers to
+  // headers.
+  export function send(
+    o: Options,
+    url: string,
+    method: string,
+    headers: { [name: string]: string },
+    body?: BodyInit
+  ): Promise<Response> {
+    const extra = o.headers || {};
+    for (const k of Object.keys(extra)) {
+      headers[k] = extra[k];
+    }
+    const f = o.fetch || fetch;
+    return f(url, { method, headers, body });
+  }
+}
+
+// Connect is a client of the Connect protocol, which carries unary calls as
+// plain HTTP POSTs of a message and streams as enveloped messages. Its
+// Transport is a Grpc.ClientConn, so it carries the calls of the generated
+// clients.
+export namespace Connect {
+  export interface Options extends Http.Options {
+    // json sends messages in the proto3 JSON mapping instead of binary.
+    json?: boolean;
+    jsonOptions?: JsonOptions;
+  }
+
+  // The flags of an enveloped message.
+  export const compressedFlag = 0x01;
+  export const endStreamFlag = 0x02;
+
+  export interface Envelope {
+    flags: number;
+    data: Uint8Array;
+  }
+
+  // The names of the codes in the Connect protocol, indexed by Grpc.Code.
+  const codeNames = [
+    "ok",
+    "canceled",
+    "unknown",
+    "invalid_argument",
+    "deadline_exceeded",
+    "not_found",
+    "already_exists",
+    "permission_denied",
+    "resource_exhausted",
+    "failed_precondition",
+    "aborted",
+    "out_of_range",
+    "unimplemented",
+    "internal",
+    "unavailable",
+    "data_loss",
+    "unauthenticated",
+  ];
+
+  // codeName returns the name of code in errors, e.g. "not_found".
+  export function codeName(code: Grpc.Code): string {
+    return codeNames[code] || codeNames[Grpc.Code.Unknown];
+  }
+
+  // codeFromName returns the code named name, or Unknown.
+  export function codeFromName(name: string): Grpc.Code {
+    const code = codeNames.indexOf(name);
+    return code > 0 ? code : Grpc.Code.Unknown;
+  }
+
+  // codeFromStatus maps the HTTP status of a response without an error
+  // body to a code.
+  export function codeFromStatus(status: number): Grpc.Code {
+    switch (status) {
+      case 400:
+        return Grpc.Code.Internal;
+      case 401:
+        return Grpc.Code.Unauthenticated;
+      case 403:
+        return Grpc.Code.PermissionDenied;
+      case 404:
+        return Grpc.Code.Unimplemented;
+      case 429:
+      case 502:
+      case 503:
+      case 504:
+        return Grpc.Code.Unavailable;
+    }
+    return Grpc.Code.Unknown;
+  }
+
+  // errorFromJSON reads an error, {"code": ..., "message": ...}. code and
+  // message are used for anything missing.
+  export function errorFromJSON(
+    j: JsonValue,
+    code: Grpc.Code,
+    message: string
+  ): Grpc.GrpcError {
+    if (j !== null && typeof j == "object" && !Array.isArray(j)) {
+      if (typeof j.code == "string") {
+        code = codeFromName(j.code);
+      }
+      if (typeof j.message == "string") {
+        message = j.message;
+      }
+    }
+    return new Grpc.GrpcError(code, message);
+  }
+
+  function parseJSON(b: Uint8Array): JsonValue | undefined {
+    try {
+      return JSON.parse(new TextDecoder().decode(b));
+    } catch {
+      return undefined;
+    }
+  }
+
+  // envelope frames a message of a stream with its flags and length.
+  export function envelope(flags: number, data: Uint8Array): Uint8Array {
+    const b = new Uint8Array(5 + data.length);
+    b[0] = flags;
+    new DataView(b.buffer).setUint32(1, data.length);
+    b.set(data, 5);
+    return b;
+  }
+
+  // envelopes reads the enveloped messages of a stream, which may be split
+  // across chunks arbitrarily.
+  export async function* envelopes(
+    chunks: AsyncIterable<Uint8Array>
+  ): AsyncIterable<Envelope> {
+    let buf = new Uint8Array(0);
+    for await (const c of chunks) {
+      buf = Internal.concatBytes([buf, c]);
+      while (buf.length >= 5) {
+        const dv = new DataView(buf.buffer, buf.byteOffset, buf.length);
+        const n = dv.getUint32(1);
+        if (buf.length < 5 + n) {
+          break;
+        }
+        yield { flags: buf[0], data: buf.subarray(5, 5 + n) };
+        buf = buf.subarray(5 + n);
+      }
+    }
+    if (buf.length > 0) {
+      throw new Grpc.GrpcError(Grpc.Code.Internal, "truncated message");
+    }
+  }
+
+  async function* chunks(res: Response): AsyncIterable<Uint8Array> {
+    if (res.body === null) {
+      return;
+    }
+    const reader = res.body.getReader();
+    for (;;) {
+      const r = await reader.read();
+      if (r.done) {
+        return;
+      }
+      yield r.value;
+    }
+  }
+
+  // endStream throws the error of a stream's final message, if any.
+  function endStream(data: Uint8Array): void {
+    const j = parseJSON(data);
+    if (j === undefined || j === null || typeof j != "object") {
+      throw new Grpc.GrpcError(Grpc.Code.Internal, "invalid end of stream");
+    }
+    if (!Array.isArray(j) && j.error !== undefined && j.error !== null) {
+      throw errorFromJSON(j.error, Grpc.Code.Unknown, "");
+    }
+  }
+
+  // Transport calls the methods of services at a base URL, at
+  // <baseUrl>/<package.Service>/<Method>. fetch cannot stream a request
+  // everywhere, so client and bidirectional streams are not supported.
+  export class Transport implements Grpc.ClientConn {
+    private baseUrl: string;
+    private options: Options;
+
+    constructor(baseUrl: string, options: Options = {}) {
+      this.baseUrl = baseUrl.replace(/\/+$/, "");
+      this.options = options;
+    }
+
+    async Invoke(method: string, min: Message, mout: Message): Promise<void> {
+      const res = await this.fetch(method, "application/", this.encode(min));
+      const body = new Uint8Array(await res.arrayBuffer());
+      if (res.status != 200) {
+        throw errorFromJSON(
+          parseJSON(body) || null,
+          codeFromStatus(res.status),
+          `HTTP status ${res.status}`
+        );
+      }
+      this.decode(body, mout);
+    }
+
+    async *ServerStream<Out extends Message>(
+      method: string,
+      min: Message,
+      newOut: () => Out
+    ): AsyncIterable<Out> {
+      const body = envelope(0, this.encode(min));
+      for await (const b of this.stream(method, body)) {
+        const m = newOut();
+        this.decode(b, m);
+        yield m;
+      }
+    }
+
+    // stream sends the enveloped requests and reads the responses, throwing
+    // the error that ends the stream, if any.
+    private async *stream(
+      method: string,
+      body: Uint8Array
+    ): AsyncIterable<Uint8Array> {
+      const res = await this.fetch(method, "application/connect+", body);
+      if (res.status != 200) {
+        throw new Grpc.GrpcError(
+          codeFromStatus(res.status),
+          `HTTP status ${res.status}`
+        );
+      }
+      for await (const e of envelopes(chunks(res))) {
+        if (e.flags & endStreamFlag) {
+          endStream(e.data);
+          return;
+        }
+        if (e.flags & compressedFlag) {
+          throw new Grpc.GrpcError(
+            Grpc.Code.Internal,
+            "compressed messages are not supported"
+          );
+        }
+        yield e.data;
+      }
+      throw new Grpc.GrpcError(Grpc.Code.Internal, "missing end of stream");
+    }
+
+    // fetch posts body to the method. The content type is completed with
+    // the codec, "proto" or "json".
+    private fetch(
+      method: string,
+      contentType: string,
+      body: Uint8Array
+    ): Promise<Response> {
+      const headers = {
+        "Content-Type": contentType + (this.options.json ? "json" : "proto"),
+        "Connect-Protocol-Version": "1",
+      };
+      const url = this.baseUrl + method;
+      return Http.send(this.options, url, "POST", headers, body);
+    }
+
+    private encode(m: Message): Uint8Array {
+      if (this.options.json) {
+        const j = MarshalJSON(m, this.options.jsonOptions);
+        return new TextEncoder().encode(j);
+      }
+      return Marshal(m);
+    }
+
+    private decode(b: Uint8Array, m: Message): void {
+      if (this.options.json) {
+        const j = new TextDecoder().decode(b);
+        UnmarshalJSON(j, m, this.options.jsonOptions);
+      } else {
+        Unmarshal(b, m);
+      }
+    }
+  }
+}
+
+// Twirp is the Twirp protocol, which carries unary calls as POSTs to
+// <prefix>/<package.Service>/<Method> of protobuf or JSON messages, and
+// errors as a JSON object with a string code. Streaming is not supported.
+export namespace Twirp {
+  export enum ErrorCode {
+    Canceled = "canceled",
+    Unknown = "unknown",
+    InvalidArgument = "invalid_argument",
+    Malformed = "malformed",
+    DeadlineExceeded = "deadline_exceeded",
+    NotFound = "not_found",
+    BadRoute = "bad_route",
+    AlreadyExists = "already_exists",
+    PermissionDenied = "permission_denied",
+    Unauthenticated = "unauthenticated",
+    ResourceExhausted = "resource_exhausted",
+    FailedPrecondition = "failed_precondition",
+    Aborted = "aborted",
+    OutOfRange = "out_of_range",
+    Unimplemented = "unimplemented",
+    Internal = "internal",
+    Unavailable = "unavailable",
+    DataLoss = "dataloss"
+  }
+
+  // The HTTP status of the response of each code.
+  const statuses: { [code: string]: number } = {
+    canceled: 408,
+    unknown: 500,
+    invalid_argument: 400,
+    malformed: 400,
+    deadline_exceeded: 408,
+    not_found: 404,
+    bad_route: 404,
+    already_exists: 409,
+    permission_denied: 403,
+    unauthenticated: 401,
+    resource_exhausted: 429,
+    failed_precondition: 412,
+    aborted: 409,
+    out_of_range: 400,
+    unimplemented: 501,
+    internal: 500,
+    unavailable: 503,
+    dataloss: 500,
+  };
+
+  // httpStatus returns the HTTP status of errors with code.
+  export function httpStatus(code: ErrorCode): number {
+    return statuses[code] || 500;
+  }
+
+  export class TwirpError extends Error {
+    public code: ErrorCode;
+    public msg: string;
+    public meta: { [key: string]: string };
+    constructor(
+      code: ErrorCode,
+      msg: string,
+      meta: { [key: string]: string } = {}
+    ) {
+      super(`twirp error ${code}: ${msg}`);
+      this.code = code;
+      this.msg = msg;
+      this.meta = meta;
+    }
+
+    // toJSON returns the error as it is sent, {"code", "msg", "meta"}.
+    toJSON(): JsonObject {
+      const j: JsonObject = { code: this.code, msg: this.msg };
+      if (Object.keys(this.meta).length > 0) {
+        j.meta = this.meta;
+      }
+      return j;
+    }
+  }
+
+  // codeFromStatus maps the HTTP status of a response which is not a Twirp
+  // error, e.g. from a proxy, to a code.
+  function codeFromStatus(status: number): ErrorCode {
+    if (status >= 300 && status < 400) {
+      return ErrorCode.Internal;
+    }
+    switch (status) {
+      case 400:
+        return ErrorCode.Internal;
+      case 401:
+        return ErrorCode.Unauthenticated;
+      case 403:
+        return ErrorCode.PermissionDenied;
+      case 404:
+        return ErrorCode.BadRoute;
+      case 429:
+      case 502:
+      case 503:
+      case 504:
+        return ErrorCode.Unavailable;
+    }
+    return ErrorCode.Unknown;
+  }
+
+  // errorFromResponse reads the error of a response with the given status.
+  function errorFromResponse(status: number, body: string): TwirpError {
+    let j: JsonValue | undefined;
+    try {
+      j = JSON.parse(body);
+    } catch {}
+    if (
+      j !== undefined &&
+      j !== null &&
+      typeof j == "object" &&
+      !Array.isArray(j) &&
+      typeof j.code == "string" &&
+      statuses[j.code] !== undefined
+    ) {
+      const meta: { [key: string]: string } = {};
+      const m = j.meta;
+      if (m !== undefined && m !== null && typeof m == "object") {
+        for (const k of Object.keys(m)) {
+          const v = (m as JsonObject)[k];
+          if (typeof v == "string") {
+            meta[k] = v;
+          }
+        }
+      }
+      const msg = typeof j.msg == "string" ? j.msg : "";
+      return new TwirpError(j.code as ErrorCode, msg, meta);
+    }
+    return new TwirpError(
+      codeFromStatus(status),
+      `error from intermediary with HTTP status ${status}`,
+      {
+        http_error_from_intermediary: "true",
+        status_code: String(status),
+        body,
+      }
+    );
+  }
+
+  export interface Options extends Http.Options {
+    // prefix precedes the method paths, by default "/twirp".
+    prefix?: string;
+    // json sends messages in the proto3 JSON mapping instead of binary.
+    json?: boolean;
+    jsonOptions?: JsonOptions;
+  }
+
+  // Transport calls the methods of services at a base URL. Failed calls
+  // throw a TwirpError.
+  export class Transport {
+    private url: string;
+    private options: Options;
+
+    constructor(baseUrl: string, options: Options = {}) {
+      const prefix = options.prefix === undefined ? "/twirp" : options.prefix;
+      this.url = baseUrl.replace(/\/+$/, "") + prefix.replace(/\/+$/, "");
+      this.options = options;
+    }
+
+    async call(
+      method: Grpc.MethodDescriptor,
+      min: Message,
+      mout: Message
+    ): Promise<void> {
+      const o = this.options;
+      const headers = {
+        "Content-Type": o.json ? "application/json" : "application/protobuf",
+      };
+      const body = o.json ? MarshalJSON(min, o.jsonOptions) : Marshal(min);
+      const url = this.url + method.path;
+      const res = await Http.send(o, url, "POST", headers, body);
+      if (res.status != 200) {
+        throw errorFromResponse(res.status, await res.text());
+      }
+      if (o.json) {
+        UnmarshalJSON(await res.text(), mout, o.jsonOptions);
+      } else {
+        Unmarshal(new Uint8Array(await res.arrayBuffer()), mout);
+      }
+    }
+  }
+
+  // ServerMethod is a method of a service, called with a decoded request.
+  export interface ServerMethod {
+    readonly descriptor: Grpc.MethodDescriptor;
+    call(min: Message): Promise<Message>;
+  }
+
+  // method is the ServerMethod of the generated handlers. d describes the
+  // method and h is the handler.
+  export function method<In extends Message, Out extends Message>(
+    d: Grpc.MethodDescriptor<In, Out>,
+    h: (min: In) => Promise<Out>
+  ): ServerMethod {
+    return {
+      descriptor: d,
+      call: (min: Message) => h(min as In),
+    };
+  }
+
+  export interface HandlerOptions {
+    // prefix precedes the method paths, by default "/twirp".
+    prefix?: string;
+    // jsonOptions are used for requests and responses in JSON.
+    jsonOptions?: JsonOptions;
+  }
+
+  // Handler serves the methods of services over the fetch API's Request
+  // and Response, so that it can be mounted on any server which speaks
+  // them. Handlers throw a TwirpError to fail a call; anything else is an
+  // internal error.
+  export class Handler {
+    readonly methods: Map<string, ServerMethod>;
+    private prefix: string;
+    private jsonOptions: JsonOptions;
+
+    constructor(methods: ServerMethod[] = [], options: HandlerOptions = {}) {
+      this.methods = new Map();
+      for (const m of methods) {
+        this.methods.set(m.descriptor.path, m);
+      }
+      const prefix = options.prefix === undefined ? "/twirp" : options.prefix;
+      this.prefix = prefix.replace(/\/+$/, "");
+      this.jsonOptions = options.jsonOptions || {};
+    }
+
+    // add registers the methods of other, so that one handler serves
+    // several services.
+    add(other: Handler): this {
+      for (const [path, m] of other.methods) {
+        this.methods.set(path, m);
+      }
+      return this;
+    }
+
+    async handle(req: Request): Promise<Response> {
+      try {
+        return await this.serve(req);
+      } catch (e) {
+        if (e instanceof TwirpError) {
+          return errorResponse(e);
+        }
+        return errorResponse(new TwirpError(ErrorCode.Internal, String(e)));
+      }
+    }
+
+    private async serve(req: Request): Promise<Response> {
+      const path = new URL(req.url).pathname;
+      const m = path.startsWith(this.prefix + "/")
+        ? this.methods.get(path.slice(this.prefix.length))
+        : undefined;
+      if (req.method != "POST" || m === undefined) {
+        throw new TwirpError(
+          ErrorCode.BadRoute,
+          `no handler for ${req.method} ${path}`
+        );
+      }
+      const contentType = (req.headers.get("Content-Type") || "")
+        .split(";")[0]
+        .trim();
+      if (
+        contentType != "application/json" &&
+        contentType != "application/protobuf"
+      ) {
+        throw new TwirpError(
+          ErrorCode.BadRoute,
+          `unexpected Content-Type: ${contentType}`
+        );
+      }
+      const json = contentType == "application/json";
+
+      const min = new m.descriptor.input();
+      try {
+        if (json) {
+          UnmarshalJSON(await req.text(), min, this.jsonOptions);
+        } else {
+          Unmarshal(new Uint8Array(await req.arrayBuffer()), min);
+        }
+      } catch (e) {
+        throw new TwirpError(ErrorCode.Malformed, `invalid request: ${e}`);
+      }
+      const mout = await m.call(min);
+      const body = json ? MarshalJSON(mout, this.jsonOptions) : Marshal(mout);
+      return new Response(body, {
+        status: 200,
+        headers: { "Content-Type": contentType },
+      });
+    }
+  }
+
+  function errorResponse(e: TwirpError): Response {
+    return new Response(JSON.stringify(e.toJSON()), {
+      status: httpStatus(e.code),
+      headers: { "Content-Type": "application/json" },
+    });
+  }
+}
+
+// Rest calls methods at the REST endpoints their google.api.http rules map
+// them to, as served by grpc-gateway. Messages are sent and received in the
+// proto3 JSON mapping.
+export namespace Rest {
+  // Variable is a variable of a path template, bound to a field path of the
+  // request by the JSON names of its fields. multi variables match more
+  // than one segment, so their slashes are kept.
+  export interface Variable {
+    field: string[];
+    multi: boolean;
+  }
+
+  // Rule is a google.api.HttpRule. body is "*" to send every field not
+  // bound to the path, the JSON name of the field to send or "" for none,
+  // in which case the remaining fields are sent in the query. responseBody
+  // is the JSON name of the response field received, or "" for all.
+  export interface Rule {
+    method: string;
+    path: (string | Variable)[];
+    body: string;
+    responseBody: string;
+  }
+
+  export interface Options extends Http.Options {
+    // jsonOptions are used for requests and responses. Fields are always
+    // sent by their JSON names, as the rules refer to them.
+    jsonOptions?: JsonOptions;
+  }
+
+  // The codes of the HTTP statuses which grpc-gateway maps them to, for
+  // errors without a google.rpc.Status body.
+  function codeFromStatus(status: number): Grpc.Code {
+    switch (status) {
+      case 400:
+        return Grpc.Code.InvalidArgument;
+      case 401:
+        return Grpc.Code.Unauthenticated;
+      case 403:
+        return Grpc.Code.PermissionDenied;
+      case 404:
+        return Grpc.Code.NotFound;
+      case 409:
+        return Grpc.Code.Aborted;
+      case 412:
+        return Grpc.Code.FailedPrecondition;
+      case 429:
+        return Grpc.Code.ResourceExhausted;
+      case 499:
+        return Grpc.Code.Canceled;
+      case 501:
+        return Grpc.Code.Unimplemented;
+      case 503:
+        return Grpc.Code.Unavailable;
+      case 504:
+        return Grpc.Code.DeadlineExceeded;
+    }
+    return status >= 500 ? Grpc.Code.Internal : Grpc.Code.Unknown;
+  }
+
+  function isObject(j: JsonValue | undefined): j is JsonObject {
+    return (
+      j !== undefined && j !== null && typeof j == "object" && !Array.isArray(j)
+    );
+  }
+
+  // errorFromResponse reads the google.rpc.Status of a failed call.
+  function errorFromResponse(status: number, body: string): Grpc.GrpcError {
+    let j: JsonValue | undefined;
+    try {
+      j = JSON.parse(body);
+    } catch {}
+    if (isObject(j) && typeof j.code == "number") {
+      const msg = typeof j.message == "string" ? j.message : "";
+      return new Grpc.GrpcError(j.code, msg);
+    }
+    return new Grpc.GrpcError(codeFromStatus(status), `HTTP status ${status}`);
+  }
+
+  // pathValue expands a variable bound to v.
+  function pathValue(v: JsonValue | undefined, x: Variable): string {
+    if (v === undefined || v === null || typeof v == "object") {
+      throw new ProtobufError(
+        `field ${x.field.join(".")} of the path is not set`
+      );
+    }
+    if (x.multi) {
+      return String(v).split("/").map(encodeURIComponent).join("/");
+    }
+    return encodeURIComponent(String(v));
+  }
+
+  // take removes the value at a field path from j and returns it. Emptied
+  // messages are removed too.
+  function take(j: JsonObject, field: string[]): JsonValue | undefined {
+    const [k, ...rest] = field;
+    if (rest.length == 0) {
+      const v = j[k];
+      delete j[k];
+      return v;
+    }
+    const child = j[k];
+    if (!isObject(child)) {
+      return undefined;
+    }
+    const v = take(child, rest);
+    if (Object.keys(child).length == 0) {
+      delete j[k];
+    }
+    return v;
+  }
+
+  // query appends the fields of j as query parameters, naming those of
+  // nested messages by their field path and repeating the repeated fields.
+  function query(j: JsonObject, prefix: string, out: string[]): void {
+    for (const k of Object.keys(j)) {
+      const name = prefix + k;
+      const v = j[k];
+      if (isObject(v)) {
+        query(v, name + ".", out);
+        continue;
+      }
+      for (const e of Array.isArray(v) ? v : [v]) {
+        if (e !== null && typeof e == "object") {
+          throw new ProtobufError(
+            `field ${name} can't be sent in the query string`
+          );
+        }
+        out.push(encodeURIComponent(name) + "=" + encodeURIComponent(`${e}`));
+      }
+    }
+  }
+
+  // Transport calls methods at a base URL. Failed calls throw a
+  // Grpc.GrpcError with the code of the google.rpc.Status returned.
+  export class Transport {
+    private baseUrl: string;
+    private options: Options;
+
+    constructor(baseUrl: string, options: Options = {}) {
+      this.baseUrl = baseUrl.replace(/\/+$/, "");
+      this.options = options;
+    }
+
+    async call(rule: Rule, min: Message, mout: Message): Promise<void> {
+      const o = Object.assign({}, this.options.jsonOptions, {
+        useProtoNames: false,
+      });
+      const j = min.ToJSON(o) as JsonObject;
+      const defaults = min.ToJSON(
+        Object.assign({}, o, { emitDefaults: true })
+      ) as JsonObject;
+
+      let url = this.baseUrl;
+      for (const p of rule.path) {
+        if (typeof p == "string") {
+          url += p;
+          continue;
+        }
+        take(j, p.field);
+        url += pathValue(take(defaults, p.field), p);
+      }
+      let body: string | undefined;
+      if (rule.body == "*") {
+        body = JSON.stringify(j);
+      } else {
+        if (rule.body != "") {
+          const v = take(j, [rule.body]);
+          const d = take(defaults, [rule.body]);
+          body = JSON.stringify(v !== undefined ? v : d !== undefined ? d : {});
+        }
+        const params: string[] = [];
+        query(j, "", params);
+        if (params.length > 0) {
+          url += "?" + params.join("&");
+        }
+      }
+
+      const headers: { [name: string]: string } = {
+        Accept: "application/json",
+      };
+      if (body !== undefined) {
+        headers["Content-Type"] = "application/json";
+      }
+      const res = await Http.send(
+        this.options,
+        url,
+        rule.method,
+        headers,
+        body
+      );
+      const text = await res.text();
+      if (res.status < 200 || res.status >= 300) {
+        throw errorFromResponse(res.status, text);
+      }
+      let r: JsonValue = {};
+      if (text != "") {
+        try {
+          r = JSON.parse(text);
+        } catch (e) {
+          throw new ProtobufError(`invalid JSON: ${e}`);
+        }
+      }
+      if (rule.responseBody != "") {
+        r = { [rule.responseBody]: r };
+      }
+      mout.MergeFromJSON(r, o);
+    }
+  }
+}
+
+export namespace Internal {
+  // Helpers for the proto3 JSON mapping, used by generated code. The FromJSON
+  // functions accept every form the mapping allows and throw a ProtobufError
+  // for anything else.
+
+  export function objectFromJSON(v: JsonValue): JsonObject {
+    if (v === null || typeof v != "object" || Array.isArray(v)) {
+      throw new ProtobufError(`expected a JSON object, got ${typeOf(v)}`);
+    }
+    return v;
+  }
+
+  export function arrayFromJSON(v: JsonValue): JsonValue[] {
+    if (!Array.isArray(v)) {
+      throw new ProtobufError(`expected a JSON array, got ${typeOf(v)}`);
+    }
+    return v;
+  }
+
+  export function stringFromJSON(v: JsonValue): string {
+    if (typeof v != "string") {
+      throw new ProtobufError(`expected a string, got ${typeOf(v)}`);
+    }
+    return v;
+  }
+
+  export function boolFromJSON(v: JsonValue): boolean {
+    if (typeof v != "boolean") {
+      throw new ProtobufError(`expected a boolean, got ${typeOf(v)}`);
+    }
+    return v;
+  }
+
+  // Map keys are always strings in JSON.
+  export function boolKeyFromJSON(k: string): boolean {
+    switch (k) {
+      case "true":
+        return true;
+      case "false":
+        return false;
+    }
+    throw new ProtobufError(`invalid bool map key: ${k}`);
+  }
+
+  export function floatFromJSON(v: JsonValue): number {
+    let n = doubleFromJSON(v);
+    if (isFinite(n) && Math.abs(n) > 3.4028234663852886e38) {
+      throw new ProtobufError(`float out of range: ${v}`);
+    }
+    return n;
+  }
+
+  export function doubleFromJSON(v: JsonValue): number {
+    switch (v) {
+      case "NaN":
+        return NaN;
+      case "Infinity":
+        return Infinity;
+      case "-Infinity":
+        return -Infinity;
+    }
+    let n = numberFromJSON(v);
+    if (!isFinite(n)) {
+      throw new ProtobufError(`double out of range: ${v}`);
+    }
+    return n;
+  }
+
+  // Used for both float and double.
+  export function floatToJSON(v: number): JsonValue {
+    if (isNaN(v)) {
+      return "NaN";
+    }
+    if (v == Infinity) {
+      return "Infinity";
+    }
+    if (v == -Infinity) {
+      return "-Infinity";
+    }
+    return v;
+  }
+
+  export function int32FromJSON(v: JsonValue): number {
+    return integerFromJSON(v, -0x80000000, 0x7fffffff);
+  }
+
+  export function uint32FromJSON(v: JsonValue): number {
+    return integerFromJSON(v, 0, 0xffffffff);
+  }
+
+  export function int64FromJSON(v: JsonValue): Long {
+    return longFromJSON(v, false);
+  }
+
+  export function uint64FromJSON(v: JsonValue): Long {
+    return longFromJSON(v, true);
+  }
+
+  // Enum values may be given by number. Names are matched by the generated
+  // <Enum>FromJSON functions, which defer to this for anything else.
+  export function enumFromJSON(
+    v: JsonValue,
+    o: JsonOptions
+  ): number | undefined {
+    if (typeof v == "string") {
+      if (o.ignoreUnknown) {
+        return undefined;
+      }
+      throw new ProtobufError(`unknown enum value: ${v}`);
+    }
+    return int32FromJSON(v);
+  }
+
+  export function unknownFieldFromJSON(k: string, o: JsonOptions): void {
+    if (!o.ignoreUnknown) {
+      throw new ProtobufError(`unknown field: ${k}`);
+    }
+  }
+
+  // Fractional seconds are written with 0, 3, 6 or 9 digits.
+  export function nanosToJSON(n: number): string {
+    if (n == 0) {
+      return "";
+    }
+    let s = ("00000000" + n).slice(-9);
+    if (n % 1000000 == 0) {
+      return "." + s.slice(0, 3);
+    }
+    if (n % 1000 == 0) {
+      return "." + s.slice(0, 6);
+    }
+    return "." + s;
+  }
+
+  export function nanosFromJSON(frac: string | undefined): number {
+    if (frac === undefined) {
+      return 0;
+    }
+    return parseInt((frac + "00000000").slice(0, 9), 10);
+  }
 
-export class OneofNotSet {
-  static readonly singleton = new OneofNotSet();
-  static readonly kind = 0;
-  readonly kind = 0;
-  private constructor() {}
-}
+  // The fields shared by Timestamp and Duration, which are encoded as the
+  // generated classes would.
+  export function readSecondsNanos(
+    d: Decoder,
+    seconds: Long,
+    nanos: number
+  ): [Long, number] {
+    while (!d.isEOF()) {
+      let [fn, wt] = d.readTag();
+      switch (fn) {
+        case 1:
+          seconds = d.readVarintSigned();
+          break;
+        case 2:
+          nanos = d.readVarInt32();
+          break;
+        default:
+          d.skipWireType(wt, fn);
+      }
+    }
+    return [seconds, nanos];
+  }
 
-// TODO move to a grpc package.
-export namespace Grpc {
-  export enum Code {
-    OK = 0,
-    Canceled = 1,
-    Unknown = 2,
-    InvalidArgument = 3,
-    DeadlineExceeded = 4,
-    NotFound = 5,
-    AlreadyExists = 6,
-    PermissionDenied = 7,
-    ResourceExhausted = 8,
-    FailedPrecondition = 9,
-    Aborted = 10,
-    OutOfRange = 11,
-    Unimplemented = 12,
-    Internal = 13,
-    Unavailable = 14,
-    DataLoss = 15,
-    Unauthenticated = 16
+  export function writeSecondsNanos(
+    e: Encoder,
+    seconds: Long,
+    nanos: number
+  ): void {
+    if (!seconds.isZero()) {
+      e.writeTag(1, 0);
+      e.writeVarint(seconds);
+    }
+    if (nanos != 0) {
+      e.writeTag(2, 0);
+      e.writeNumberAsVarint(nanos);
+    }
   }
 
-  export class GrpcError extends Error {
-    public grpc_code: Code;
-    public grpc_message: string;
-    constructor(code: Code, msg: string) {
-      let name = Grpc.Code[code];
-      super(`grpc error: ${name} (${code}); ${msg}`);
-      this.grpc_code = code;
-      this.grpc_message = msg;
+  // FieldMask paths are joined by commas, with their snake_case names
+  // converted to lowerCamelCase. Paths which wouldn't convert back, because
+  // they have capitals or an underscore which isn't before a lowercase
+  // letter, can't be written.
+  export function fieldMaskToJSON(paths: string[]): string {
+    return paths
+      .map(p => {
+        if (/[A-Z]|_([^a-z]|$)/.test(p)) {
+          throw new ProtobufError(`field mask path ${p} has no JSON form`);
+        }
+        return p.replace(/_([a-z])/g, (_, c) => c.toUpperCase());
+      })
+      .join(",");
+  }
+
+  export function fieldMaskFromJSON(v: JsonValue): string[] {
+    let s = stringFromJSON(v);
+    if (s == "") {
+      return [];
     }
+    return s.split(",").map(p => {
+      if (!/^[A-Za-z][A-Za-z0-9]*(\.[A-Za-z][A-Za-z0-9]*)*$/.test(p)) {
+        throw new ProtobufError(`invalid field mask path: ${p}`);
+      }
+      return p.replace(/[A-Z]/g, c => "_" + c.toLowerCase());
+    });
   }
 
-  export interface CallOption {}
+  export const typeUrlPrefix = "type.googleapis.com/";
 
-  export interface ClientConn {
-    Invoke(
-      method: string,
-      min: Message,
-      mout: Message,
-      ...co: CallOption[]
-    ): Promise<void>;
+  // The type name in a type URL follows the last "/".
+  export function typeUrlName(typeUrl: string): string {
+    return typeUrl.slice(typeUrl.lastIndexOf("/") + 1);
+  }
+
+  export function anyUnpackTo(
+    typeUrl: string,
+    value: Uint8Array,
+    m: Message
+  ): void {
+    let name = typeUrlName(typeUrl);
+    if (name != typeNameOf(m)) {
+      throw new ProtobufError(
+        `cannot unpack Any of type ${name} into ${typeNameOf(m)}`
+      );
+    }
+    Unmarshal(value, m);
+  }
+
+  // The well known types with a special JSON representation, which is held
+  // under a "value" key when they are packed in an Any.
+  const wellKnownJSON = new Set(
+    [
+      "Any",
+      "Duration",
+      "FieldMask",
+      "ListValue",
+      "Struct",
+      "Timestamp",
+      "Value",
+      "DoubleValue",
+      "FloatValue",
+      "Int64Value",
+      "UInt64Value",
+      "Int32Value",
+      "UInt32Value",
+      "BoolValue",
+      "StringValue",
+      "BytesValue",
+    ].map(n => "google.protobuf." + n)
+  );
+
+  function anyMessage(typeUrl: string, o: JsonOptions): Message {
+    let name = typeUrlName(typeUrl);
+    let registry = o.typeRegistry || globalRegistry;
+    let cls = registry.findMessage(name);
+    if (cls === undefined) {
+      throw new ProtobufError(`unknown type in Any: ${typeUrl}`);
+    }
+    return new cls();
+  }
+
+  // Any is an object holding the packed message's JSON and its type URL
+  // under "@type".
+  export function anyFromJSON(
+    j: JsonValue,
+    o: JsonOptions
+  ): [string, Uint8Array] {
+    let obj = objectFromJSON(j);
+    let keys = Object.keys(obj);
+    if (keys.length == 0) {
+      return ["", new Uint8Array(0)];
+    }
+    if (obj["@type"] === undefined) {
+      throw new ProtobufError("Any is missing @type");
+    }
+    let typeUrl = stringFromJSON(obj["@type"]);
+    let m = anyMessage(typeUrl, o);
+    if (wellKnownJSON.has(typeNameOf(m))) {
+      if (obj["value"] === undefined) {
+        throw new ProtobufError(`Any of type ${typeUrl} is missing value`);
+      }
+      m.MergeFromJSON(obj["value"], o);
+    } else {
+      let fields: JsonObject = {};
+      for (let k of keys) {
+        if (k != "@type") {
+          fields[k] = obj[k];
+        }
+      }
+      m.MergeFromJSON(fields, o);
+    }
+    return [typeUrl, Marshal(m)];
+  }
+
+  export function anyToJSON(
+    typeUrl: string,
+    value: Uint8Array,
+    o: JsonOptions
+  ): JsonValue {
+    if (typeUrl == "" && value.length == 0) {
+      return {};
+    }
+    let m = anyMessage(typeUrl, o);
+    Unmarshal(value, m);
+    let v = m.ToJSON(o);
+    if (wellKnownJSON.has(typeNameOf(m))) {
+      return { "@type": typeUrl, value: v };
+    }
+    let obj: JsonObject = { "@type": typeUrl };
+    let fields = objectFromJSON(v);
+    for (let k of Object.keys(fields)) {
+      obj[k] = fields[k];
+    }
+    return obj;
+  }
+
+  // readStruct decodes the entries of a google.protobuf.Struct into obj.
+  export function readStruct(d: Decoder, obj: JsonObject): JsonObject {
+    while (!d.isEOF()) {
+      let [fn, wt] = d.readTag();
+      if (fn != 1) {
+        d.skipWireType(wt, fn);
+        continue;
+      }
+      let entry = d.readDecoder();
+      let k = "";
+      let v: JsonValue = null;
+      while (!entry.isEOF()) {
+        let [efn, ewt] = entry.readTag();
+        if (efn == 1) {
+          k = entry.readValidString();
+        } else if (efn == 2) {
+          v = readValue(entry.readDecoder());
+        } else {
+          entry.skipWireType(ewt, efn);
+        }
+      }
+      obj[k] = v;
+    }
+    return obj;
+  }
+
+  export function writeStruct(e: Encoder, obj: JsonObject): void {
+    for (let k of Object.keys(obj)) {
+      let entry = new Encoder();
+      entry.writeTag(1, 2);
+      entry.writeString(k);
+      let value = new Encoder();
+      writeValue(value, obj[k]);
+      entry.writeEncoder(value, 2);
+      e.writeEncoder(entry, 1);
+    }
+  }
+
+  // readValue decodes a google.protobuf.Value. The last kind read wins.
+  export function readValue(d: Decoder): JsonValue {
+    let v: JsonValue = null;
+    while (!d.isEOF()) {
+      let [fn, wt] = d.readTag();
+      switch (fn) {
+        case 1:
+          d.readVarInt32();
+          v = null;
+          break;
+        case 2:
+          v = d.readDouble();
+          break;
+        case 3:
+          v = d.readValidString();
+          break;
+        case 4:
+          v = d.readBool();
+          break;
+        case 5:
+          v = readStruct(d.readDecoder(), {});
+          break;
+        case 6:
+          v = readList(d.readDecoder(), []);
+          break;
+        default:
+          d.skipWireType(wt, fn);
+      }
+    }
+    return v;
+  }
+
+  export function writeValue(e: Encoder, v: JsonValue): void {
+    if (v === null) {
+      e.writeTag(1, 0);
+      e.writeNumberAsVarint(0);
+    } else if (typeof v == "number") {
+      e.writeTag(2, 1);
+      e.writeDouble(v);
+    } else if (typeof v == "string") {
+      e.writeTag(3, 2);
+      e.writeString(v);
+    } else if (typeof v == "boolean") {
+      e.writeTag(4, 0);
+      e.writeBool(v);
+    } else if (Array.isArray(v)) {
+      let nested = new Encoder();
+      writeList(nested, v);
+      e.writeEncoder(nested, 6);
+    } else {
+      let nested = new Encoder();
+      writeStruct(nested, v);
+      e.writeEncoder(nested, 5);
+    }
+  }
+
+  export function readList(d: Decoder, list: JsonValue[]): JsonValue[] {
+    while (!d.isEOF()) {
+      let [fn, wt] = d.readTag();
+      if (fn == 1) {
+        list.push(readValue(d.readDecoder()));
+      } else {
+        d.skipWireType(wt, fn);
+      }
+    }
+    return list;
+  }
+
+  export function writeList(e: Encoder, list: JsonValue[]): void {
+    for (let v of list) {
+      let nested = new Encoder();
+      writeValue(nested, v);
+      e.writeEncoder(nested, 1);
+    }
+  }
+
+  // fromInit returns v if it is an instance of cls, or else constructs one
+  // from it.
+  export function fromInit<T, I>(cls: { new (init?: I): T }, v: T | I): T {
+    return v instanceof cls ? v : new cls(v as I);
+  }
+
+  // fromJSONValue merges the JSON j into m, returning m.
+  export function fromJSONValue<T extends Message>(m: T, j: JsonValue): T {
+    m.MergeFromJSON(j);
+    return m;
+  }
+
+  // unknownVarint encodes a varint field, for values of closed enums which are
+  // not declared.
+  export function unknownVarint(fn: number, v: number): Uint8Array {
+    let e = new Encoder();
+    e.writeTag(fn, 0);
+    e.writeNumberAsVarint(v);
+    return e.buffer();
+  }
+
+  const base64Chars =
+    "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/";
+
+  export function bytesToJSON(v: Uint8Array): string {
+    let s = "";
+    for (let i = 0; i < v.length; i += 3) {
+      let n = (v[i] << 16) | (v[i + 1] << 8) | v[i + 2];
+      s += base64Chars[(n >> 18) & 63] + base64Chars[(n >> 12) & 63];
+      s += i + 1 < v.length ? base64Chars[(n >> 6) & 63] : "=";
+      s += i + 2 < v.length ? base64Chars[n & 63] : "=";
+    }
+    return s;
+  }
+
+  // Accepts standard and URL safe base64, with or without padding.
+  export function bytesFromJSON(v: JsonValue): Uint8Array {
+    let s = stringFromJSON(v).replace(/=+$/, "");
+    let out = new Uint8Array(Math.floor((s.length * 3) / 4));
+    let n = 0;
+    let bits = 0;
+    let j = 0;
+    for (let i = 0; i < s.length; i++) {
+      let c = s[i];
+      let d = base64Chars.indexOf(c == "-" ? "+" : c == "_" ? "/" : c);
+      if (d < 0) {
+        throw new ProtobufError(`invalid base64: ${s}`);
+      }
+      n = (n << 6) | d;
+      bits += 6;
+      if (bits >= 8) {
+        bits -= 8;
+        out[j++] = (n >> bits) & 0xff;
+      }
+    }
+    if (s.length % 4 == 1) {
+      throw new ProtobufError(`invalid base64: ${s}`);
+    }
+    return out;
+  }
+
+  function typeOf(v: JsonValue): string {
+    if (v === null) {
+      return "null";
+    }
+    if (Array.isArray(v)) {
+      return "array";
+    }
+    return typeof v;
+  }
+
+  const numberRe = /^-?(\d+\.?\d*|\.\d+)([eE][+-]?\d+)?$/;
+
+  // Numbers may be given as JSON numbers or strings.
+  function numberFromJSON(v: JsonValue): number {
+    if (typeof v == "number") {
+      return v;
+    }
+    if (typeof v == "string" && numberRe.test(v)) {
+      return Number(v);
+    }
+    throw new ProtobufError(`expected a number, got ${JSON.stringify(v)}`);
+  }
+
+  function integerFromJSON(v: JsonValue, min: number, max: number): number {
+    let n = numberFromJSON(v);
+    if (!Number.isInteger(n) || n < min || n > max) {
+      throw new ProtobufError(`invalid integer: ${JSON.stringify(v)}`);
+    }
+    return n;
+  }
+
+  function longFromJSON(v: JsonValue, unsigned: boolean): Long {
+    let s = typeof v == "number" ? String(v) : stringFromJSON(v);
+    let m = /^(-?)(\d+)(?:\.(\d*))?(?:[eE]([+-]?\d+))?$/.exec(s);
+    if (m == null) {
+      throw new ProtobufError(`invalid integer: ${JSON.stringify(v)}`);
+    }
+    s = integerString(m[1], m[2], m[3] || "", parseInt(m[4] || "0", 10), s);
+    let l = LongFromString(s, unsigned);
+    if (l.toString() != s) {
+      throw new ProtobufError(`integer out of range: ${JSON.stringify(v)}`);
+    }
+    return l;
+  }
+
+  // integerString normalizes a number given in exponent notation, failing if
+  // it has a fractional part.
+  function integerString(
+    sign: string,
+    int: string,
+    frac: string,
+    exp: number,
+    orig: string
+  ): string {
+    let digits = int + frac;
+    let point = int.length + exp;
+    if (point < digits.length) {
+      if (/[^0]/.test(digits.slice(Math.max(point, 0)))) {
+        throw new ProtobufError(`invalid integer: ${orig}`);
+      }
+      digits = digits.slice(0, Math.max(point, 0));
+    } else {
+      digits += "0".repeat(point - digits.length);
+    }
+    digits = digits.replace(/^0+(?=\d)/, "");
+    if (digits == "" || digits == "0") {
+      return "0";
+    }
+    return sign + digits;
+  }
+
+  // Helpers for the generated equals(), clone() and hashCode(). Values are
+  // compared with protobuf semantics: NaN equals NaN, bytes and maps by
+  // content, and unknown fields by their encoding.
+
+  export function floatEqual(a: number, b: number): boolean {
+    return a === b || (a !== a && b !== b);
+  }
+
+  export function bytesEqual(a: Uint8Array, b: Uint8Array): boolean {
+    if (a.length != b.length) {
+      return false;
+    }
+    for (let i = 0; i < a.length; i++) {
+      if (a[i] !== b[i]) {
+        return false;
+      }
+    }
+    return true;
+  }
+
+  // optionalEqual compares values of singular message fields, which are
+  // null or undefined when not set.
+  export function optionalEqual<T>(
+    a: T | null | undefined,
+    b: T | null | undefined,
+    eq: (a: T, b: T) => boolean
+  ): boolean {
+    return a == null || b == null ? a === b : eq(a, b);
+  }
+
+  export function arrayEqual<T>(
+    a: T[],
+    b: T[],
+    eq: (a: T, b: T) => boolean = (x, y) => x === y
+  ): boolean {
+    if (a.length != b.length) {
+      return false;
+    }
+    for (let i = 0; i < a.length; i++) {
+      if (!eq(a[i], b[i])) {
+        return false;
+      }
+    }
+    return true;
+  }
+
+  export function mapEqual<K, V>(
+    a: Map<K, V>,
+    b: Map<K, V>,
+    eq: (a: V, b: V) => boolean = (x, y) => x === y
+  ): boolean {
+    if (a.size != b.size) {
+      return false;
+    }
+    for (const [k, v] of a) {
+      const w = b.get(k);
+      if (w === undefined || !eq(v, w)) {
+        return false;
+      }
+    }
+    return true;
+  }
+
+  export function jsonEqual(
+    a: JsonValue | undefined,
+    b: JsonValue | undefined
+  ): boolean {
+    if (typeof a == "number" && typeof b == "number") {
+      return floatEqual(a, b);
+    }
+    if (a === b || a == null || b == null) {
+      return a === b;
+    }
+    if (typeof a != "object" || typeof b != "object") {
+      return false;
+    }
+    if (Array.isArray(a) || Array.isArray(b)) {
+      return (
+        Array.isArray(a) && Array.isArray(b) && arrayEqual(a, b, jsonEqual)
+      );
+    }
+    const ao = a as JsonObject;
+    const bo = b as JsonObject;
+    const keys = Object.keys(ao);
+    if (keys.length != Object.keys(bo).length) {
+      return false;
+    }
+    return keys.every(k => k in bo && jsonEqual(ao[k], bo[k]));
+  }
+
+  // wireEqual compares messages by their encoding, for the runtime library
+  // classes which have no equals().
+  export function wireEqual(a: Message, b: Message): boolean {
+    return bytesEqual(Marshal(a), Marshal(b));
+  }
+
+  // unknownEqual compares unknown fields by their concatenated encoding.
+  export function unknownEqual(a: Uint8Array[], b: Uint8Array[]): boolean {
+    return bytesEqual(concatBytes(a), concatBytes(b));
+  }
+
+  export function concatBytes(parts: Uint8Array[]): Uint8Array {
+    if (parts.length == 1) {
+      return parts[0];
+    }
+    let n = 0;
+    for (const p of parts) {
+      n += p.length;
+    }
+    const out = new Uint8Array(n);
+    n = 0;
+    for (const p of parts) {
+      out.set(p, n);
+      n += p.length;
+    }
+    return out;
+  }
+
+  // wireClone copies src into dst through its encoding, returning dst.
+  export function wireClone<T extends Message>(src: T, dst: T): T {
+    Unmarshal(Marshal(src), dst);
+    return dst;
+  }
+
+  export function cloneJSON(v: JsonValue): JsonValue {
+    if (Array.isArray(v)) {
+      return v.map(cloneJSON);
+    }
+    if (v !== null && typeof v == "object") {
+      const o: JsonObject = {};
+      for (const k of Object.keys(v)) {
+        o[k] = cloneJSON(v[k]);
+      }
+      return o;
+    }
+    return v;
+  }
+
+  // Hashes are 32 bit integers which depend only on the values hashed, so
+  // they are stable across runs. Values which are equal hash equally.
+
+  export function hashCombine(h: number, v: number): number {
+    return (Math.imul(h, 31) + v) | 0;
+  }
+
+  const hashView = new DataView(new ArrayBuffer(8));
+
+  export function hashNumber(v: number): number {
+    if ((v | 0) === v) {
+      return v | 0;
+    }
+    if (v !== v) {
+      return 0x7ff80000;
+    }
+    hashView.setFloat64(0, v);
+    return hashView.getInt32(0) ^ hashView.getInt32(4);
+  }
+
+  export function hashBool(v: boolean): number {
+    return v ? 1231 : 1237;
+  }
+
+  export function hashString(v: string): number {
+    let h = 0;
+    for (let i = 0; i < v.length; i++) {
+      h = hashCombine(h, v.charCodeAt(i));
+    }
+    return h;
+  }
+
+  export function hashLong(v: Long): number {
+    return v.high ^ v.low;
+  }
+
+  export function hashBytes(v: Uint8Array): number {
+    let h = 0;
+    for (let i = 0; i < v.length; i++) {
+      h = hashCombine(h, v[i]);
+    }
+    return h;
+  }
+
+  export function hashArray<T>(a: T[], hash: (v: T) => number): number {
+    let h = 1;
+    for (const v of a) {
+      h = hashCombine(h, hash(v));
+    }
+    return h;
+  }
+
+  // Map entries are summed, so the hash does not depend on their order.
+  export function hashMap<K, V>(
+    m: Map<K, V>,
+    hashKey: (k: K) => number,
+    hashValue: (v: V) => number
+  ): number {
+    let h = 0;
+    for (const [k, v] of m) {
+      h = (h + hashCombine(hashKey(k), hashValue(v))) | 0;
+    }
+    return h;
+  }
+
+  export function hashJSON(v: JsonValue | undefined): number {
+    if (v === undefined || v === null) {
+      return 0;
+    }
+    switch (typeof v) {
+      case "number":
+        return hashNumber(v);
+      case "boolean":
+        return hashBool(v);
+      case "string":
+        return hashString(v);
+    }
+    if (Array.isArray(v)) {
+      return hashArray(v, hashJSON);
+    }
+    const o = v as JsonObject;
+    let h = 0;
+    for (const k of Object.keys(o)) {
+      h = (h + hashCombine(hashString(k), hashJSON(o[k]))) | 0;
+    }
+    return h;
   }
-}
 
-export namespace Internal {
   export class Decoder {
     private buf: Uint8Array;
     private offset: number;
+    // The offset of the last tag read, for readUnknown.
+    private tagOffset: number;
     constructor(buf: Uint8Array) {
       this.offset = 0;
+      this.tagOffset = 0;
       this.buf = buf;
     }
 
@@ -132,6 +2669,7 @@ export namespace Internal {
     }
 
     readTag(): [number, number] {
+      this.tagOffset = this.offset;
       let k = this.readVarintAsNumber();
       let fn = k >> 3;
       if (fn == 0) {
@@ -202,6 +2740,21 @@ export namespace Internal {
       return new TextDecoder("utf-8").decode(dv);
     }
 
+    // Like readString, but rejects invalid UTF-8 instead of substituting
+    // replacement characters.
+    readValidString(): string {
+      let len = this.readVarintAsNumber();
+      if (len == 0) {
+        return "";
+      }
+      let dv = this.readView(len);
+      try {
+        return new TextDecoder("utf-8", { fatal: true }).decode(dv);
+      } catch (e) {
+        throw new ProtobufError("invalid UTF-8 in string field");
+      }
+    }
+
     readBytes(): Uint8Array {
       let len = this.readVarintAsNumber();
       if (len == 0) {
@@ -222,6 +2775,36 @@ export namespace Internal {
       return new Decoder(ua);
     }
 
+    // Reads a group whose start tag, numbered fn, has already been read. The
+    // returned decoder excludes the end group tag.
+    readGroup(fn: number): Decoder {
+      let start = this.offset;
+      let end = this.skipGroup(fn);
+      let ua = new Uint8Array(
+        this.buf.buffer,
+        this.buf.byteOffset + start,
+        end - start
+      );
+      return new Decoder(ua);
+    }
+
+    // Skips to the end of a group and returns the offset of its end tag.
+    private skipGroup(fn?: number): number {
+      while (true) {
+        let end = this.offset;
+        let [gfn, wt] = this.readTag();
+        if (wt == 4) {
+          if (fn !== undefined && gfn != fn) {
+            throw new ProtobufError(
+              `mismatched end group tag: got ${gfn}, want ${fn}`
+            );
+          }
+          return end;
+        }
+        this.skipWireType(wt, gfn);
+      }
+    }
+
     // len should be > 0.
     readView(len: number): DataView {
       if (this.isEOF()) {
@@ -240,7 +2823,9 @@ export namespace Internal {
       return dv;
     }
 
-    skipWireType(wt: number): void {
+    // fn is the field number of the tag, and is used to match the end of a
+    // group.
+    skipWireType(wt: number, fn?: number): void {
       switch (wt) {
         case 0:
           this.readVarintAsNumber();
@@ -252,6 +2837,9 @@ export namespace Internal {
           let z = this.readVarintAsNumber();
           this.offset += z;
           break;
+        case 3:
+          this.skipGroup(fn);
+          break;
         case 5:
           this.offset += 4;
           break;
@@ -266,6 +2854,14 @@ export namespace Internal {
       }
     }
 
+    // Skips the field whose tag was just read, and returns a copy of its
+    // encoding, including the tag.
+    readUnknown(wt: number, fn: number): Uint8Array {
+      let start = this.tagOffset;
+      this.skipWireType(wt, fn);
+      return this.buf.slice(start, this.offset);
+    }
+
     isEOF(): boolean {
       return this.offset >= this.buf.length;
     }
@@ -420,6 +3016,19 @@ export namespace Internal {
       this.writeBytes(e.buffer());
     }
 
+    // Writes fields captured by Decoder.readUnknown as they were read.
+    writeUnknown(fields: Uint8Array[]): void {
+      for (let f of fields) {
+        this.buf.writeBytes(f);
+      }
+    }
+
+    writeGroup(e: Encoder, fn: number) {
+      this.writeTag(fn, 3);
+      this.buf.writeBytes(e.buffer());
+      this.writeTag(fn, 4);
+    }
+
     buffer(): Uint8Array {
       return this.buf.buffer();
     }
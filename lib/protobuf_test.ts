@@ -59,3 +59,60 @@ testVarintSignedNumber(-15, [
   0xff,
   0x01
 ]);
+
+function testSkipGroup(d: number[], offset: number): void {
+  let dec = new pb.Internal.Decoder(new Uint8Array(d));
+  let [fn, wt] = dec.readTag();
+  dec.skipWireType(wt, fn);
+  assertEqual((dec as any).offset, offset, `skip group ${d}`);
+}
+
+// Group 1 containing a varint and a nested group 2, followed by a varint.
+testSkipGroup([0x0b, 0x10, 0x01, 0x1b, 0x1c, 0x0c, 0x08, 0x01], 6);
+
+function testBase64(d: number[], s: string): void {
+  let ua = new Uint8Array(d);
+  assertEqual(pb.Internal.bytesToJSON(ua), s, `bytesToJSON ${d}`);
+  assertEqual(pb.Internal.bytesFromJSON(s), ua, `bytesFromJSON ${s}`);
+}
+
+testBase64([], "");
+testBase64([0x66], "Zg==");
+testBase64([0x66, 0x6f], "Zm8=");
+testBase64([0x66, 0x6f, 0x6f], "Zm9v");
+testBase64([0xfb, 0xff], "+/8=");
+assertEqual(
+  pb.Internal.bytesFromJSON("-_8"),
+  new Uint8Array([0xfb, 0xff]),
+  "url safe base64"
+);
+
+function testInt64FromJSON(v: pb.JsonValue, exp: string): void {
+  let got = pb.Internal.int64FromJSON(v).toString();
+  assertEqual(got, exp, `int64FromJSON ${v}`);
+}
+
+testInt64FromJSON("-9223372036854775808", "-9223372036854775808");
+testInt64FromJSON("1.5e1", "15");
+testInt64FromJSON("100e-2", "1");
+testInt64FromJSON(-12, "-12");
+
+function testTimestampJSON(json: string, seconds: string, nanos: number): void {
+  let t = new pb.Timestamp();
+  t.MergeFromJSON(json);
+  assertEqual(t.seconds.toString(), seconds, `timestamp seconds ${json}`);
+  assertEqual(t.nanos, nanos, `timestamp nanos ${json}`);
+}
+
+testTimestampJSON("0001-01-01T00:00:00Z", "-62135596800", 0);
+testTimestampJSON("1970-01-01T00:00:00.000000001-00:01", "60", 1);
+assertEqual(
+  new pb.Timestamp(pb.Internal.int64FromJSON("-1"), 10000).ToJSON(),
+  "1969-12-31T23:59:59.000010Z",
+  "timestamp to JSON"
+);
+assertEqual(
+  pb.Duration.fromMillis(-1500).ToJSON(),
+  "-1.500s",
+  "duration to JSON"
+);
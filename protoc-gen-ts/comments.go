@@ -0,0 +1,57 @@
+package main
+
+import (
+	"strings"
+)
+
+// commentEscaper escapes text which TSDoc would otherwise interpret: the end
+// of the comment block and tags.
+var commentEscaper = strings.NewReplacer(`\`, `\\`, "*/", `*\/`, "@", `\@`)
+
+// writeComment writes the comments on the element at path in the .proto
+// source as a TSDoc block. Detached comments come first, then the leading
+// and trailing comments, separated by blank lines. Deprecated elements are
+// tagged with @deprecated. Nothing is written if the element has no comments
+// and is not deprecated.
+func (s *source) writeComment(w *writer, path []int32, deprecated bool) {
+	blocks := []string{}
+	if loc := s.location(path); loc != nil {
+		blocks = append(blocks, loc.LeadingDetachedComments...)
+		blocks = append(blocks, loc.GetLeadingComments(), loc.GetTrailingComments())
+	}
+	lines := []string{}
+	for _, b := range blocks {
+		b = strings.TrimRight(b, " \t\n")
+		if strings.TrimSpace(b) == "" {
+			continue
+		}
+		if len(lines) > 0 {
+			lines = append(lines, "")
+		}
+		for _, l := range strings.Split(b, "\n") {
+			// protoc keeps the space following the comment marker.
+			l = strings.TrimPrefix(strings.TrimRight(l, " \t"), " ")
+			lines = append(lines, commentEscaper.Replace(l))
+		}
+	}
+	if deprecated {
+		if len(lines) > 0 {
+			lines = append(lines, "")
+		}
+		lines = append(lines, "@deprecated")
+	}
+	if len(lines) == 0 {
+		return
+	}
+	// Lines are written through "%s" so that text ending in a brace does not
+	// change the indentation.
+	w.p("/**")
+	for _, l := range lines {
+		if l == "" {
+			w.p(" *")
+			continue
+		}
+		w.p(" * %s", l)
+	}
+	w.p(" */")
+}
@@ -0,0 +1,188 @@
+package main
+
+import (
+	"fmt"
+	desc "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"io"
+	"os"
+	"strings"
+)
+
+// Field numbers used to build paths into a FileDescriptorProto, as described
+// by SourceCodeInfo.Location.path.
+const (
+	fileMessagePath   = 4  // FileDescriptorProto.message_type
+	fileEnumPath      = 5  // FileDescriptorProto.enum_type
+	fileServicePath   = 6  // FileDescriptorProto.service
+	fileExtensionPath = 7  // FileDescriptorProto.extension
+	fileSyntaxPath    = 12 // FileDescriptorProto.syntax
+	fileEditionPath   = 14 // FileDescriptorProto.edition
+
+	messageFieldPath     = 2 // DescriptorProto.field
+	messageNestedPath    = 3 // DescriptorProto.nested_type
+	messageEnumPath      = 4 // DescriptorProto.enum_type
+	messageExtensionPath = 6 // DescriptorProto.extension
+	messageOptionsPath   = 7 // DescriptorProto.options
+	messageOneofPath     = 8 // DescriptorProto.oneof_decl
+
+	fieldOptionsPath = 8 // FieldDescriptorProto.options
+
+	enumValuePath = 2 // EnumDescriptorProto.value
+
+	serviceMethodPath = 2 // ServiceDescriptorProto.method
+)
+
+// warningOutput is where non-fatal diagnostics are written.
+var warningOutput io.Writer = os.Stderr
+
+// subPath returns a copy of path extended with elems, so that sibling paths
+// never share a backing array.
+func subPath(path []int32, elems ...int32) []int32 {
+	p := make([]int32, 0, len(path)+len(elems))
+	p = append(p, path...)
+	return append(p, elems...)
+}
+
+// source resolves paths within a file to locations in the .proto source, and
+// reports diagnostics against them.
+type source struct {
+	fdp       *desc.FileDescriptorProto
+	locations map[string]*desc.SourceCodeInfo_Location
+}
+
+func newSource(fdp *desc.FileDescriptorProto) *source {
+	s := &source{
+		fdp:       fdp,
+		locations: map[string]*desc.SourceCodeInfo_Location{},
+	}
+	for _, loc := range fdp.GetSourceCodeInfo().GetLocation() {
+		k := pathKey(loc.Path)
+		// Keep the first location, which covers the whole element.
+		if s.locations[k] == nil {
+			s.locations[k] = loc
+		}
+	}
+	return s
+}
+
+func pathKey(path []int32) string {
+	return fmt.Sprint(path)
+}
+
+// location returns the source location of the element at path, or nil if the
+// request carried no source info for it.
+func (s *source) location(path []int32) *desc.SourceCodeInfo_Location {
+	return s.locations[pathKey(path)]
+}
+
+// position formats the location of path in the "file:line:col" form used by
+// protoc. Line and column are omitted if the location is unknown.
+func (s *source) position(path []int32) string {
+	loc := s.location(path)
+	if path == nil || loc == nil || len(loc.Span) < 2 {
+		return s.fdp.GetName()
+	}
+	return fmt.Sprintf("%s:%d:%d", s.fdp.GetName(), loc.Span[0]+1, loc.Span[1]+1)
+}
+
+// genError is a problem with the input which prevents code generation. It is
+// reported to protoc through CodeGeneratorResponse.error.
+type genError struct {
+	pos, msg string
+}
+
+func (e *genError) Error() string {
+	if e.pos == "" {
+		return e.msg
+	}
+	return e.pos + ": " + e.msg
+}
+
+// fail aborts code generation with an error at the element at path. The
+// error is recovered by gen.
+func (s *source) fail(path []int32, format string, a ...interface{}) {
+	panic(&genError{s.position(path), fmt.Sprintf(format, a...)})
+}
+
+// warn reports a non-fatal problem with the element at path.
+func (s *source) warn(path []int32, format string, a ...interface{}) {
+	fmt.Fprintf(warningOutput, "%s: warning: %s\n", s.position(path), fmt.Sprintf(format, a...))
+}
+
+// tsReservedWords may not be used as the names of classes or namespaces.
+var tsReservedWords = map[string]bool{}
+
+// tsReservedMembers are generated members of message classes which fields
+// must not shadow.
+var tsReservedMembers = map[string]bool{}
+
+func init() {
+	for _, w := range strings.Fields(`
+		break case catch class const continue debugger default delete do else
+		enum export extends false finally for function if import in instanceof
+		new null return super switch this throw true try typeof var void while
+		with implements interface let package private protected public static
+		yield any boolean number string symbol`) {
+		tsReservedWords[w] = true
+	}
+	for _, w := range strings.Fields(`constructor MergeFrom WriteTo MergeFromJSON ToJSON unknownFields toObject equals clone hashCode`) {
+		tsReservedMembers[w] = true
+	}
+}
+
+// tsName returns the typescript identifier for a proto message, enum or
+// oneof name. Names which are reserved words are escaped with a trailing
+// underscore.
+func tsName(name string) string {
+	if tsReservedWords[name] {
+		return name + "_"
+	}
+	return name
+}
+
+// tsTypeName applies tsName to each component of a dotted type name.
+func tsTypeName(name string) string {
+	parts := strings.Split(name, ".")
+	for i, p := range parts {
+		parts[i] = tsName(p)
+	}
+	return strings.Join(parts, ".")
+}
+
+// tsMemberName returns the class member name for a proto field, escaping
+// names which collide with generated members.
+func tsMemberName(name string) string {
+	if tsReservedMembers[name] {
+		return name + "_"
+	}
+	return name
+}
+
+// warnEscaped warns if a declared name had to be escaped.
+func (s *source) warnEscaped(path []int32, kind, name, escaped string) {
+	if name != escaped {
+		s.warn(path, "%s name %q is reserved in typescript and was generated as %q", kind, name, escaped)
+	}
+}
+
+// declaredKind returns "message" or "enum" if a type named name is declared
+// in scope, the fully qualified proto name of a package or message, by the
+// file being generated, or "" otherwise. Types declared by other files are
+// generated into other modules, so their names can't collide.
+func (mr *moduleResolver) declaredKind(ns *Namespace, scope, name string) string {
+	fqName := "." + name
+	if scope != "" {
+		fqName = "." + scope + fqName
+	}
+	_, _, d, fdp, err := ns.FindFullyQualifiedName(fqName)
+	if err != nil || fdp != mr.currentFile {
+		return ""
+	}
+	switch d.(type) {
+	case *desc.DescriptorProto:
+		return "message"
+	case *desc.EnumDescriptorProto:
+		return "enum"
+	}
+	return ""
+}
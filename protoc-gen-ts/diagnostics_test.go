@@ -0,0 +1,145 @@
+package main
+
+import (
+	"bytes"
+	"github.com/golang/protobuf/proto"
+	desc "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	ppb "github.com/golang/protobuf/protoc-gen-go/plugin"
+	"strings"
+	"testing"
+)
+
+// testRequest returns a request to generate test.proto, in which message Foo
+// has an enum field kind with the given default value, and there is an
+// unsupported extension. The elements have source locations, as protoc sends.
+func testRequest(defaultKind string) *ppb.CodeGeneratorRequest {
+	fdp := &desc.FileDescriptorProto{
+		Name:    proto.String("test.proto"),
+		Package: proto.String("test"),
+		Syntax:  proto.String("proto2"),
+		EnumType: []*desc.EnumDescriptorProto{{
+			Name: proto.String("Kind"),
+			Value: []*desc.EnumValueDescriptorProto{
+				{Name: proto.String("A"), Number: proto.Int32(0)},
+			},
+		}},
+		MessageType: []*desc.DescriptorProto{{
+			Name: proto.String("Foo"),
+			Field: []*desc.FieldDescriptorProto{{
+				Name:         proto.String("kind"),
+				Number:       proto.Int32(1),
+				Label:        desc.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+				Type:         desc.FieldDescriptorProto_TYPE_ENUM.Enum(),
+				TypeName:     proto.String(".test.Kind"),
+				DefaultValue: proto.String(defaultKind),
+			}},
+		}},
+		Extension: []*desc.FieldDescriptorProto{{
+			Name:     proto.String("ext"),
+			Number:   proto.Int32(100),
+			Label:    desc.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:     desc.FieldDescriptorProto_TYPE_INT32.Enum(),
+			Extendee: proto.String(".test.Foo"),
+		}},
+		SourceCodeInfo: &desc.SourceCodeInfo{
+			Location: []*desc.SourceCodeInfo_Location{
+				{Path: []int32{}, Span: []int32{0, 0, 20, 0}},
+				{Path: []int32{fileEnumPath, 0}, Span: []int32{2, 0, 4, 1}},
+				{Path: []int32{fileMessagePath, 0}, Span: []int32{5, 0, 7, 1}},
+				{Path: []int32{fileMessagePath, 0, messageFieldPath, 0}, Span: []int32{6, 2, 44}},
+				// A later location of the same element, e.g. of its name,
+				// doesn't override the first.
+				{Path: []int32{fileMessagePath, 0, messageFieldPath, 0}, Span: []int32{6, 11, 15}},
+				{Path: []int32{fileExtensionPath, 0}, Span: []int32{9, 2, 27}},
+			},
+		},
+	}
+	return &ppb.CodeGeneratorRequest{
+		FileToGenerate: []string{"test.proto"},
+		ProtoFile:      []*desc.FileDescriptorProto{fdp},
+	}
+}
+
+// captureWarnings redirects warnings to a buffer until the returned function
+// is called.
+func captureWarnings() (*bytes.Buffer, func()) {
+	b := &bytes.Buffer{}
+	old := warningOutput
+	warningOutput = b
+	return b, func() { warningOutput = old }
+}
+
+func TestGenFailure(t *testing.T) {
+	_, restore := captureWarnings()
+	defer restore()
+
+	resp := gen(testRequest("B"))
+	want := "test.proto:7:3: unknown default enum value B for field kind"
+	if resp.GetError() != want {
+		t.Errorf("error = %q, want %q", resp.GetError(), want)
+	}
+	if len(resp.File) != 0 {
+		t.Errorf("got %d files with an error, want none", len(resp.File))
+	}
+}
+
+func TestGenFailureWithoutSourceInfo(t *testing.T) {
+	_, restore := captureWarnings()
+	defer restore()
+
+	req := testRequest("B")
+	req.ProtoFile[0].SourceCodeInfo = nil
+	resp := gen(req)
+	want := "test.proto: unknown default enum value B for field kind"
+	if resp.GetError() != want {
+		t.Errorf("error = %q, want %q", resp.GetError(), want)
+	}
+}
+
+func TestGenInvalidParameter(t *testing.T) {
+	req := testRequest("A")
+	req.Parameter = proto.String("plugin=nope")
+	resp := gen(req)
+	if !strings.HasPrefix(resp.GetError(), "invalid compiler option plugin: ") {
+		t.Errorf("error = %q, want an invalid plugin error", resp.GetError())
+	}
+	if len(resp.File) != 0 {
+		t.Errorf("got %d files with an error, want none", len(resp.File))
+	}
+}
+
+func TestGenWarning(t *testing.T) {
+	warnings, restore := captureWarnings()
+	defer restore()
+
+	resp := gen(testRequest("A"))
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.GetError())
+	}
+	if len(resp.File) != 1 || resp.File[0].GetName() != "test_pb.ts" {
+		t.Errorf("got files %v, want test_pb.ts", resp.File)
+	}
+	want := "test.proto:10:3: warning: extensions are not supported; ext is ignored\n"
+	if warnings.String() != want {
+		t.Errorf("warnings = %q, want %q", warnings.String(), want)
+	}
+}
+
+func TestPosition(t *testing.T) {
+	s := newSource(testRequest("A").ProtoFile[0])
+	tests := []struct {
+		path []int32
+		want string
+	}{
+		{nil, "test.proto"},
+		{[]int32{}, "test.proto:1:1"},
+		{[]int32{fileMessagePath, 0}, "test.proto:6:1"},
+		{[]int32{fileMessagePath, 0, messageFieldPath, 0}, "test.proto:7:3"},
+		{[]int32{fileMessagePath, 1}, "test.proto"},
+	}
+	for _, tt := range tests {
+		if got := s.position(tt.path); got != tt.want {
+			t.Errorf("position(%v) = %q, want %q", tt.path, got, tt.want)
+		}
+	}
+}
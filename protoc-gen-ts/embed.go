@@ -0,0 +1,54 @@
+package main
+
+import (
+	"encoding/base64"
+	"github.com/golang/protobuf/proto"
+	desc "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"strings"
+)
+
+// descriptorLineLength is the number of base64 characters written per line
+// of an embedded descriptor.
+const descriptorLineLength = 76
+
+// writeFileDescriptor exports the serialized FileDescriptorProto of the
+// current file as fileDescriptor, along with references to the descriptors of
+// the files it imports. It must be written after the rest of the file, so
+// that it knows which of their modules are imported. Files whose modules
+// aren't imported, and which aren't being generated, are referenced by name
+// and found in the registry when needed, so that files imported only for
+// their options, such as google/api/annotations.proto, aren't loaded.
+func writeFileDescriptor(w *writer, fdp *desc.FileDescriptorProto, mr *moduleResolver, libMod *modRef) {
+	if mr.opts.StripSourceInfo {
+		fdp = proto.Clone(fdp).(*desc.FileDescriptorProto)
+		fdp.SourceCodeInfo = nil
+	}
+	b, err := proto.Marshal(fdp)
+	if err != nil {
+		mr.src.fail(nil, "unable to serialize file descriptor: %v", err)
+	}
+	encoded := base64.StdEncoding.EncodeToString(b)
+	lines := []string{}
+	for len(encoded) > descriptorLineLength {
+		lines = append(lines, jsString(encoded[:descriptorLineLength]))
+		encoded = encoded[descriptorLineLength:]
+	}
+	lines = append(lines, jsString(encoded))
+
+	deps := []string{}
+	for _, dep := range fdp.Dependency {
+		if mr.references[dep] != nil || mr.generated[dep] {
+			deps = append(deps, mr.moduleFor(dep).alias+".fileDescriptor")
+		} else {
+			deps = append(deps, jsString(dep))
+		}
+	}
+
+	w.p("// fileDescriptor is the google.protobuf.FileDescriptorProto of %s.", fdp.GetName())
+	w.p("export const fileDescriptor = new %s.FileDescriptor(", libMod.alias)
+	w.p("  %s,", jsString(fdp.GetName()))
+	w.p("  %s,", strings.Join(lines, " +\n    "))
+	w.p("  [%s]", strings.Join(deps, ", "))
+	w.p(");")
+	w.ln()
+}
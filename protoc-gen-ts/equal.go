@@ -0,0 +1,281 @@
+package main
+
+import (
+	"fmt"
+	desc "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"strings"
+)
+
+// The generated equals(), clone() and hashCode() work field by field rather
+// than through reflection. The expressions below act on a single value of a
+// field, an element of a repeated field or a map value, which is set.
+
+func (f field) isFloat() bool {
+	t := f.fd.GetType()
+	return t == desc.FieldDescriptorProto_TYPE_DOUBLE || t == desc.FieldDescriptorProto_TYPE_FLOAT
+}
+
+// equal returns an expression which is true when a and b, values of the
+// field, are equal.
+func (f field) equal(libMod *modRef, a, b string) string {
+	pb := libMod.alias
+	switch {
+	case f.converted == timestampName:
+		return fmt.Sprintf("%s.getTime() === %s.getTime()", a, b)
+	case isStructType(f.converted):
+		return fmt.Sprintf("%s.Internal.jsonEqual(%s, %s)", pb, a, b)
+	case f.converted != "":
+		if eq := f.wrappedField().equalFunc(libMod); eq != "" {
+			return fmt.Sprintf("%s.Internal.optionalEqual(%s, %s, %s)", pb, a, b, eq)
+		}
+	case f.isMessage() && f.isLibraryType():
+		return fmt.Sprintf("%s.Internal.wireEqual(%s, %s)", pb, a, b)
+	case f.isMessage(), f.tsType() == "__long":
+		return fmt.Sprintf("%s.equals(%s)", a, b)
+	case f.fd.GetType() == desc.FieldDescriptorProto_TYPE_BYTES:
+		return fmt.Sprintf("%s.Internal.bytesEqual(%s, %s)", pb, a, b)
+	case f.isFloat():
+		return fmt.Sprintf("%s.Internal.floatEqual(%s, %s)", pb, a, b)
+	}
+	return a + " === " + b
+}
+
+// equalFunc returns a function comparing two values of the field, or "" if
+// they are compared with ===.
+func (f field) equalFunc(libMod *modRef) string {
+	eq := f.equal(libMod, "x", "y")
+	if eq == "x === y" {
+		return ""
+	}
+	if fn := strings.TrimSuffix(eq, "(x, y)"); fn != eq && !strings.ContainsAny(fn, "( ") {
+		return fn
+	}
+	return "(x, y) => " + eq
+}
+
+// cloneValue returns an expression for a deep copy of v, a value of the
+// field.
+func (f field) cloneValue(libMod *modRef, v string) string {
+	pb := libMod.alias
+	switch {
+	case f.converted == timestampName:
+		return fmt.Sprintf("new Date(%s.getTime())", v)
+	case f.converted == valueName:
+		return fmt.Sprintf("%s.Internal.cloneJSON(%s)", pb, v)
+	case isStructType(f.converted):
+		return fmt.Sprintf("%s.Internal.cloneJSON(%s) as %s", pb, v, f.convertedTsType())
+	case f.converted != "":
+		if c := f.wrappedField().cloneValue(libMod, v); c != v {
+			return fmt.Sprintf("%s === null ? null : %s", v, c)
+		}
+	case f.isMessage() && f.isLibraryType():
+		return fmt.Sprintf("%s.Internal.wireClone(%s, new %s())", pb, v, f.typeTsName)
+	case f.isMessage():
+		return v + ".clone()"
+	case f.fd.GetType() == desc.FieldDescriptorProto_TYPE_BYTES:
+		return v + ".slice()"
+	}
+	return v
+}
+
+// hash returns an expression for the hash of v, a value of the field.
+func (f field) hash(libMod *modRef, v string) string {
+	pb := libMod.alias
+	switch {
+	case f.converted == timestampName:
+		return fmt.Sprintf("%s.Internal.hashNumber(%s.getTime())", pb, v)
+	case isStructType(f.converted):
+		return fmt.Sprintf("%s.Internal.hashJSON(%s)", pb, v)
+	case f.converted != "":
+		return fmt.Sprintf("%s === null ? 0 : %s", v, f.wrappedField().hash(libMod, v))
+	case f.isMessage() && f.isLibraryType():
+		return fmt.Sprintf("%s.Internal.hashBytes(%s.Marshal(%s))", pb, pb, v)
+	case f.isMessage():
+		return v + ".hashCode()"
+	case f.tsType() == "__long":
+		return fmt.Sprintf("%s.Internal.hashLong(%s)", pb, v)
+	}
+	switch f.fd.GetType() {
+	case desc.FieldDescriptorProto_TYPE_BYTES:
+		return fmt.Sprintf("%s.Internal.hashBytes(%s)", pb, v)
+	case desc.FieldDescriptorProto_TYPE_STRING:
+		return fmt.Sprintf("%s.Internal.hashString(%s)", pb, v)
+	case desc.FieldDescriptorProto_TYPE_BOOL:
+		return fmt.Sprintf("%s.Internal.hashBool(%s)", pb, v)
+	}
+	return fmt.Sprintf("%s.Internal.hashNumber(%s)", pb, v)
+}
+
+// hashFunc returns a function hashing a value of the field.
+func (f field) hashFunc(libMod *modRef) string {
+	h := f.hash(libMod, "v")
+	if fn := strings.TrimSuffix(h, "(v)"); fn != h && !strings.ContainsAny(fn, "( ") {
+		return fn
+	}
+	return "v => " + h
+}
+
+// nullable reports whether a singular message field is null or undefined
+// when it is not set, rather than the null being a value of its type.
+func (f field) nullable() bool {
+	return f.converted == "" || f.converted == timestampName || (isStructType(f.converted) && f.converted != valueName)
+}
+
+// notCond negates the condition cond.
+func notCond(cond string) string {
+	if strings.Count(cond, " ") == 2 && strings.Contains(cond, " === ") {
+		return strings.Replace(cond, " === ", " !== ", 1)
+	}
+	depth := 0
+	for _, c := range cond {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ' ':
+			if depth == 0 {
+				return "!(" + cond + ")"
+			}
+		}
+	}
+	return "!" + cond
+}
+
+// writeEqualsMethods writes equals(), clone() and hashCode().
+func writeEqualsMethods(w *writer, name string, fields []*field, oneofs []*oneof, libMod *modRef, keepUnknown bool) {
+	pb := libMod.alias
+
+	w.p("// equals reports whether other holds the same values as the message.")
+	w.p("equals(other: %s): boolean {", name)
+	w.p("if (this === other) return true;")
+	for _, f := range fields {
+		if f.isOneofMember() {
+			continue
+		}
+		a, b := "this."+f.varName(), "other."+f.varName()
+		var cond string
+		switch {
+		case f.isMap:
+			_, mv := f.mapFields()
+			cond = fmt.Sprintf("%s.Internal.mapEqual(%s, %s)", pb, a, b)
+			if eq := mv.equalFunc(libMod); eq != "" {
+				cond = fmt.Sprintf("%s.Internal.mapEqual(%s, %s, %s)", pb, a, b, eq)
+			}
+		case f.isRepeated():
+			cond = fmt.Sprintf("%s.Internal.arrayEqual(%s, %s)", pb, a, b)
+			if eq := f.equalFunc(libMod); eq != "" {
+				cond = fmt.Sprintf("%s.Internal.arrayEqual(%s, %s, %s)", pb, a, b, eq)
+			}
+		case f.isMessage() && f.nullable():
+			cond = fmt.Sprintf("%s.Internal.optionalEqual(%s, %s, %s)", pb, a, b, f.equalFunc(libMod))
+		case f.hasPresence():
+			cond = fmt.Sprintf("this.%s() === other.%s() && %s", f.hasName(), f.hasName(), f.equal(libMod, a, b))
+		default:
+			cond = f.equal(libMod, a, b)
+		}
+		w.p("if (%s) return false;", notCond(cond))
+	}
+	for _, oo := range oneofs {
+		a, b := "this."+oo.name, "other."+oo.name
+		w.p("if (%s.kind !== %s.kind) return false;", a, b)
+		for _, f := range oo.fields {
+			class := oo.fqNamespace + "." + f.oneofClassName()
+			eq := f.equal(libMod, a+".value", b+".value")
+			w.p("if (%s instanceof %s && %s instanceof %s && %s) return false;", a, class, b, class, notCond(eq))
+		}
+	}
+	if keepUnknown {
+		w.p("if (!%s.Internal.unknownEqual(this.unknownFields, other.unknownFields)) return false;", pb)
+	}
+	w.p("return true;")
+	w.p("}")
+	w.ln()
+
+	w.p("// clone returns a deep copy of the message.")
+	w.p("clone(): %s {", name)
+	w.p("const m = new %s();", name)
+	for _, f := range fields {
+		if f.isOneofMember() {
+			continue
+		}
+		v := "this." + f.varName()
+		switch {
+		case f.isMap:
+			_, mv := f.mapFields()
+			w.p("for (const [k, v] of %s) {", v)
+			w.p("m.%s.set(k, %s);", f.varName(), mv.cloneValue(libMod, "v"))
+			w.p("}")
+		case f.isRepeated():
+			if c := f.cloneValue(libMod, "v"); c != "v" {
+				w.p("m.%s = %s.map(v => %s);", f.varName(), v, c)
+			} else {
+				w.p("m.%s = %s.slice();", f.varName(), v)
+			}
+		case f.isMessage() && f.nullable():
+			w.p("m.%s = %s ? %s : %s;", f.varName(), f.isUnset(v), f.unsetValue(), f.cloneValue(libMod, v))
+		case f.isMessage() && f.converted == valueName:
+			w.p("m.%s = %s ? undefined : %s;", f.varName(), f.isUnset(v), f.cloneValue(libMod, v))
+		case f.hasPresence():
+			w.p("if (this.%s()) m.%s = %s;", f.hasName(), f.varName(), f.cloneValue(libMod, v))
+		default:
+			w.p("m.%s = %s;", f.varName(), f.cloneValue(libMod, v))
+		}
+	}
+	for _, oo := range oneofs {
+		for _, f := range oo.fields {
+			class := oo.fqNamespace + "." + f.oneofClassName()
+			v := "this." + oo.name + ".value"
+			w.p("if (this.%s instanceof %s) m.%s = new %s(%s);", oo.name, class, oo.name, class, f.cloneValue(libMod, v))
+		}
+	}
+	if keepUnknown {
+		w.p("m.unknownFields = this.unknownFields.map(u => u.slice());")
+	}
+	w.p("return m;")
+	w.p("}")
+	w.ln()
+
+	w.p("// hashCode returns a hash of the message's values, which is equal for")
+	w.p("// equal messages and stable across runs.")
+	w.p("hashCode(): number {")
+	if len(fields) < 1 {
+		w.p("return 0;")
+		w.p("}")
+		return
+	}
+	w.p("let h = 0;")
+	for _, f := range fields {
+		if f.isOneofMember() {
+			continue
+		}
+		v := "this." + f.varName()
+		var h string
+		switch {
+		case f.isMap:
+			k, mv := f.mapFields()
+			hk := k.hashFunc(libMod)
+			if k.mapKeyCoercedType() != k.tsType() {
+				// 64 bit integer keys are held as strings.
+				hk = pb + ".Internal.hashString"
+			}
+			h = fmt.Sprintf("%s.Internal.hashMap(%s, %s, %s)", pb, v, hk, mv.hashFunc(libMod))
+		case f.isRepeated():
+			h = fmt.Sprintf("%s.Internal.hashArray(%s, %s)", pb, v, f.hashFunc(libMod))
+		case f.isMessage() && f.nullable():
+			h = fmt.Sprintf("%s ? 0 : %s", f.isUnset(v), f.hash(libMod, v))
+		default:
+			h = f.hash(libMod, v)
+		}
+		w.p("h = %s.Internal.hashCombine(h, %s);", pb, h)
+	}
+	for _, oo := range oneofs {
+		w.p("h = %s.Internal.hashCombine(h, this.%s.kind);", pb, oo.name)
+		for _, f := range oo.fields {
+			class := oo.fqNamespace + "." + f.oneofClassName()
+			w.p("if (this.%s instanceof %s) h = %s.Internal.hashCombine(h, %s);", oo.name, class, pb, f.hash(libMod, "this."+oo.name+".value"))
+		}
+	}
+	w.p("return h;")
+	w.p("}")
+}
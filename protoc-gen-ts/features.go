@@ -0,0 +1,163 @@
+package main
+
+import (
+	"fmt"
+	desc "github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// The range of editions the generator understands. proto2 and proto3 are
+// treated as editions with their own feature defaults.
+const (
+	minimumEdition = desc.Edition_EDITION_PROTO2
+	maximumEdition = desc.Edition_EDITION_2023
+)
+
+// features is the resolved set of edition features which affect code
+// generation. Features are resolved from the edition defaults, then
+// overridden by the file, each enclosing message, the oneof and finally the
+// field or enum itself.
+type features struct {
+	fieldPresence         desc.FeatureSet_FieldPresence
+	enumType              desc.FeatureSet_EnumType
+	repeatedFieldEncoding desc.FeatureSet_RepeatedFieldEncoding
+	utf8Validation        desc.FeatureSet_Utf8Validation
+	messageEncoding       desc.FeatureSet_MessageEncoding
+}
+
+var proto2Features = features{
+	fieldPresence:         desc.FeatureSet_EXPLICIT,
+	enumType:              desc.FeatureSet_CLOSED,
+	repeatedFieldEncoding: desc.FeatureSet_EXPANDED,
+	utf8Validation:        desc.FeatureSet_NONE,
+	messageEncoding:       desc.FeatureSet_LENGTH_PREFIXED,
+}
+
+var proto3Features = features{
+	fieldPresence:         desc.FeatureSet_IMPLICIT,
+	enumType:              desc.FeatureSet_OPEN,
+	repeatedFieldEncoding: desc.FeatureSet_PACKED,
+	utf8Validation:        desc.FeatureSet_VERIFY,
+	messageEncoding:       desc.FeatureSet_LENGTH_PREFIXED,
+}
+
+var edition2023Features = features{
+	fieldPresence:         desc.FeatureSet_EXPLICIT,
+	enumType:              desc.FeatureSet_OPEN,
+	repeatedFieldEncoding: desc.FeatureSet_PACKED,
+	utf8Validation:        desc.FeatureSet_VERIFY,
+	messageEncoding:       desc.FeatureSet_LENGTH_PREFIXED,
+}
+
+// fileEdition returns the edition of the file, mapping the legacy syntax
+// strings to their editions.
+func fileEdition(fdp *desc.FileDescriptorProto) (desc.Edition, error) {
+	switch fdp.GetSyntax() {
+	case "", "proto2":
+		return desc.Edition_EDITION_PROTO2, nil
+	case "proto3":
+		return desc.Edition_EDITION_PROTO3, nil
+	case "editions":
+		e := fdp.GetEdition()
+		if e < minimumEdition || e > maximumEdition {
+			return e, fmt.Errorf("unsupported edition: %s", e)
+		}
+		return e, nil
+	}
+	return desc.Edition_EDITION_UNKNOWN, fmt.Errorf("unsupported syntax: %s", fdp.GetSyntax())
+}
+
+// fileFeatures returns the features of a file, which must have a supported
+// edition.
+func fileFeatures(fdp *desc.FileDescriptorProto) features {
+	var f features
+	switch e, _ := fileEdition(fdp); e {
+	case desc.Edition_EDITION_PROTO2:
+		f = proto2Features
+	case desc.Edition_EDITION_PROTO3:
+		f = proto3Features
+	default:
+		f = edition2023Features
+	}
+	return f.merge(fdp.GetOptions().GetFeatures())
+}
+
+// merge returns f overridden by any features explicitly set in fs.
+func (f features) merge(fs *desc.FeatureSet) features {
+	if fs == nil {
+		return f
+	}
+	if fs.FieldPresence != nil {
+		f.fieldPresence = fs.GetFieldPresence()
+	}
+	if fs.EnumType != nil {
+		f.enumType = fs.GetEnumType()
+	}
+	if fs.RepeatedFieldEncoding != nil {
+		f.repeatedFieldEncoding = fs.GetRepeatedFieldEncoding()
+	}
+	if fs.Utf8Validation != nil {
+		f.utf8Validation = fs.GetUtf8Validation()
+	}
+	if fs.MessageEncoding != nil {
+		f.messageEncoding = fs.GetMessageEncoding()
+	}
+	return f
+}
+
+// forMessage returns the features of a message nested in f's scope. The
+// fields of map entries are always length prefixed, as protoc encodes them.
+func (f features) forMessage(dp *desc.DescriptorProto) features {
+	f = f.merge(dp.GetOptions().GetFeatures())
+	if dp.GetOptions().GetMapEntry() {
+		f.messageEncoding = desc.FeatureSet_LENGTH_PREFIXED
+	}
+	return f
+}
+
+// forField returns the features of a field declared in f's scope. Fields
+// declared in a oneof should be resolved against the oneof's features.
+func (f features) forField(fd *desc.FieldDescriptorProto) features {
+	f = f.merge(fd.GetOptions().GetFeatures())
+
+	// The legacy syntaxes express some features through labels, types and
+	// options instead.
+	if fd.GetLabel() == desc.FieldDescriptorProto_LABEL_REQUIRED {
+		f.fieldPresence = desc.FeatureSet_LEGACY_REQUIRED
+	}
+	if fd.GetProto3Optional() {
+		f.fieldPresence = desc.FeatureSet_EXPLICIT
+	}
+	if fd.GetOptions() != nil && fd.GetOptions().Packed != nil {
+		f.repeatedFieldEncoding = desc.FeatureSet_EXPANDED
+		if fd.GetOptions().GetPacked() {
+			f.repeatedFieldEncoding = desc.FeatureSet_PACKED
+		}
+	}
+	if fd.GetType() == desc.FieldDescriptorProto_TYPE_GROUP {
+		f.messageEncoding = desc.FeatureSet_DELIMITED
+	}
+	return f
+}
+
+// enumFeatures returns the features of an enum declared in fdp.
+func enumFeatures(fdp *desc.FileDescriptorProto, edp *desc.EnumDescriptorProto) features {
+	ff := fileFeatures(fdp)
+	if f, ok := findEnumFeatures(ff, fdp.EnumType, fdp.MessageType, edp); ok {
+		return f
+	}
+	return ff.merge(edp.GetOptions().GetFeatures())
+}
+
+func findEnumFeatures(parent features, enums []*desc.EnumDescriptorProto, dps []*desc.DescriptorProto, edp *desc.EnumDescriptorProto) (features, bool) {
+	for _, e := range enums {
+		if e == edp {
+			return parent.merge(e.GetOptions().GetFeatures()), true
+		}
+	}
+	for _, dp := range dps {
+		if f, ok := findEnumFeatures(parent.forMessage(dp), dp.EnumType, dp.NestedType, edp); ok {
+			return f, true
+		}
+	}
+	return features{}, false
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"fmt"
+)
+
+// isLibraryType reports whether the field's message type is generated as a
+// runtime library class or a converted type, which have no Init interface.
+func (f field) isLibraryType() bool {
+	return libraryType(f.fd.GetTypeName(), f.mr.opts) != ""
+}
+
+// initElemType is the type accepted for a single value of the field by the
+// Init interface: messages may be given as their own Init.
+func (f field) initElemType() string {
+	if f.isMessage() && !f.isLibraryType() {
+		return fmt.Sprintf("%s | %sInit", f.typeTsName, f.typeTsName)
+	}
+	return f.tsType()
+}
+
+// initType is the type of the field in the Init interface.
+func (f field) initType() string {
+	switch {
+	case f.isMap:
+		k, v := f.mapFields()
+		return fmt.Sprintf("Map<%s, %s>", k.mapKeyCoercedType(), v.initElemType())
+	case f.isRepeated() && f.isMessage() && !f.isLibraryType():
+		return "(" + f.initElemType() + ")[]"
+	case f.isRepeated():
+		return f.initElemType() + "[]"
+	}
+	return f.initElemType()
+}
+
+// fromInit returns an expression converting the Init value v to the field's
+// type.
+func (f field) fromInit(libMod *modRef, v string) string {
+	if f.isMessage() && !f.isLibraryType() {
+		return fmt.Sprintf("%s.Internal.fromInit(%s, %s)", libMod.alias, f.typeTsName, v)
+	}
+	return v
+}
+
+// writeInitInterface writes the <Message>Init interface accepted by the
+// constructor, in which every field is optional. The members of a oneof may
+// be given directly, or the oneof as a whole.
+func writeInitInterface(w *writer, name string, fields []*field, oneofs []*oneof) {
+	if len(fields) < 1 {
+		w.p("export interface %sInit {}", name)
+		w.ln()
+		return
+	}
+	w.p("export interface %sInit {", name)
+	for _, f := range fields {
+		if !f.isOneofMember() {
+			f.mr.src.writeComment(w, f.path, f.isDeprecated())
+			w.p("%s?: %s;", f.varName(), f.initType())
+		}
+	}
+	for _, oo := range oneofs {
+		oo.fields[0].mr.src.writeComment(w, oo.path, false)
+		w.p("%s?: %s.%s;", oo.name, oo.fqNamespace, oo.typeName)
+		for _, f := range oo.fields {
+			f.mr.src.writeComment(w, f.path, f.isDeprecated())
+			w.p("%s?: %s;", f.varName(), f.initType())
+		}
+	}
+	w.p("}")
+	w.ln()
+}
+
+// writeInitAssignments writes the constructor statements copying the fields
+// given in init.
+func writeInitAssignments(w *writer, fields []*field, oneofs []*oneof, libMod *modRef) {
+	w.p("if (init !== undefined) {")
+	for _, f := range fields {
+		if f.isOneofMember() {
+			continue
+		}
+		v := "init." + f.varName()
+		switch {
+		case f.isMap:
+			_, mv := f.mapFields()
+			w.p("if (%s !== undefined) {", v)
+			w.p("for (const [k, v] of %s) {", v)
+			w.p("this.%s.set(k, %s);", f.varName(), mv.fromInit(libMod, "v"))
+			w.p("}")
+			w.p("}")
+		case f.isRepeated():
+			if conv := f.fromInit(libMod, "v"); conv != "v" {
+				w.p("if (%s !== undefined) this.%s = %s.map(v => %s);", v, f.varName(), v, conv)
+			} else {
+				w.p("if (%s !== undefined) this.%s = %s.slice();", v, f.varName(), v)
+			}
+		default:
+			w.p("if (%s !== undefined) this.%s = %s;", v, f.varName(), f.fromInit(libMod, v))
+		}
+	}
+	for _, oo := range oneofs {
+		w.p("if (init.%s !== undefined) this.%s = init.%s;", oo.name, oo.name, oo.name)
+		for _, f := range oo.fields {
+			v := "init." + f.varName()
+			w.p("if (%s !== undefined) this.%s = new %s.%s(%s);", v, oo.name, oo.fqNamespace, f.oneofClassName(), f.fromInit(libMod, v))
+		}
+	}
+	w.p("}")
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"github.com/golang/protobuf/proto"
+	desc "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	ppb "github.com/golang/protobuf/protoc-gen-go/plugin"
+	"testing"
+)
+
+// namesRequest returns a request to generate test.proto, declaring the
+// given messages and enum BazInit in package test. other.proto, in the same
+// package, declares message OtherInit and isn't generated.
+func namesRequest(messages ...*desc.DescriptorProto) *ppb.CodeGeneratorRequest {
+	return &ppb.CodeGeneratorRequest{
+		FileToGenerate: []string{"test.proto"},
+		ProtoFile: []*desc.FileDescriptorProto{{
+			Name:        proto.String("other.proto"),
+			Package:     proto.String("test"),
+			MessageType: []*desc.DescriptorProto{{Name: proto.String("OtherInit")}},
+		}, {
+			Name:        proto.String("test.proto"),
+			Package:     proto.String("test"),
+			MessageType: messages,
+			EnumType: []*desc.EnumDescriptorProto{{
+				Name: proto.String("BazInit"),
+				Value: []*desc.EnumValueDescriptorProto{
+					{Name: proto.String("A"), Number: proto.Int32(0)},
+				},
+			}},
+		}},
+	}
+}
+
+func message(name string, nested ...*desc.DescriptorProto) *desc.DescriptorProto {
+	return &desc.DescriptorProto{Name: proto.String(name), NestedType: nested}
+}
+
+// checkNames generates each request, and checks that it failed with want, or
+// succeeded if want is "".
+func checkNames(t *testing.T, tests []struct {
+	req  *ppb.CodeGeneratorRequest
+	want string
+}) {
+	_, restore := captureWarnings()
+	defer restore()
+	for i, tt := range tests {
+		resp := gen(tt.req)
+		if resp.GetError() != tt.want {
+			t.Errorf("%d: error = %q, want %q", i, resp.GetError(), tt.want)
+		}
+	}
+}
+
+func TestInitNameCollision(t *testing.T) {
+	checkNames(t, []struct {
+		req  *ppb.CodeGeneratorRequest
+		want string
+	}{
+		{namesRequest(message("Foo"), message("Bar")), ""},
+		{
+			namesRequest(message("Foo"), message("FooInit")),
+			"test.proto: message Foo generates interface FooInit, which collides with the message of that name",
+		},
+		{
+			namesRequest(message("Baz")),
+			"test.proto: message Baz generates interface BazInit, which collides with the enum of that name",
+		},
+		{
+			namesRequest(message("Foo", message("Bar"), message("BarInit"))),
+			"test.proto: message Bar generates interface BarInit, which collides with the message of that name",
+		},
+		// Nested types are in the namespace of their message.
+		{namesRequest(message("Foo", message("FooInit"))), ""},
+		{namesRequest(message("Foo"), message("Bar", message("FooInit"))), ""},
+		// Other files are generated into other modules.
+		{namesRequest(message("Other")), ""},
+	})
+}
+
+// withFields returns dp with an optional int32 field of each name, and a
+// oneof holding a last field if oneofName isn't "".
+func withFields(dp *desc.DescriptorProto, oneofName string, names ...string) *desc.DescriptorProto {
+	for i, name := range names {
+		fd := &desc.FieldDescriptorProto{
+			Name:   proto.String(name),
+			Number: proto.Int32(int32(i + 1)),
+			Label:  desc.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:   desc.FieldDescriptorProto_TYPE_INT32.Enum(),
+		}
+		if oneofName != "" && i == len(names)-1 {
+			fd.OneofIndex = proto.Int32(0)
+			dp.OneofDecl = []*desc.OneofDescriptorProto{{Name: proto.String(oneofName)}}
+		}
+		dp.Field = append(dp.Field, fd)
+	}
+	return dp
+}
+
+func TestPresenceMethodCollision(t *testing.T) {
+	checkNames(t, []struct {
+		req  *ppb.CodeGeneratorRequest
+		want string
+	}{
+		{namesRequest(withFields(message("Foo"), "", "a", "b")), ""},
+		{
+			namesRequest(withFields(message("Foo"), "", "a", "has_a")),
+			"test.proto: field a generates method has_a, which collides with field has_a",
+		},
+		{
+			namesRequest(withFields(message("Foo"), "", "clear_a", "a")),
+			"test.proto: field a generates method clear_a, which collides with field clear_a",
+		},
+		{
+			namesRequest(withFields(message("Foo"), "has_a", "a", "b")),
+			"test.proto: field a generates method has_a, which collides with oneof has_a",
+		},
+		// Oneof members have no presence methods of their own.
+		{namesRequest(withFields(message("Foo"), "o", "has_a", "a")), ""},
+	})
+}
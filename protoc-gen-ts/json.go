@@ -0,0 +1,365 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	desc "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"strings"
+)
+
+// This file generates the proto3 JSON mapping for messages and enums:
+// https://developers.google.com/protocol-buffers/docs/proto3#json
+
+// jsString quotes s as a javascript string literal.
+func jsString(s string) string {
+	b, _ := json.Marshal(s)
+	return string(b)
+}
+
+// jsonName is the key of the field in JSON. protoc always fills in json_name,
+// but it is derived here as protoc would if missing.
+func (f field) jsonName() string {
+	if f.fd.JsonName != nil {
+		return f.fd.GetJsonName()
+	}
+	name := ""
+	upper := false
+	for _, c := range f.fd.GetName() {
+		if c == '_' {
+			upper = true
+			continue
+		}
+		if upper {
+			name += strings.ToUpper(string(c))
+			upper = false
+		} else {
+			name += string(c)
+		}
+	}
+	return name
+}
+
+// jsonKey returns an expression for the key the field is written with.
+func (f field) jsonKey() string {
+	if f.jsonName() == f.fd.GetName() {
+		return jsString(f.jsonName())
+	}
+	return fmt.Sprintf("(o.useProtoNames ? %s : %s)", jsString(f.fd.GetName()), jsString(f.jsonName()))
+}
+
+// toJSON returns an expression converting a single value v of the field's
+// type to JSON.
+func (f field) toJSON(libMod *modRef, v string) string {
+	switch f.fd.GetType() {
+	case desc.FieldDescriptorProto_TYPE_FLOAT,
+		desc.FieldDescriptorProto_TYPE_DOUBLE:
+		return fmt.Sprintf("%s.Internal.floatToJSON(%s)", libMod.alias, v)
+	case desc.FieldDescriptorProto_TYPE_INT64,
+		desc.FieldDescriptorProto_TYPE_UINT64,
+		desc.FieldDescriptorProto_TYPE_SINT64,
+		desc.FieldDescriptorProto_TYPE_FIXED64,
+		desc.FieldDescriptorProto_TYPE_SFIXED64:
+		return v + ".toString()"
+	case desc.FieldDescriptorProto_TYPE_BYTES:
+		return fmt.Sprintf("%s.Internal.bytesToJSON(%s)", libMod.alias, v)
+	case desc.FieldDescriptorProto_TYPE_ENUM:
+		return fmt.Sprintf("%sToJSON(%s)", f.typeTsName, v)
+	case desc.FieldDescriptorProto_TYPE_MESSAGE,
+		desc.FieldDescriptorProto_TYPE_GROUP:
+		return f.toMessage(v) + ".ToJSON(o)"
+	}
+	return v
+}
+
+// fromJSON returns an expression converting the JSON value v to the field's
+// scalar type. Enums and messages are handled by writeFromJSON.
+func (f field) fromJSON(libMod *modRef, v string) string {
+	conv := ""
+	switch f.fd.GetType() {
+	case desc.FieldDescriptorProto_TYPE_STRING:
+		conv = "stringFromJSON"
+	case desc.FieldDescriptorProto_TYPE_BYTES:
+		conv = "bytesFromJSON"
+	case desc.FieldDescriptorProto_TYPE_INT32,
+		desc.FieldDescriptorProto_TYPE_SINT32,
+		desc.FieldDescriptorProto_TYPE_SFIXED32:
+		conv = "int32FromJSON"
+	case desc.FieldDescriptorProto_TYPE_UINT32,
+		desc.FieldDescriptorProto_TYPE_FIXED32:
+		conv = "uint32FromJSON"
+	case desc.FieldDescriptorProto_TYPE_INT64,
+		desc.FieldDescriptorProto_TYPE_SINT64,
+		desc.FieldDescriptorProto_TYPE_SFIXED64:
+		conv = "int64FromJSON"
+	case desc.FieldDescriptorProto_TYPE_UINT64,
+		desc.FieldDescriptorProto_TYPE_FIXED64:
+		conv = "uint64FromJSON"
+	case desc.FieldDescriptorProto_TYPE_FLOAT:
+		conv = "floatFromJSON"
+	case desc.FieldDescriptorProto_TYPE_DOUBLE:
+		conv = "doubleFromJSON"
+	case desc.FieldDescriptorProto_TYPE_BOOL:
+		conv = "boolFromJSON"
+	default:
+		panic(fmt.Errorf("unknown JSON reader for fd type: %+v", f.fd.GetType()))
+	}
+	return fmt.Sprintf("%s.Internal.%s(%s)", libMod.alias, conv, v)
+}
+
+// mapKeyFromJSON converts a JSON object key to the coerced map key type.
+func (f field) mapKeyFromJSON(libMod *modRef, k string) string {
+	switch f.fd.GetType() {
+	case desc.FieldDescriptorProto_TYPE_STRING:
+		return k
+	case desc.FieldDescriptorProto_TYPE_BOOL:
+		return fmt.Sprintf("%s.Internal.boolKeyFromJSON(%s)", libMod.alias, k)
+	}
+	v := f.fromJSON(libMod, k)
+	if f.tsType() == "__long" {
+		return f.mapKeyCoerce(v)
+	}
+	return v
+}
+
+// writeFromJSON writes the statement produced by stmt for the JSON value v
+// converted to the field's type. Enum value names which are unknown and
+// ignored are dropped.
+func (f field) writeFromJSON(w *writer, libMod *modRef, v string, stmt func(v string) string) {
+	switch {
+	case f.isMessage():
+		w.p("{")
+		w.p("let msg = new %s();", f.typeTsName)
+		w.p("msg.MergeFromJSON(%s, o);", v)
+		w.p(stmt(f.fromMessage("msg")))
+		w.p("}")
+	case f.fd.GetType() == desc.FieldDescriptorProto_TYPE_ENUM:
+		w.p("{")
+		w.p("let e = %sFromJSON(%s, o);", f.typeTsName, v)
+		w.p("if (e !== undefined) {")
+		w.p(stmt("e"))
+		w.p("}")
+		w.p("}")
+	default:
+		w.p(stmt(f.fromJSON(libMod, v)))
+	}
+}
+
+// writeMergeFromJSON writes the case reading the field from the JSON value v.
+func (f field) writeMergeFromJSON(w *writer, libMod *modRef, v string) {
+	w.p("case %s:", jsString(f.jsonName()))
+	if f.jsonName() != f.fd.GetName() {
+		w.p("case %s:", jsString(f.fd.GetName()))
+	}
+	switch {
+	case f.isMap:
+		k, mv := f.mapFields()
+		w.p("{")
+		w.p("const m = %s.Internal.objectFromJSON(%s);", libMod.alias, v)
+		w.p("for (const mk in m) {")
+		mv.writeFromJSON(w, libMod, "m[mk]", func(e string) string {
+			return fmt.Sprintf("this.%s.set(%s, %s);", f.varName(), k.mapKeyFromJSON(libMod, "mk"), e)
+		})
+		w.p("}")
+		w.p("}")
+	case f.isRepeated():
+		w.p("for (const elem of %s.Internal.arrayFromJSON(%s)) {", libMod.alias, v)
+		f.writeFromJSON(w, libMod, "elem", func(e string) string {
+			return fmt.Sprintf("this.%s.push(%s);", f.varName(), e)
+		})
+		w.p("}")
+	case f.isOneofMember():
+		oo := f.oneof
+		f.writeFromJSON(w, libMod, v, func(e string) string {
+			return fmt.Sprintf("this.%s = new %s.%s(%s);", oo.name, oo.fqNamespace, f.oneofClassName(), e)
+		})
+	case f.isMessage() && f.converted == "":
+		w.p("if (this.%s == null) this.%s = new %s();", f.varName(), f.varName(), f.typeTsName)
+		w.p("this.%s.MergeFromJSON(%s, o);", f.varName(), v)
+	default:
+		f.writeFromJSON(w, libMod, v, func(e string) string {
+			return fmt.Sprintf("this.%s = %s;", f.varName(), e)
+		})
+	}
+	w.p("break;")
+}
+
+// jsonNonDefault returns a condition which is true when the field, which
+// does not track presence, differs from its default value.
+func (f field) jsonNonDefault() string {
+	switch {
+	case f.isMap:
+		return fmt.Sprintf("this.%s.size > 0", f.varName())
+	case f.isRepeated(), f.fd.GetType() == desc.FieldDescriptorProto_TYPE_BYTES:
+		return fmt.Sprintf("this.%s.length > 0", f.varName())
+	case f.isMessage():
+		return f.isSet("this." + f.varName())
+	case f.tsType() == "__long":
+		return fmt.Sprintf("!this.%s.isZero()", f.varName())
+	}
+	return fmt.Sprintf("this.%s != %s", f.varName(), f.defaultValue())
+}
+
+// writeToJSON writes the statements adding the field to the JSON object j.
+// Fields with their default value are only written if o.emitDefaults is set.
+func (f field) writeToJSON(w *writer, libMod *modRef) {
+	cond := f.jsonNonDefault()
+	if f.hasPresence() {
+		cond = fmt.Sprintf("this.%s()", f.hasName())
+	}
+	w.p("if (o.emitDefaults || %s) {", cond)
+	switch {
+	case f.isMap:
+		_, mv := f.mapFields()
+		w.p("const m: %s.JsonObject = {};", libMod.alias)
+		w.p("for (const [k, v] of this.%s) {", f.varName())
+		w.p("m[String(k)] = %s;", mv.toJSON(libMod, "v"))
+		w.p("}")
+		w.p("j[%s] = m;", f.jsonKey())
+	case f.isRepeated():
+		if conv := f.toJSON(libMod, "elem"); conv != "elem" {
+			w.p("j[%s] = this.%s.map(elem => %s);", f.jsonKey(), f.varName(), conv)
+		} else {
+			w.p("j[%s] = this.%s.slice();", f.jsonKey(), f.varName())
+		}
+	case f.isMessage():
+		w.p("const msg = this.%s;", f.varName())
+		w.p("j[%s] = %s ? null : %s;", f.jsonKey(), f.isUnset("msg"), f.toJSON(libMod, "msg"))
+	default:
+		w.p("j[%s] = %s;", f.jsonKey(), f.toJSON(libMod, "this."+f.varName()))
+	}
+	w.p("}")
+}
+
+// jsonNullableKeys returns the conditions excluding the keys of singular
+// google.protobuf.Value fields from those skipped when null, as null is a
+// Value rather than the absence of one.
+func jsonNullableKeys(fields []*field) string {
+	cond := ""
+	for _, f := range fields {
+		if f.fd.GetTypeName() != valueName || f.isRepeated() {
+			continue
+		}
+		cond += fmt.Sprintf(" && k != %s", jsString(f.jsonName()))
+		if f.jsonName() != f.fd.GetName() {
+			cond += fmt.Sprintf(" && k != %s", jsString(f.fd.GetName()))
+		}
+	}
+	return cond
+}
+
+// toJSONUsesOptions reports whether the generated ToJSON references its
+// options, so that an unused parameter can be avoided.
+func toJSONUsesOptions(fields []*field) bool {
+	for _, f := range fields {
+		if !f.isOneofMember() || f.isMessage() || f.jsonName() != f.fd.GetName() {
+			return true
+		}
+	}
+	return false
+}
+
+// writeJSONMethods writes MergeFromJSON and ToJSON. The keys of omitted
+// fields are accepted and ignored.
+func writeJSONMethods(w *writer, fields, omitted []*field, oneofs []*oneof, libMod *modRef) {
+	// MergeFromJSON
+	w.p("MergeFromJSON(j: %s.JsonValue, o: %s.JsonOptions = {}): void {", libMod.alias, libMod.alias)
+	if len(fields) < 1 && len(omitted) < 1 {
+		w.p("for (const k in %s.Internal.objectFromJSON(j)) {", libMod.alias)
+		w.p("%s.Internal.unknownFieldFromJSON(k, o);", libMod.alias)
+		w.p("}")
+	} else {
+		w.p("const obj = %s.Internal.objectFromJSON(j);", libMod.alias)
+		w.p("for (const k in obj) {")
+		w.p("const v = obj[k];")
+		w.p("if (v === null%s) {", jsonNullableKeys(fields))
+		w.p("continue;")
+		w.p("}")
+		w.p("switch (k) {")
+		for _, f := range fields {
+			f.writeMergeFromJSON(w, libMod, "v")
+		}
+		for _, f := range omitted {
+			w.p("case %s:", jsString(f.jsonName()))
+			if f.jsonName() != f.fd.GetName() {
+				w.p("case %s:", jsString(f.fd.GetName()))
+			}
+		}
+		if len(omitted) > 0 {
+			w.p("break;")
+		}
+		w.p("default:")
+		w.p("%s.Internal.unknownFieldFromJSON(k, o);", libMod.alias)
+		w.p("}") // switch
+		w.p("}") // for
+	}
+	w.p("}") // MergeFromJSON
+	w.ln()
+
+	// ToJSON
+	if len(fields) < 1 {
+		w.p("ToJSON(_: %s.JsonOptions = {}): %s.JsonValue {", libMod.alias, libMod.alias)
+		w.p("return {};")
+		w.p("}")
+		return
+	}
+	opts := "o"
+	if !toJSONUsesOptions(fields) {
+		opts = "_"
+	}
+	w.p("ToJSON(%s: %s.JsonOptions = {}): %s.JsonValue {", opts, libMod.alias, libMod.alias)
+	w.p("const j: %s.JsonObject = {};", libMod.alias)
+	for _, f := range fields {
+		if f.isOneofMember() {
+			continue
+		}
+		f.writeToJSON(w, libMod)
+	}
+	for _, oo := range oneofs {
+		w.p("switch (this.%s.kind) {", oo.name)
+		for _, f := range oo.fields {
+			value := fmt.Sprintf("(this.%s as %s.%s).value", oo.name, oo.fqNamespace, f.oneofClassName())
+			w.p("case %d:", f.fd.GetNumber())
+			if f.isMessage() {
+				w.p("{")
+				w.p("const msg = %s;", value)
+				w.p("j[%s] = %s;", f.jsonKey(), f.toJSON(libMod, fmt.Sprintf("(%s ? %s : msg)", f.isUnset("msg"), f.newMessage())))
+				w.p("}")
+			} else {
+				w.p("j[%s] = %s;", f.jsonKey(), f.toJSON(libMod, value))
+			}
+			w.p("break;")
+		}
+		w.p("}")
+	}
+	w.p("return j;")
+	w.p("}") // ToJSON
+}
+
+// writeEnumJSON writes the functions converting values of an enum to and
+// from JSON. Values are written by name, unless they are unknown.
+func writeEnumJSON(w *writer, edp *desc.EnumDescriptorProto, name string, libMod *modRef) {
+	w.p("export function %sToJSON(v: %s): string | number {", name, name)
+	w.p("switch (v as number) {")
+	seen := map[int32]bool{}
+	for _, v := range edp.Value {
+		if seen[v.GetNumber()] {
+			continue
+		}
+		seen[v.GetNumber()] = true
+		w.p("case %d:", v.GetNumber())
+		w.p("return %s;", jsString(v.GetName()))
+	}
+	w.p("}")
+	w.p("return v;")
+	w.p("}")
+	w.ln()
+	w.p("export function %sFromJSON(v: %s.JsonValue, o: %s.JsonOptions = {}): %s | undefined {", name, libMod.alias, libMod.alias, name)
+	w.p("switch (v) {")
+	for _, v := range edp.Value {
+		w.p("case %s:", jsString(v.GetName()))
+		w.p("return %s.%s;", name, v.GetName())
+	}
+	w.p("}")
+	w.p("return %s.Internal.enumFromJSON(v, o);", libMod.alias)
+	w.p("}")
+}
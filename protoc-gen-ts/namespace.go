@@ -82,7 +82,7 @@ func (n *Namespace) get(create bool, parts []string) *Namespace {
 }
 
 // From any point in the namespace tree, decend to the root and then back up to
-// the target namespace.
+// the target namespace. Returns nil if there is no such namespace.
 func (n *Namespace) FindFullyQualifiedNamespace(fqns string) *Namespace {
 	if fqns == "" {
 		fqns = "." //ugh, hax.
@@ -96,11 +96,7 @@ func (n *Namespace) FindFullyQualifiedNamespace(fqns string) *Namespace {
 		return n
 	}
 
-	found := n.get(false, strings.Split(strings.TrimPrefix(fqns, "."), "."))
-	if found != nil {
-		return found
-	}
-	panic(fmt.Errorf("unable to find target namespace: %s", fqns))
+	return n.get(false, strings.Split(strings.TrimPrefix(fqns, "."), "."))
 }
 
 func (n *Namespace) Parse(fdp *desc.FileDescriptorProto) {
@@ -121,7 +117,7 @@ func (n *Namespace) Parse(fdp *desc.FileDescriptorProto) {
 	// Messages, recurse.
 	for _, dp := range fdp.MessageType {
 		childNames := childns.Names.get(true, *dp.Name)
-		childNames.descriptor = fdp.MessageType
+		childNames.descriptor = dp
 		childNames.fileDescriptor = fdp
 		childNames.parseDescriptor(dp, fdp)
 	}
@@ -161,14 +157,14 @@ func mustFullyQualified(fqn string) {
 //   e.g. ".foo" "bar.baz"
 // and also returns the type descriptor and file descriptor in which it is
 // contained.
-func (n *Namespace) FindFullyQualifiedName(fqn string) (string, string, interface{}, *desc.FileDescriptorProto) {
+func (n *Namespace) FindFullyQualifiedName(fqn string) (string, string, interface{}, *desc.FileDescriptorProto, error) {
 	mustFullyQualified(fqn)
 	ns, name, i, fdp := n.find(fqn, true)
 	if i == nil {
-		panic("couldn't resolve name: " + fqn)
+		return "", "", nil, nil, fmt.Errorf("couldn't resolve name: %s", fqn)
 	}
 	ns = strings.TrimSuffix(ns, ".")
-	return ns, name, i, fdp
+	return ns, name, i, fdp, nil
 }
 
 func (n *Namespace) find(fqn string, checkParent bool) (string, string, interface{}, *desc.FileDescriptorProto) {
@@ -190,7 +186,7 @@ func (n *Namespace) find(fqn string, checkParent bool) (string, string, interfac
 	// Try our ancestor namespace.
 	// TODO: this will revist n [us] multiple times! We could optimize.
 	if checkParent && n.parent != nil {
-		return n.parent.FindFullyQualifiedName(fqn)
+		return n.parent.find(fqn, true)
 	}
 	return "", "", nil, nil
 }
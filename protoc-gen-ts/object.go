@@ -0,0 +1,295 @@
+package main
+
+import (
+	"fmt"
+	desc "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"strings"
+)
+
+// The plain object form of a message, I<Message>, holds only serializable
+// values: no classes, Maps or longs. Fields are generated as the options
+// object_int64, object_bytes and object_maps select, and well known types
+// generated as library types are held as their JSON.
+
+// isUnsigned reports whether the field is an unsigned 64 bit integer.
+func (f field) isUnsigned() bool {
+	t := f.fd.GetType()
+	return t == desc.FieldDescriptorProto_TYPE_UINT64 || t == desc.FieldDescriptorProto_TYPE_FIXED64
+}
+
+// objectElemType is the type of a single value of the field in the object
+// form.
+func (f field) objectElemType() string {
+	switch {
+	case f.converted == timestampName || (f.converted == "" && f.isMessage() && f.isLibraryType()):
+		return "string"
+	case isStructType(f.converted):
+		return f.tsType()
+	case f.converted != "":
+		return f.wrappedField().objectElemType() + " | null"
+	case f.isMessage():
+		return objectTypeName(f.typeTsName)
+	case f.tsType() == "__long":
+		switch f.mr.opts.ObjectInt64 {
+		case "number":
+			return "number"
+		case "long":
+			return "__long"
+		}
+		return "string"
+	case f.fd.GetType() == desc.FieldDescriptorProto_TYPE_BYTES:
+		switch f.mr.opts.ObjectBytes {
+		case "array":
+			return "number[]"
+		case "uint8array":
+			return "Uint8Array"
+		}
+		return "string"
+	}
+	return f.tsType()
+}
+
+// objectType is the type of the field in the object form.
+func (f field) objectType() string {
+	switch {
+	case f.isMap:
+		k, v := f.mapFields()
+		if f.mr.opts.ObjectMaps == "entries" {
+			return fmt.Sprintf("[%s, %s][]", k.mapKeyCoercedType(), v.objectElemType())
+		}
+		return fmt.Sprintf("{ [k: string]: %s }", v.objectElemType())
+	case f.isRepeated() && strings.Contains(f.objectElemType(), " "):
+		return "(" + f.objectElemType() + ")[]"
+	case f.isRepeated():
+		return f.objectElemType() + "[]"
+	}
+	return f.objectElemType()
+}
+
+// objectTypeName returns the name of the object form of the message class
+// typeTsName, which is declared alongside it.
+func objectTypeName(typeTsName string) string {
+	for i := len(typeTsName) - 1; i >= 0; i-- {
+		if typeTsName[i] == '.' {
+			return typeTsName[:i+1] + "I" + typeTsName[i+1:]
+		}
+	}
+	return "I" + typeTsName
+}
+
+// toObject returns an expression converting v, a value of the field, to its
+// object form.
+func (f field) toObject(libMod *modRef, v string) string {
+	switch {
+	case f.converted == timestampName || (f.converted == "" && f.isMessage() && f.isLibraryType()):
+		return fmt.Sprintf("%s.ToJSON() as string", f.toMessage(v))
+	case isStructType(f.converted):
+		return v
+	case f.converted != "":
+		if conv := f.wrappedField().toObject(libMod, v); conv != v {
+			return fmt.Sprintf("%s === null ? null : %s", v, conv)
+		}
+		return v
+	case f.isMessage():
+		return v + ".toObject()"
+	case f.tsType() == "__long":
+		switch f.mr.opts.ObjectInt64 {
+		case "number":
+			return v + ".toNumber()"
+		case "long":
+			return v
+		}
+		return v + ".toString()"
+	case f.fd.GetType() == desc.FieldDescriptorProto_TYPE_BYTES:
+		switch f.mr.opts.ObjectBytes {
+		case "array":
+			return fmt.Sprintf("Array.from(%s)", v)
+		case "uint8array":
+			return fmt.Sprintf("new Uint8Array(%s)", v)
+		}
+		return fmt.Sprintf("%s.Internal.bytesToJSON(%s)", libMod.alias, v)
+	}
+	return v
+}
+
+// fromObject returns an expression converting v, the object form of a value
+// of the field, to the field's type.
+func (f field) fromObject(libMod *modRef, v string) string {
+	switch {
+	case f.converted == timestampName || (f.converted == "" && f.isMessage() && f.isLibraryType()):
+		m := fmt.Sprintf("%s.Internal.fromJSONValue(new %s(), %s)", libMod.alias, f.typeTsName, v)
+		return f.fromMessage(m)
+	case isStructType(f.converted):
+		return v
+	case f.converted != "":
+		if conv := f.wrappedField().fromObject(libMod, v); conv != v {
+			return fmt.Sprintf("%s === null ? null : %s", v, conv)
+		}
+		return v
+	case f.isMessage():
+		return fmt.Sprintf("%s.fromObject(%s)", f.typeTsName, v)
+	case f.tsType() == "__long":
+		switch f.mr.opts.ObjectInt64 {
+		case "number":
+			return fmt.Sprintf("__long.fromNumber(%s, %t)", v, f.isUnsigned())
+		case "long":
+			return v
+		}
+		return fmt.Sprintf("__longFromString(%s, %t)", v, f.isUnsigned())
+	case f.fd.GetType() == desc.FieldDescriptorProto_TYPE_BYTES:
+		if f.mr.opts.ObjectBytes == "base64" {
+			return fmt.Sprintf("%s.Internal.bytesFromJSON(%s)", libMod.alias, v)
+		}
+		return fmt.Sprintf("new Uint8Array(%s)", v)
+	}
+	return v
+}
+
+// mapKeyToObject converts k, a key of the map, to a key of the object in the
+// object mode.
+func (f field) mapKeyToObject(k string) string {
+	if f.mapKeyCoercedType() == "string" {
+		return k
+	}
+	return fmt.Sprintf("String(%s)", k)
+}
+
+// mapKeyFromObject converts k, a key of a map in the object mode, which is
+// always a string, to the map's key type.
+func (f field) mapKeyFromObject(k string) string {
+	switch f.mapKeyCoercedType() {
+	case "number":
+		return fmt.Sprintf("Number(%s)", k)
+	case "boolean":
+		return fmt.Sprintf("%s == \"true\"", k)
+	}
+	return k
+}
+
+// writeObjectInterface writes I<Message>, the plain object form of the
+// message. Every field is optional; the members of a oneof appear directly,
+// at most one of them being set.
+func writeObjectInterface(w *writer, name string, fields []*field, oneofs []*oneof) {
+	if len(fields) < 1 {
+		w.p("export interface I%s {}", name)
+		w.ln()
+		return
+	}
+	w.p("export interface I%s {", name)
+	for _, f := range fields {
+		if !f.isOneofMember() {
+			f.mr.src.writeComment(w, f.path, f.isDeprecated())
+			w.p("%s?: %s;", f.varName(), f.objectType())
+		}
+	}
+	for _, oo := range oneofs {
+		for _, f := range oo.fields {
+			f.mr.src.writeComment(w, f.path, f.isDeprecated())
+			w.p("%s?: %s;", f.varName(), f.objectType())
+		}
+	}
+	w.p("}")
+	w.ln()
+}
+
+// writeObjectMethods writes toObject() and the static fromObject(), which
+// convert the message to and from its plain object form recursively.
+func writeObjectMethods(w *writer, name string, fields []*field, oneofs []*oneof, libMod *modRef) {
+	entries := len(fields) > 0 && fields[0].mr.opts.ObjectMaps == "entries"
+
+	w.p("// toObject returns the message as a plain object, holding no classes.")
+	w.p("toObject(): I%s {", name)
+	w.p("const o: I%s = {};", name)
+	for _, f := range fields {
+		if f.isOneofMember() {
+			continue
+		}
+		v := "this." + f.varName()
+		switch {
+		case f.isMap && entries:
+			k, mv := f.mapFields()
+			if conv := mv.toObject(libMod, "v"); conv != "v" {
+				w.p("o.%s = Array.from(%s, ([k, v]): [%s, %s] => [k, %s]);", f.varName(), v, k.mapKeyCoercedType(), mv.objectElemType(), conv)
+			} else {
+				w.p("o.%s = Array.from(%s);", f.varName(), v)
+			}
+		case f.isMap:
+			k, mv := f.mapFields()
+			w.p("{")
+			w.p("const m: %s = {};", f.objectType())
+			w.p("for (const [k, v] of %s) {", v)
+			w.p("m[%s] = %s;", k.mapKeyToObject("k"), mv.toObject(libMod, "v"))
+			w.p("}")
+			w.p("o.%s = m;", f.varName())
+			w.p("}")
+		case f.isRepeated():
+			if conv := f.toObject(libMod, "v"); conv != "v" {
+				w.p("o.%s = %s.map(v => %s);", f.varName(), v, conv)
+			} else {
+				w.p("o.%s = %s.slice();", f.varName(), v)
+			}
+		case f.isMessage():
+			conv := f.toObject(libMod, v)
+			if wrapperTypes[f.converted] != 0 {
+				// Already known not to be null.
+				conv = f.wrappedField().toObject(libMod, v)
+			}
+			w.p("if (%s) {", f.isSet(v))
+			w.p("o.%s = %s;", f.varName(), conv)
+			w.p("}")
+		case f.hasPresence():
+			w.p("if (this.%s()) {", f.hasName())
+			w.p("o.%s = %s;", f.varName(), f.toObject(libMod, v))
+			w.p("}")
+		default:
+			w.p("o.%s = %s;", f.varName(), f.toObject(libMod, v))
+		}
+	}
+	for _, oo := range oneofs {
+		for _, f := range oo.fields {
+			w.p("if (this.%s instanceof %s.%s) {", oo.name, oo.fqNamespace, f.oneofClassName())
+			w.p("o.%s = %s;", f.varName(), f.toObject(libMod, "this."+oo.name+".value"))
+			w.p("}")
+		}
+	}
+	w.p("return o;")
+	w.p("}")
+	w.ln()
+
+	w.p("// fromObject returns a message from its plain object form.")
+	w.p("static fromObject(o: I%s): %s {", name, name)
+	w.p("const m = new %s();", name)
+	for _, f := range fields {
+		v := "o." + f.varName()
+		switch {
+		case f.isMap && entries:
+			_, mv := f.mapFields()
+			w.p("if (%s !== undefined) {", v)
+			w.p("for (const [k, v] of %s) {", v)
+			w.p("m.%s.set(k, %s);", f.varName(), mv.fromObject(libMod, "v"))
+			w.p("}")
+			w.p("}")
+		case f.isMap:
+			k, mv := f.mapFields()
+			w.p("if (%s !== undefined) {", v)
+			w.p("const obj = %s;", v)
+			w.p("for (const k of Object.keys(obj)) {")
+			w.p("m.%s.set(%s, %s);", f.varName(), k.mapKeyFromObject("k"), mv.fromObject(libMod, "obj[k]"))
+			w.p("}")
+			w.p("}")
+		case f.isRepeated():
+			if conv := f.fromObject(libMod, "v"); conv != "v" {
+				w.p("if (%s !== undefined) m.%s = %s.map(v => %s);", v, f.varName(), v, conv)
+			} else {
+				w.p("if (%s !== undefined) m.%s = %s.slice();", v, f.varName(), v)
+			}
+		case f.isOneofMember():
+			oo := f.oneof
+			w.p("if (%s !== undefined) m.%s = new %s.%s(%s);", v, oo.name, oo.fqNamespace, f.oneofClassName(), f.fromObject(libMod, v))
+		default:
+			w.p("if (%s !== undefined) m.%s = %s;", v, f.varName(), f.fromObject(libMod, v))
+		}
+	}
+	w.p("return m;")
+	w.p("}")
+}
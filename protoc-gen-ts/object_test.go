@@ -0,0 +1,29 @@
+package main
+
+import (
+	ppb "github.com/golang/protobuf/protoc-gen-go/plugin"
+	"testing"
+)
+
+func TestObjectInterfaceNameCollision(t *testing.T) {
+	checkNames(t, []struct {
+		req  *ppb.CodeGeneratorRequest
+		want string
+	}{
+		{namesRequest(message("Foo"), message("Info")), ""},
+		{
+			namesRequest(message("Foo"), message("IFoo")),
+			"test.proto: message Foo generates interface IFoo, which collides with the message of that name",
+		},
+		{
+			namesRequest(message("Foo", message("Bar"), message("IBar"))),
+			"test.proto: message Bar generates interface IBar, which collides with the message of that name",
+		},
+		{
+			namesRequest(message("FooInit"), message("IFoo")),
+			"test.proto: message FooInit generates interface IFooInit, which collides with the Init interface of message IFoo",
+		},
+		{namesRequest(message("FooInit"), message("Bar", message("IFoo"))), ""},
+		{namesRequest(message("Foo", message("IFoo"))), ""},
+	})
+}
@@ -0,0 +1,264 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Options configure the generator. They are parsed from the plugin parameter,
+// a comma separated list of key=value pairs and boolean flags, e.g.
+//
+//	--ts_out=plugin=grpc,library_import=../lib/protobuf:./out
+type Options struct {
+	// Plugins are the service generators to run.
+	Plugins []string
+	// LibraryImport is the module from which the runtime library is imported.
+	LibraryImport string
+	// ImportMappings overrides the module that the generated code for a
+	// .proto file is imported from.
+	ImportMappings map[string]string
+	// Timestamp selects how google.protobuf.Timestamp fields are generated:
+	// "message" for the generated class, "date" for a Date or "helper" for
+	// the runtime library's nanosecond precise Timestamp.
+	Timestamp string
+	// Duration selects how google.protobuf.Duration fields are generated:
+	// "message" for the generated class or "helper" for the runtime
+	// library's Duration.
+	Duration string
+	// Wrappers selects how the wrapper types such as
+	// google.protobuf.StringValue are generated: "message" for the generated
+	// classes or "primitive" for a nullable primitive, e.g. string | null.
+	Wrappers string
+	// Struct selects how google.protobuf.Struct, Value and ListValue are
+	// generated: "message" for the generated classes or "json" for a
+	// JsonObject, JsonValue and JsonValue[] respectively.
+	Struct string
+	// EmbedDescriptors exports the serialized FileDescriptorProto of each
+	// file as fileDescriptor, and registers it in globalRegistry. It is on
+	// by default.
+	EmbedDescriptors bool
+	// StripSourceInfo removes SourceCodeInfo from the embedded file
+	// descriptors.
+	StripSourceInfo bool
+	// DiscardUnknownFields drops unknown fields when decoding, rather than
+	// preserving them to be written back when encoding.
+	DiscardUnknownFields bool
+	// ObjectInt64 selects how 64 bit integers are held in the plain object
+	// form of messages: "string", "number" or "long".
+	ObjectInt64 string
+	// ObjectBytes selects how bytes are held in the plain object form:
+	// "base64", "array" for a number[] or "uint8array".
+	ObjectBytes string
+	// ObjectMaps selects how maps are held in the plain object form:
+	// "object" for an object keyed by the string form of the keys, or
+	// "entries" for an array of key value pairs.
+	ObjectMaps string
+	// OmitDeprecated leaves fields marked deprecated out of the generated
+	// classes. They are decoded as unknown fields.
+	OmitDeprecated bool
+}
+
+func newOptions() *Options {
+	return &Options{
+		LibraryImport:    "protobuf",
+		ImportMappings:   map[string]string{},
+		Timestamp:        "message",
+		Duration:         "message",
+		Wrappers:         "message",
+		Struct:           "message",
+		EmbedDescriptors: true,
+		ObjectInt64:      "string",
+		ObjectBytes:      "base64",
+		ObjectMaps:       "object",
+	}
+}
+
+// HasPlugin reports whether the named service generator was requested.
+func (o *Options) HasPlugin(name string) bool {
+	for _, p := range o.Plugins {
+		if p == name {
+			return true
+		}
+	}
+	return false
+}
+
+// servicePlugins are the valid values of the plugin option.
+var servicePlugins = []string{"grpc", "connect", "twirp", "rest"}
+
+// option describes a key accepted in the plugin parameter.
+type option struct {
+	name, usage string
+	// flag options may be given without a value, which means true.
+	flag bool
+	// repeated options may be given more than once.
+	repeated bool
+	set      func(o *Options, value string) error
+}
+
+// generatorOptions is the registry of every option the generator accepts.
+// Generator modes register their options here.
+var generatorOptions = []option{
+	{
+		name:     "plugin",
+		usage:    "generate services with the named plugin: " + strings.Join(servicePlugins, ", "),
+		repeated: true,
+		set: func(o *Options, v string) error {
+			for _, p := range servicePlugins {
+				if v == p {
+					o.Plugins = append(o.Plugins, v)
+					return nil
+				}
+			}
+			return fmt.Errorf("unknown plugin %q; valid plugins are: %s", v, strings.Join(servicePlugins, ", "))
+		},
+	},
+	stringOption("library_import", "module to import the runtime library from", func(o *Options) *string {
+		return &o.LibraryImport
+	}),
+	{
+		name:     "import_mapping",
+		usage:    "<file.proto>=<module>, import the code generated for file.proto from module",
+		repeated: true,
+		set: func(o *Options, v string) error {
+			i := strings.Index(v, "=")
+			if i <= 0 || i == len(v)-1 {
+				return fmt.Errorf("expected <file.proto>=<module>, got %q", v)
+			}
+			o.ImportMappings[v[:i]] = v[i+1:]
+			return nil
+		},
+	},
+	enumOption("wkt_timestamp", "generate google.protobuf.Timestamp fields as", []string{"message", "date", "helper"}, func(o *Options) *string {
+		return &o.Timestamp
+	}),
+	enumOption("wkt_duration", "generate google.protobuf.Duration fields as", []string{"message", "helper"}, func(o *Options) *string {
+		return &o.Duration
+	}),
+	enumOption("wkt_wrappers", "generate wrapper fields such as google.protobuf.StringValue as", []string{"message", "primitive"}, func(o *Options) *string {
+		return &o.Wrappers
+	}),
+	enumOption("wkt_struct", "generate google.protobuf.Struct, Value and ListValue fields as", []string{"message", "json"}, func(o *Options) *string {
+		return &o.Struct
+	}),
+	boolOption("embed_descriptors", "export the serialized FileDescriptorProto of each file as fileDescriptor, true by default", func(o *Options) *bool {
+		return &o.EmbedDescriptors
+	}),
+	boolOption("strip_source_info", "omit source code info, such as comments, from the embedded file descriptors", func(o *Options) *bool {
+		return &o.StripSourceInfo
+	}),
+	boolOption("discard_unknown_fields", "drop unknown fields when decoding instead of preserving them", func(o *Options) *bool {
+		return &o.DiscardUnknownFields
+	}),
+	enumOption("object_int64", "hold 64 bit integers in the plain object form of messages as", []string{"string", "number", "long"}, func(o *Options) *string {
+		return &o.ObjectInt64
+	}),
+	enumOption("object_bytes", "hold bytes in the plain object form of messages as", []string{"base64", "array", "uint8array"}, func(o *Options) *string {
+		return &o.ObjectBytes
+	}),
+	enumOption("object_maps", "hold maps in the plain object form of messages as", []string{"object", "entries"}, func(o *Options) *string {
+		return &o.ObjectMaps
+	}),
+	boolOption("omit_deprecated", "leave deprecated fields out of the generated classes, decoding them as unknown fields", func(o *Options) *bool {
+		return &o.OmitDeprecated
+	}),
+}
+
+func stringOption(name, usage string, field func(o *Options) *string) option {
+	return option{
+		name:  name,
+		usage: usage,
+		set: func(o *Options, v string) error {
+			*field(o) = v
+			return nil
+		},
+	}
+}
+
+func enumOption(name, usage string, values []string, field func(o *Options) *string) option {
+	return option{
+		name:  name,
+		usage: usage + ": " + strings.Join(values, ", "),
+		set: func(o *Options, v string) error {
+			for _, value := range values {
+				if v == value {
+					*field(o) = v
+					return nil
+				}
+			}
+			return fmt.Errorf("expected one of %s, got %q", strings.Join(values, ", "), v)
+		},
+	}
+}
+
+func boolOption(name, usage string, field func(o *Options) *bool) option {
+	return option{
+		name:  name,
+		usage: usage,
+		flag:  true,
+		set: func(o *Options, v string) error {
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				return fmt.Errorf("expected a boolean, got %q", v)
+			}
+			*field(o) = b
+			return nil
+		},
+	}
+}
+
+func findOption(name string) *option {
+	for i := range generatorOptions {
+		if generatorOptions[i].name == name {
+			return &generatorOptions[i]
+		}
+	}
+	return nil
+}
+
+// optionUsage lists every valid option, for error messages.
+func optionUsage() string {
+	lines := []string{}
+	for _, opt := range generatorOptions {
+		lines = append(lines, fmt.Sprintf("  %s: %s", opt.name, opt.usage))
+	}
+	sort.Strings(lines)
+	return strings.Join(lines, "\n")
+}
+
+// ParseOptions parses the plugin parameter.
+func ParseOptions(param string) (*Options, error) {
+	o := newOptions()
+	seen := map[string]bool{}
+	for _, kv := range strings.Split(param, ",") {
+		kv = strings.TrimSpace(kv)
+		if kv == "" {
+			continue
+		}
+		name, value := kv, ""
+		hasValue := false
+		if i := strings.Index(kv, "="); i >= 0 {
+			name, value, hasValue = kv[:i], kv[i+1:], true
+		}
+		opt := findOption(name)
+		if opt == nil {
+			return nil, fmt.Errorf("unknown compiler option %q; valid options are:\n%s", name, optionUsage())
+		}
+		if !hasValue {
+			if !opt.flag {
+				return nil, fmt.Errorf("compiler option %q requires a value", name)
+			}
+			value = "true"
+		}
+		if seen[name] && !opt.repeated {
+			return nil, fmt.Errorf("compiler option %q given more than once", name)
+		}
+		seen[name] = true
+		if err := opt.set(o, value); err != nil {
+			return nil, fmt.Errorf("invalid compiler option %s: %v", name, err)
+		}
+	}
+	return o, nil
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseOptions(t *testing.T) {
+	tests := []struct {
+		param string
+		check func(o *Options) bool
+	}{
+		{"", func(o *Options) bool {
+			return reflect.DeepEqual(o, newOptions())
+		}},
+		{" , ,", func(o *Options) bool {
+			return reflect.DeepEqual(o, newOptions())
+		}},
+		{"strip_source_info", func(o *Options) bool {
+			return o.StripSourceInfo
+		}},
+		{"strip_source_info=true", func(o *Options) bool {
+			return o.StripSourceInfo
+		}},
+		{"strip_source_info=false", func(o *Options) bool {
+			return !o.StripSourceInfo
+		}},
+		{"embed_descriptors=false", func(o *Options) bool {
+			return !o.EmbedDescriptors && newOptions().EmbedDescriptors
+		}},
+		{"omit_deprecated,discard_unknown_fields=1", func(o *Options) bool {
+			return o.OmitDeprecated && o.DiscardUnknownFields
+		}},
+		{"plugin=grpc,plugin=rest", func(o *Options) bool {
+			return reflect.DeepEqual(o.Plugins, []string{"grpc", "rest"})
+		}},
+		{"plugin=grpc", func(o *Options) bool {
+			return o.HasPlugin("grpc") && !o.HasPlugin("connect")
+		}},
+		{"import_mapping=a.proto=./a,import_mapping=b/b.proto=@b/b=c", func(o *Options) bool {
+			return reflect.DeepEqual(o.ImportMappings, map[string]string{
+				"a.proto":   "./a",
+				"b/b.proto": "@b/b=c",
+			})
+		}},
+		{"library_import=../lib/protobuf", func(o *Options) bool {
+			return o.LibraryImport == "../lib/protobuf"
+		}},
+		{"wkt_timestamp=date, wkt_struct=json", func(o *Options) bool {
+			return o.Timestamp == "date" && o.Struct == "json" && o.Duration == "message"
+		}},
+		{"object_int64=long,object_bytes=array,object_maps=entries", func(o *Options) bool {
+			return o.ObjectInt64 == "long" && o.ObjectBytes == "array" && o.ObjectMaps == "entries"
+		}},
+	}
+	for _, tt := range tests {
+		o, err := ParseOptions(tt.param)
+		if err != nil {
+			t.Errorf("ParseOptions(%q): %v", tt.param, err)
+			continue
+		}
+		if !tt.check(o) {
+			t.Errorf("ParseOptions(%q) = %+v", tt.param, o)
+		}
+	}
+}
+
+func TestParseOptionsErrors(t *testing.T) {
+	tests := []struct {
+		param string
+		want  []string
+	}{
+		{"nope", []string{
+			`unknown compiler option "nope"; valid options are:`,
+			"\n  discard_unknown_fields: ",
+			"\n  plugin: generate services with the named plugin: grpc, connect, twirp, rest",
+			"\n  wkt_timestamp: generate google.protobuf.Timestamp fields as: message, date, helper",
+		}},
+		{"nope=1", []string{`unknown compiler option "nope"`}},
+		{"plugin", []string{`compiler option "plugin" requires a value`}},
+		{"plugin=grpcweb", []string{
+			`invalid compiler option plugin: unknown plugin "grpcweb"; valid plugins are: grpc, connect, twirp, rest`,
+		}},
+		{"library_import=a,library_import=b", []string{`compiler option "library_import" given more than once`}},
+		{"strip_source_info,strip_source_info", []string{`compiler option "strip_source_info" given more than once`}},
+		{"strip_source_info=maybe", []string{`invalid compiler option strip_source_info: expected a boolean, got "maybe"`}},
+		{"wkt_duration=date", []string{`invalid compiler option wkt_duration: expected one of message, helper, got "date"`}},
+		{"import_mapping=a.proto", []string{`invalid compiler option import_mapping: expected <file.proto>=<module>, got "a.proto"`}},
+		{"import_mapping==a", []string{`expected <file.proto>=<module>, got "=a"`}},
+		{"import_mapping=a.proto=", []string{`expected <file.proto>=<module>, got "a.proto="`}},
+	}
+	for _, tt := range tests {
+		o, err := ParseOptions(tt.param)
+		if err == nil {
+			t.Errorf("ParseOptions(%q) = %+v, want an error", tt.param, o)
+			continue
+		}
+		for _, want := range tt.want {
+			if !strings.Contains(err.Error(), want) {
+				t.Errorf("ParseOptions(%q) error:\n%v\nwant it to contain %q", tt.param, err, want)
+			}
+		}
+	}
+}
+
+func TestOptionUsageListsEveryOption(t *testing.T) {
+	usage := optionUsage()
+	for _, opt := range generatorOptions {
+		if !strings.Contains(usage, "  "+opt.name+": ") {
+			t.Errorf("optionUsage() is missing %s:\n%s", opt.name, usage)
+		}
+	}
+}
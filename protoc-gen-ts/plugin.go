@@ -2,6 +2,7 @@ package main
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"github.com/golang/protobuf/proto"
 	desc "github.com/golang/protobuf/protoc-gen-go/descriptor"
@@ -9,6 +10,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strings"
 )
 
@@ -18,11 +20,13 @@ func main() {
 	var buf bytes.Buffer
 	_, err := buf.ReadFrom(os.Stdin)
 	if err != nil {
-		panic(fmt.Errorf("error reading from stdin: %v", err))
+		fmt.Fprintf(os.Stderr, "error reading from stdin: %v\n", err)
+		os.Exit(1)
 	}
 	out, err := codeGenerator(buf.Bytes())
 	if err != nil {
-		panic(err)
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
 	}
 	os.Stdout.Write(out)
 }
@@ -41,27 +45,35 @@ func codeGenerator(b []byte) ([]byte, error) {
 	return out, nil
 }
 
-func gen(req *ppb.CodeGeneratorRequest) *ppb.CodeGeneratorResponse {
-	resp := &ppb.CodeGeneratorResponse{}
+// gen generates a typescript file for each requested proto file. Problems
+// with the input are reported through the response's error field, rather
+// than failing the plugin.
+func gen(req *ppb.CodeGeneratorRequest) (resp *ppb.CodeGeneratorResponse) {
+	resp = &ppb.CodeGeneratorResponse{
+		SupportedFeatures: proto.Uint64(uint64(ppb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL |
+			ppb.CodeGeneratorResponse_FEATURE_SUPPORTS_EDITIONS)),
+		MinimumEdition: proto.Int32(int32(minimumEdition)),
+		MaximumEdition: proto.Int32(int32(maximumEdition)),
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err, ok := r.(*genError)
+			if !ok {
+				panic(r)
+			}
+			resp.File = nil
+			resp.Error = proto.String(err.Error())
+		}
+	}()
 	fileToGenerate := map[string]bool{}
 	for _, f := range req.FileToGenerate {
 		fileToGenerate[f] = true
 	}
 
-	optGenService := false
-	optLibraryImport := "protobuf"
-
-	params := strings.Split(req.GetParameter(), ",")
-	for _, param := range params {
-		if param == "plugin=grpc" {
-			optGenService = true
-			continue
-		}
-		if strings.HasPrefix(param, "library_import=") {
-			optLibraryImport = strings.TrimPrefix(param, "library_import=")
-			continue
-		}
-		panic("unknown compiler option: " + param)
+	opts, err := ParseOptions(req.GetParameter())
+	if err != nil {
+		resp.Error = proto.String(err.Error())
+		return resp
 	}
 
 	rootns := NewEmptyNamespace()
@@ -81,12 +93,12 @@ func gen(req *ppb.CodeGeneratorRequest) *ppb.CodeGeneratorResponse {
 
 		libMod := &modRef{
 			alias: "__pb__",
-			path:  optLibraryImport,
+			path:  opts.LibraryImport,
 		}
 
-		imports := writeFile(w, fdp, rootns, libMod, optGenService)
+		imports := writeFile(w, fdp, rootns, libMod, opts, fileToGenerate)
 		beforeReplace := b.String()
-		if strings.Contains(beforeReplace, "__long.") {
+		if longRe.MatchString(beforeReplace) {
 			imports = imports + "import * as __long from 'long'\n"
 		}
 		if strings.Contains(beforeReplace, "__longFromString(") {
@@ -100,22 +112,45 @@ func gen(req *ppb.CodeGeneratorRequest) *ppb.CodeGeneratorResponse {
 }
 
 func tsFileName(fdp *desc.FileDescriptorProto) string {
-	fext := filepath.Ext(fdp.GetName())
-	fname := strings.TrimSuffix(fdp.GetName(), fext)
-	return fname + "_pb"
+	return tsModuleName(fdp.GetName())
+}
+
+// tsModuleName is the module generated for the .proto file name, relative to
+// the output directory.
+func tsModuleName(name string) string {
+	fext := filepath.Ext(name)
+	return strings.TrimSuffix(name, fext) + "_pb"
 }
 
 const importPlaceholder = "!!!IMPORT_PLACEHOLDER!!!"
 
-func writeFile(w *writer, fdp *desc.FileDescriptorProto, rootNs *Namespace, libMod *modRef, genService bool) string {
-	if fdp.GetSyntax() != "proto3" {
-		panic(fmt.Errorf("unsupported syntax: %s in file %s", fdp.GetSyntax(), fdp.GetName()))
+// longRe matches uses of the long.js module, as a type or a value.
+var longRe = regexp.MustCompile(`\b__long\b`)
+
+func writeFile(w *writer, fdp *desc.FileDescriptorProto, rootNs *Namespace, libMod *modRef, opts *Options, generated map[string]bool) string {
+	src := newSource(fdp)
+	if _, err := fileEdition(fdp); err != nil {
+		path := []int32{fileSyntaxPath}
+		if fdp.GetSyntax() == "editions" {
+			path = []int32{fileEditionPath}
+		}
+		src.fail(path, "%v", err)
 	}
 
 	ns := rootNs.FindFullyQualifiedNamespace("." + fdp.GetPackage())
-	mr := &moduleResolver{fdp, map[string]*modRef{}}
+	mr := &moduleResolver{
+		currentFile: fdp,
+		src:         src,
+		opts:        opts,
+		libMod:      libMod,
+		references:  map[string]*modRef{},
+		generated:   generated,
+	}
 	if ns == nil {
-		panic("unable to find namespace for: " + fdp.GetPackage())
+		src.fail(nil, "unable to find namespace for: %s", fdp.GetPackage())
+	}
+	for i, fd := range fdp.Extension {
+		src.warn([]int32{fileExtensionPath, int32(i)}, "extensions are not supported; %s is ignored", fd.GetName())
 	}
 	w.p("// Generated by the protocol buffer compiler.  DO NOT EDIT!")
 	w.p("// Source: %s", fdp.GetName())
@@ -124,22 +159,27 @@ func writeFile(w *writer, fdp *desc.FileDescriptorProto, rootNs *Namespace, libM
 	w.ln()
 
 	// Top level enums.
-	for _, edp := range fdp.EnumType {
-		writeEnum(w, edp, nil)
+	for i, edp := range fdp.EnumType {
+		writeEnum(w, edp, mr, libMod, []int32{fileEnumPath, int32(i)}, fdp.GetPackage(), nil)
 	}
 
 	// Messages, recurse.
-	for _, dp := range fdp.MessageType {
-		writeDescriptor(w, dp, ns, mr, libMod, nil)
+	for i, dp := range fdp.MessageType {
+		writeDescriptor(w, dp, []int32{fileMessagePath, int32(i)}, ns, mr, libMod, fileFeatures(fdp), fdp.GetPackage(), nil)
 	}
 
 	// Services
-	if genService {
-		for _, sdp := range fdp.Service {
-			writeService(w, sdp, fdp.GetPackage(), ns, mr, libMod)
+	if len(opts.Plugins) > 0 {
+		for i, sdp := range fdp.Service {
+			writeService(w, sdp, []int32{fileServicePath, int32(i)}, fdp.GetPackage(), ns, mr, libMod)
 		}
 	}
 
+	if opts.EmbedDescriptors {
+		writeFileDescriptor(w, fdp, mr, libMod)
+	}
+	writeRegistrations(w, mr, libMod)
+
 	imports := fmt.Sprintf("import * as %s from '%s'\n", libMod.alias, libMod.path)
 	for _, mod := range mr.references {
 		imports += fmt.Sprintf("import * as %s from '%s'\n", mod.alias, mod.path)
@@ -153,29 +193,53 @@ type modRef struct {
 
 type moduleResolver struct {
 	currentFile *desc.FileDescriptorProto
+	src         *source
+	opts        *Options
+	libMod      *modRef
 	references  map[string]*modRef
+	// generated are the files being generated along with the current file.
+	generated map[string]bool
+	// messages and enums are the typescript names of the message classes and
+	// EnumInfos generated for the current file, to be registered.
+	messages, enums []string
 }
 
+// nonIdentRe matches characters which may not appear in an identifier.
+var nonIdentRe = regexp.MustCompile(`[^A-Za-z0-9_$]`)
+
 func (m *moduleResolver) ToRelativeModule(fdp *desc.FileDescriptorProto) *modRef {
-	if fdp.GetName() == m.currentFile.GetName() {
+	return m.moduleFor(fdp.GetName())
+}
+
+// moduleFor returns the module generated for the .proto file name, adding it
+// to the imports of the current file, or nil for the current file itself.
+func (m *moduleResolver) moduleFor(name string) *modRef {
+	if name == m.currentFile.GetName() {
 		return nil
 	}
-	mod := m.references[fdp.GetName()]
+	mod := m.references[name]
 	if mod == nil {
-		cwd := filepath.Dir(m.currentFile.GetName())
-		path := tsFileName(fdp)
-		path, _ = filepath.Rel(cwd, path)
+		path, ok := m.opts.ImportMappings[name]
+		if !ok {
+			cwd := filepath.Dir(m.currentFile.GetName())
+			path, _ = filepath.Rel(cwd, tsModuleName(name))
+			if !strings.HasPrefix(path, "../") {
+				path = "./" + path
+			}
+		}
 		mod = &modRef{
-			alias: "___" + strings.Replace(path, "/", "_", -1),
-			path:  "./" + path,
+			alias: "___" + nonIdentRe.ReplaceAllString(strings.TrimPrefix(path, "./"), "_"),
+			path:  path,
 		}
-		m.references[fdp.GetName()] = mod
+		m.references[name] = mod
 	}
 	return mod
 }
 
 type oneof struct {
 	odp         *desc.OneofDescriptorProto
+	path        []int32
+	name        string
 	fields      []*field
 	fqNamespace string
 	typeName    string
@@ -183,27 +247,45 @@ type oneof struct {
 
 type field struct {
 	fd              *desc.FieldDescriptorProto
+	path            []int32
 	typeTsName      string
 	typeDescriptor  interface{}
 	typeNs          *Namespace
+	typeFdp         *desc.FileDescriptorProto
 	typeEnumDefault string
 	isMap           bool
 	oneof           *oneof
 	typeFqProtoName string
+	features        features
 	mr              *moduleResolver
+	// converted is the name of the well known type whose values are
+	// converted to another typescript type, such as a Date, or "".
+	converted string
 }
 
-func newField(fd *desc.FieldDescriptorProto, ns *Namespace, mr *moduleResolver) *field {
+// newField wraps a field, resolving its features against those of the scope
+// it is declared in. path locates the field within the current file, and is
+// nil for synthesized fields.
+func newField(fd *desc.FieldDescriptorProto, path []int32, scope features, ns *Namespace, mr *moduleResolver) *field {
 	f := &field{
-		fd: fd,
-		mr: mr,
+		fd:       fd,
+		path:     path,
+		features: scope.forField(fd),
+		mr:       mr,
 	}
 	if fd.GetTypeName() != "" {
-		typeNs, typeName, i, typeFdp := ns.FindFullyQualifiedName(fd.GetTypeName())
+		typeNs, typeName, i, typeFdp, err := ns.FindFullyQualifiedName(fd.GetTypeName())
+		if err != nil {
+			mr.src.fail(path, "%v", err)
+		}
 		f.typeFqProtoName = typeNs + "." + typeName
+		f.typeFdp = typeFdp
 
-		f.typeTsName = typeName
-		if mod := mr.ToRelativeModule(typeFdp); mod != nil {
+		f.typeTsName = tsTypeName(typeName)
+		if lt := libraryType(fd.GetTypeName(), mr.opts); lt != "" {
+			f.typeTsName = mr.libMod.alias + "." + lt
+			f.converted = convertedType(fd.GetTypeName(), mr.opts)
+		} else if mod := mr.ToRelativeModule(typeFdp); mod != nil {
 			f.typeTsName = mod.alias + "." + f.typeTsName
 		}
 
@@ -226,18 +308,107 @@ func newField(fd *desc.FieldDescriptorProto, ns *Namespace, mr *moduleResolver)
 	return f
 }
 
+// hasPresence reports whether the field tracks whether it has been set,
+// separately from its value. Messages and oneof members already track
+// presence through null and the oneof union respectively.
+func (f field) hasPresence() bool {
+	if f.isRepeated() || f.isMessage() || f.isOneofMember() {
+		return false
+	}
+	return f.features.fieldPresence != desc.FeatureSet_IMPLICIT
+}
+
+// isDeprecated reports whether the field is marked [deprecated = true].
+func (f field) isDeprecated() bool {
+	return f.fd.GetOptions().GetDeprecated()
+}
+
+// storageName is the name of the private member that backs a field with
+// presence.
+func (f field) storageName() string {
+	return "__" + f.varName()
+}
+
+func (f field) hasName() string {
+	return "has_" + f.varName()
+}
+
+func (f field) clearName() string {
+	return "clear_" + f.varName()
+}
+
+// isGroup reports whether the field is a message using the delimited (group)
+// encoding.
+func (f field) isGroup() bool {
+	return f.isMessage() && !f.isMap && f.features.messageEncoding == desc.FeatureSet_DELIMITED
+}
+
+// isClosedEnum reports whether unknown values of the field's enum type must
+// be rejected on decode.
+func (f field) isClosedEnum() bool {
+	if f.fd.GetType() != desc.FieldDescriptorProto_TYPE_ENUM {
+		return false
+	}
+	ed := f.typeDescriptor.(*desc.EnumDescriptorProto)
+	return enumFeatures(f.typeFdp, ed).enumType == desc.FeatureSet_CLOSED
+}
+
+// enumValueCheck returns an expression which is true when v is one of the
+// values declared by the field's enum type.
+func (f field) enumValueCheck(v string) string {
+	ed := f.typeDescriptor.(*desc.EnumDescriptorProto)
+	seen := map[int32]bool{}
+	checks := []string{}
+	for _, ev := range ed.Value {
+		if seen[ev.GetNumber()] {
+			continue
+		}
+		seen[ev.GetNumber()] = true
+		checks = append(checks, fmt.Sprintf("%s == %d", v, ev.GetNumber()))
+	}
+	return strings.Join(checks, " || ")
+}
+
+// readNested returns an expression reading a nested message from dec,
+// accounting for group encoding.
+func (f field) readNested(dec string) string {
+	if f.isGroup() {
+		return fmt.Sprintf("%s.readGroup(%d)", dec, f.fd.GetNumber())
+	}
+	return fmt.Sprintf("%s.readDecoder()", dec)
+}
+
+// writeNested returns a statement writing the nested encoder to enc,
+// accounting for group encoding.
+func (f field) writeNested(enc, nested string) string {
+	if f.isGroup() {
+		return fmt.Sprintf("%s.writeGroup(%s, %d)", enc, nested, f.fd.GetNumber())
+	}
+	return fmt.Sprintf("%s.writeEncoder(%s, %d)", enc, nested, f.fd.GetNumber())
+}
+
+// isOneofMember reports whether the field belongs to a real oneof. proto3
+// optional fields are placed in a synthetic oneof, which is not treated as
+// one.
 func (f field) isOneofMember() bool {
-	return f.fd.OneofIndex != nil
+	return f.fd.OneofIndex != nil && !f.fd.GetProto3Optional()
 }
 
 func (f field) varName() string {
-	return f.fd.GetName()
+	return tsMemberName(f.fd.GetName())
+}
+
+// oneofClassName is the name of the class wrapping the field's value in its
+// oneof union.
+func (f field) oneofClassName() string {
+	return tsName(f.fd.GetName())
 }
 
 func (f field) mapFields() (*field, *field) {
 	dp := f.typeDescriptor.(*desc.DescriptorProto)
-	keyField := newField(dp.Field[0], f.typeNs, f.mr)
-	valueField := newField(dp.Field[1], f.typeNs, f.mr)
+	entryFeatures := f.features.forMessage(dp)
+	keyField := newField(dp.Field[0], nil, entryFeatures, f.typeNs, f.mr)
+	valueField := newField(dp.Field[1], nil, entryFeatures, f.typeNs, f.mr)
 	return keyField, valueField
 }
 
@@ -266,6 +437,9 @@ func (f field) tsType() string {
 		return "boolean"
 	case desc.FieldDescriptorProto_TYPE_MESSAGE,
 		desc.FieldDescriptorProto_TYPE_GROUP:
+		if f.converted != "" {
+			return f.convertedTsType()
+		}
 		return f.typeTsName
 	case desc.FieldDescriptorProto_TYPE_ENUM:
 		return f.typeTsName
@@ -282,6 +456,9 @@ func (f field) defaultValue() string {
 	if f.isRepeated() {
 		return "[]"
 	}
+	if f.fd.DefaultValue != nil {
+		return f.explicitDefaultValue()
+	}
 	switch t := *f.fd.Type; t {
 	case desc.FieldDescriptorProto_TYPE_STRING:
 		return `""`
@@ -307,14 +484,120 @@ func (f field) defaultValue() string {
 		return "false"
 	case desc.FieldDescriptorProto_TYPE_MESSAGE,
 		desc.FieldDescriptorProto_TYPE_GROUP:
-		return "null"
+		return f.unsetValue()
 	case desc.FieldDescriptorProto_TYPE_ENUM:
+		// Enums default to their first declared value, which is always zero
+		// for open enums.
+		ed := f.typeDescriptor.(*desc.EnumDescriptorProto)
+		if len(ed.Value) > 0 {
+			return fmt.Sprintf("%d", ed.Value[0].GetNumber())
+		}
 		return "0"
 	default:
 		panic(fmt.Errorf("unexpected proto type while converting to php type: %v", t))
 	}
 }
 
+// explicitDefaultValue converts a proto2 [default = ...] value, as protoc
+// stores it in the FieldDescriptorProto, to a typescript expression.
+func (f field) explicitDefaultValue() string {
+	dv := f.fd.GetDefaultValue()
+	switch t := *f.fd.Type; t {
+	case desc.FieldDescriptorProto_TYPE_STRING:
+		b, _ := json.Marshal(dv)
+		return string(b)
+	case desc.FieldDescriptorProto_TYPE_BYTES:
+		nums := []string{}
+		for _, c := range unescapeC(dv) {
+			nums = append(nums, fmt.Sprintf("%d", c))
+		}
+		return fmt.Sprintf("new Uint8Array([%s])", strings.Join(nums, ", "))
+	case desc.FieldDescriptorProto_TYPE_INT64,
+		desc.FieldDescriptorProto_TYPE_SINT64,
+		desc.FieldDescriptorProto_TYPE_SFIXED64:
+		return fmt.Sprintf("__longFromString(%q, false)", dv)
+	case desc.FieldDescriptorProto_TYPE_UINT64,
+		desc.FieldDescriptorProto_TYPE_FIXED64:
+		return fmt.Sprintf("__longFromString(%q, true)", dv)
+	case desc.FieldDescriptorProto_TYPE_FLOAT,
+		desc.FieldDescriptorProto_TYPE_DOUBLE:
+		switch dv {
+		case "inf":
+			return "Infinity"
+		case "-inf":
+			return "-Infinity"
+		case "nan":
+			return "NaN"
+		}
+		return dv
+	case desc.FieldDescriptorProto_TYPE_ENUM:
+		ed := f.typeDescriptor.(*desc.EnumDescriptorProto)
+		for _, v := range ed.Value {
+			if v.GetName() == dv {
+				return fmt.Sprintf("%d", v.GetNumber())
+			}
+		}
+		f.mr.src.fail(f.path, "unknown default enum value %s for field %s", dv, f.fd.GetName())
+		return ""
+	default:
+		// Remaining scalars (integers, bool) are already valid literals.
+		return dv
+	}
+}
+
+// unescapeC reverses the C style escaping protoc applies to default values
+// of bytes fields.
+func unescapeC(s string) []byte {
+	b := []byte{}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c != '\\' || i+1 >= len(s) {
+			b = append(b, c)
+			continue
+		}
+		i++
+		switch c = s[i]; c {
+		case 'n':
+			b = append(b, '\n')
+		case 'r':
+			b = append(b, '\r')
+		case 't':
+			b = append(b, '\t')
+		case 'x':
+			n := 0
+			for j := 0; j < 2 && i+1 < len(s) && isHexDigit(s[i+1]); j++ {
+				i++
+				n = n*16 + hexValue(s[i])
+			}
+			b = append(b, byte(n))
+		case '0', '1', '2', '3', '4', '5', '6', '7':
+			n := int(c - '0')
+			for j := 0; j < 2 && i+1 < len(s) && s[i+1] >= '0' && s[i+1] <= '7'; j++ {
+				i++
+				n = n*8 + int(s[i]-'0')
+			}
+			b = append(b, byte(n))
+		default:
+			b = append(b, c)
+		}
+	}
+	return b
+}
+
+func isHexDigit(c byte) bool {
+	return (c >= '0' && c <= '9') || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')
+}
+
+func hexValue(c byte) int {
+	switch {
+	case c >= 'a':
+		return int(c-'a') + 10
+	case c >= 'A':
+		return int(c-'A') + 10
+	}
+	return int(c - '0')
+}
+
 func (f field) mapKeyCoercedType() string {
 	t := f.tsType()
 	if t == "__long" {
@@ -346,11 +629,10 @@ func (f field) mapKeyUncoerce(s string) string {
 }
 
 func (f field) isPacked() bool {
-	//if f.syn == SyntaxProto3 {
-	// TODO: technically you can disable packing?
-	return isPackable[f.fd.GetType()]
-	//}
-	//return f.fd.GetOptions().GetPacked()
+	if !isPackable[f.fd.GetType()] {
+		return false
+	}
+	return f.features.repeatedFieldEncoding == desc.FeatureSet_PACKED
 }
 
 func (f field) labeledType() string {
@@ -362,7 +644,7 @@ func (f field) labeledType() string {
 		return f.tsType() + "[]"
 	}
 	if f.isMessage() {
-		return f.tsType() + " | null"
+		return f.tsType() + " | " + f.unsetValue()
 	}
 	return f.tsType()
 }
@@ -415,7 +697,7 @@ func (f field) writeDecoder(w *writer, dec, wt string) {
 		k, v := f.mapFields()
 		ck := k.mapKeyCoerce("obj.key")
 		if v.isMessage() {
-			w.p("this.%s.set(%s, obj.value == null ? new %s() : obj.value);", f.varName(), ck, v.tsType())
+			w.p("this.%s.set(%s, obj.value == null ? %s : obj.value);", f.varName(), ck, v.newMessage())
 		} else {
 			w.p("this.%s.set(%s, obj.value);", f.varName(), ck)
 		}
@@ -427,21 +709,29 @@ func (f field) writeDecoder(w *writer, dec, wt string) {
 		if f.isRepeated() {
 			w.p("{")
 			w.p("let obj = new %s();", f.typeTsName)
-			w.p("obj.MergeFrom(%s.readDecoder());", dec)
-			w.p("this.%s.push(obj)", f.varName())
+			w.p("obj.MergeFrom(%s);", f.readNested(dec))
+			w.p("this.%s.push(%s)", f.varName(), f.fromMessage("obj"))
 			w.p("}")
 		} else {
 			if f.isOneofMember() {
 				oo := f.oneof
 				w.p("{")
 				w.p("let msg = new %s();", f.typeTsName)
-				w.p("msg.MergeFrom(%s.readDecoder());", dec)
-				w.p("this.%s = new %s.%s(msg);", oo.odp.GetName(), oo.fqNamespace, f.varName())
+				w.p("msg.MergeFrom(%s);", f.readNested(dec))
+				w.p("this.%s = new %s.%s(%s);", oo.name, oo.fqNamespace, f.oneofClassName(), f.fromMessage("msg"))
+				w.p("}")
+				return
+			}
+			if f.converted != "" {
+				w.p("{")
+				w.p("let msg = %s ? new %s() : %s;", f.isUnset("this."+f.varName()), f.typeTsName, f.toMessage("this."+f.varName()))
+				w.p("msg.MergeFrom(%s);", f.readNested(dec))
+				w.p("this.%s = %s;", f.varName(), f.fromMessage("msg"))
 				w.p("}")
 				return
 			}
 			w.p("if (this.%s == null) this.%s = new %s();", f.varName(), f.varName(), f.typeTsName)
-			w.p("this.%s.MergeFrom(%s.readDecoder());", f.varName(), dec)
+			w.p("this.%s.MergeFrom(%s);", f.varName(), f.readNested(dec))
 		}
 		return
 	}
@@ -449,6 +739,9 @@ func (f field) writeDecoder(w *writer, dec, wt string) {
 	switch f.fd.GetType() {
 	case desc.FieldDescriptorProto_TYPE_STRING:
 		reader = fmt.Sprintf("%s.readString()", dec)
+		if f.features.utf8Validation == desc.FeatureSet_VERIFY {
+			reader = fmt.Sprintf("%s.readValidString()", dec)
+		}
 	case desc.FieldDescriptorProto_TYPE_BYTES:
 		reader = fmt.Sprintf("%s.readBytes()", dec)
 	case desc.FieldDescriptorProto_TYPE_INT64:
@@ -482,26 +775,54 @@ func (f field) writeDecoder(w *writer, dec, wt string) {
 	default:
 		panic(fmt.Errorf("unknown reader for fd type: %+v", f.fd.GetType()))
 	}
+
+	// store emits the statement produced by stmt for the value read by
+	// reader. Values of closed enums which aren't declared are unknown
+	// fields.
+	store := func(reader string, stmt func(v string) string) {
+		if !f.isClosedEnum() {
+			w.p(stmt(reader))
+			return
+		}
+		w.p("{")
+		w.p("let v = %s;", reader)
+		w.p("if (%s) {", f.enumValueCheck("v"))
+		w.p(stmt("v"))
+		if !f.mr.opts.DiscardUnknownFields {
+			w.p("} else {")
+			w.p("this.unknownFields.push(%s.Internal.unknownVarint(%d, v));", f.mr.libMod.alias, f.fd.GetNumber())
+		}
+		w.p("}")
+		w.p("}")
+	}
+
 	if f.isOneofMember() {
 		oo := f.oneof
-		w.p("this.%s = new %s.%s(%s);", oo.odp.GetName(), oo.fqNamespace, f.varName(), reader)
+		store(reader, func(v string) string {
+			return fmt.Sprintf("this.%s = new %s.%s(%s);", oo.name, oo.fqNamespace, f.oneofClassName(), v)
+		})
 		return
 	}
 	if !f.isRepeated() {
-		w.p("this.%s = %s;", f.varName(), reader)
+		store(reader, func(v string) string {
+			return fmt.Sprintf("this.%s = %s;", f.varName(), v)
+		})
 		return
 	}
+	push := func(v string) string {
+		return fmt.Sprintf("this.%s.push(%s)", f.varName(), v)
+	}
 	packable := isPackable[f.fd.GetType()]
 	if packable {
 		w.p("if (%s == 2) {", wt)
 		w.p("let packed = %s.readDecoder();", dec)
 		w.p("while (!packed.isEOF()) {")
 		packedReader := strings.Replace(reader, dec, "packed", 1) // heh kinda hacky
-		w.p("this.%s.push(%s)", f.varName(), packedReader)
+		store(packedReader, push)
 		w.p("}")
 		w.p("} else {")
 	}
-	w.p("this.%s.push(%s)", f.varName(), reader)
+	store(reader, push)
 	if packable {
 		w.p("}")
 	}
@@ -568,11 +889,11 @@ func (f field) writeEncoder(w *writer, libMod *modRef, enc string, alwaysEmitDef
 			w.p("for (const msg of this.%s) {", f.varName())
 		} else {
 			w.p("const msg = this.%s;", f.varName())
-			w.p("if (msg != null) {")
+			w.p("if (%s) {", f.isSet("msg"))
 		}
 		w.p("let nested = new %s.Internal.Encoder();", libMod.alias)
-		w.p("msg.WriteTo(nested);")
-		w.p("%s.writeEncoder(nested, %d)", enc, f.fd.GetNumber())
+		w.p("%s.WriteTo(nested);", f.toMessage("msg"))
+		w.p(f.writeNested(enc, "nested") + ";")
 		w.p("}")
 		w.p("}")
 		return
@@ -581,7 +902,9 @@ func (f field) writeEncoder(w *writer, libMod *modRef, enc string, alwaysEmitDef
 	tagWriter, writer := f.primitiveWriter(enc)
 
 	if !f.isRepeated() {
-		if !alwaysEmitDefaultValue {
+		if f.hasPresence() {
+			w.p("if (this.%s()) {", f.hasName())
+		} else if !alwaysEmitDefaultValue {
 			if f.fd.GetType() == desc.FieldDescriptorProto_TYPE_BYTES {
 				w.p("if (this.%s.length != 0) {", f.varName())
 			} else {
@@ -590,7 +913,7 @@ func (f field) writeEncoder(w *writer, libMod *modRef, enc string, alwaysEmitDef
 		}
 		w.p(tagWriter + ";")
 		w.p(writer + ";")
-		if !alwaysEmitDefaultValue {
+		if f.hasPresence() || !alwaysEmitDefaultValue {
 			w.p("}")
 		}
 		return
@@ -600,7 +923,7 @@ func (f field) writeEncoder(w *writer, libMod *modRef, enc string, alwaysEmitDef
 	repeatWriter := strings.Replace(writer, "this."+f.varName(), "elem", 1)
 	if f.isPacked() {
 		packedWriter := strings.Replace(repeatWriter, enc, "packed", 1) // heh hax
-		w.p("{")
+		w.p("if (this.%s.length > 0) {", f.varName())
 		w.p("const packed = new %s.Internal.Encoder();", libMod.alias)
 		w.p("for (let elem of this.%s) {", f.varName())
 		w.p(packedWriter + ";")
@@ -615,16 +938,33 @@ func (f field) writeEncoder(w *writer, libMod *modRef, enc string, alwaysEmitDef
 	}
 }
 
-func writeEnum(w *writer, edp *desc.EnumDescriptorProto, prefixNames []string) {
+func writeEnum(w *writer, edp *desc.EnumDescriptorProto, mr *moduleResolver, libMod *modRef, path []int32, scope string, prefixNames []string) {
 	// name := strings.Join(append(prefixNames, edp.GetName()), "_")
+	name := tsName(edp.GetName())
+	mr.src.warnEscaped(path, "enum", edp.GetName(), name)
 	if len(prefixNames) > 0 {
 		w.p("export namespace %s {", strings.Join(prefixNames, "."))
 	}
-	w.p("export const enum %s {", edp.GetName())
-	for _, v := range edp.Value {
+	mr.src.writeComment(w, path, edp.GetOptions().GetDeprecated())
+	w.p("export const enum %s {", name)
+	for i, v := range edp.Value {
+		mr.src.writeComment(w, subPath(path, enumValuePath, int32(i)), v.GetOptions().GetDeprecated())
 		w.p("%s = %d,", v.GetName(), v.GetNumber())
 	}
 	w.p("}")
+	w.ln()
+	writeEnumJSON(w, edp, name, libMod)
+	fqName := edp.GetName()
+	if scope != "" {
+		fqName = scope + "." + fqName
+	}
+	w.ln()
+	writeEnumInfo(w, edp, name, fqName, libMod)
+	if len(prefixNames) > 0 {
+		mr.enums = append(mr.enums, strings.Join(prefixNames, ".")+"."+name+"Info")
+	} else {
+		mr.enums = append(mr.enums, name+"Info")
+	}
 	if len(prefixNames) > 0 {
 		w.p("}") // namespace
 	}
@@ -633,19 +973,20 @@ func writeEnum(w *writer, edp *desc.EnumDescriptorProto, prefixNames []string) {
 
 func writeOneof(w *writer, oo *oneof, libMod *modRef, prefixNames []string) {
 	if len(prefixNames) > 0 {
-		w.p("export namespace %s {", strings.Join(append(prefixNames, oo.odp.GetName()), "."))
+		w.p("export namespace %s {", strings.Join(append(prefixNames, oo.name), "."))
 	}
 
 	classNames := []string{fmt.Sprintf("%s.OneofNotSet", libMod.alias)}
 	for _, field := range oo.fields {
-		w.p("export class %s {", field.varName())
+		field.mr.src.writeComment(w, field.path, field.isDeprecated())
+		w.p("export class %s {", field.oneofClassName())
 		w.p("static readonly kind = %d;", field.fd.GetNumber())
 		w.p("readonly kind = %d;", field.fd.GetNumber())
 		w.p("value: %s;", field.labeledType())
 		w.p("constructor(v: %s) {", field.labeledType())
 		w.p("this.value = v;")
 		w.p("}")
-		classNames = append(classNames, field.varName())
+		classNames = append(classNames, field.oneofClassName())
 		w.p("}")
 		w.ln()
 
@@ -657,17 +998,17 @@ func writeOneof(w *writer, oo *oneof, libMod *modRef, prefixNames []string) {
 	w.p("export function WriteTo(oo: %s, e: %s.Internal.Encoder):void {", oo.typeName, libMod.alias)
 	w.p("switch (oo.kind) {")
 	for _, f := range oo.fields {
-		value := fmt.Sprintf("(oo as %s).value", f.varName())
+		value := fmt.Sprintf("(oo as %s).value", f.oneofClassName())
 		w.p("case %d:", f.fd.GetNumber())
 
 		if f.isMessage() {
 			w.p("{")
 			w.p("let nested = new %s.Internal.Encoder();", libMod.alias)
 			w.p("let msg = %s;", value)
-			w.p("if (msg != null) {")
-			w.p("msg.WriteTo(nested);")
+			w.p("if (%s) {", f.isSet("msg"))
+			w.p("%s.WriteTo(nested);", f.toMessage("msg"))
 			w.p("}")
-			w.p("e.writeEncoder(nested, %d);", f.fd.GetNumber())
+			w.p(f.writeNested("e", "nested") + ";")
 			w.p("return")
 			w.p("}")
 			continue
@@ -691,13 +1032,80 @@ func writeOneof(w *writer, oo *oneof, libMod *modRef, prefixNames []string) {
 	w.ln()
 }
 
-func writeDescriptor(w *writer, dp *desc.DescriptorProto, ns *Namespace, mr *moduleResolver, libMod *modRef, prefixNames []string) {
-	nextNames := append(prefixNames, dp.GetName())
+// writePresenceAccessors writes the getter, setter, has and clear methods for
+// a field which tracks presence. Unset fields read as their default value.
+func writePresenceAccessors(w *writer, f *field) {
+	f.mr.src.writeComment(w, f.path, f.isDeprecated())
+	w.p("get %s(): %s {", f.varName(), f.labeledType())
+	w.p("return this.%s === undefined ? %s : this.%s;", f.storageName(), f.defaultValue(), f.storageName())
+	w.p("}")
+	w.ln()
+	w.p("set %s(v: %s) {", f.varName(), f.labeledType())
+	w.p("this.%s = v;", f.storageName())
+	w.p("}")
+	w.ln()
+	w.p("%s(): boolean {", f.hasName())
+	w.p("return this.%s !== undefined;", f.storageName())
+	w.p("}")
+	w.ln()
+	w.p("%s(): void {", f.clearName())
+	w.p("this.%s = undefined;", f.storageName())
+	w.p("}")
+	w.ln()
+}
 
-	// Wrap fields.
+// writeDescriptor writes the class for a message, and then its nested types.
+// scope is the fully qualified proto name of the enclosing package or message.
+func writeDescriptor(w *writer, dp *desc.DescriptorProto, path []int32, ns *Namespace, mr *moduleResolver, libMod *modRef, parentFeatures features, scope string, prefixNames []string) {
+	name := tsName(dp.GetName())
+	fqName := dp.GetName()
+	if scope != "" {
+		fqName = scope + "." + fqName
+	}
+	mr.src.warnEscaped(path, "message", dp.GetName(), name)
+	if kind := mr.declaredKind(ns, scope, name+"Init"); kind != "" {
+		mr.src.fail(path, "message %s generates interface %sInit, which collides with the %s of that name", dp.GetName(), name, kind)
+	}
+	if kind := mr.declaredKind(ns, scope, "I"+name); kind != "" {
+		mr.src.fail(path, "message %s generates interface I%s, which collides with the %s of that name", dp.GetName(), name, kind)
+	}
+	// I<Message> of FooInit is also the Init interface of IFoo.
+	if base := strings.TrimSuffix(name, "Init"); base != name && mr.declaredKind(ns, scope, "I"+base) == "message" {
+		mr.src.fail(path, "message %s generates interface I%s, which collides with the Init interface of message I%s", dp.GetName(), name, base)
+	}
+	nextNames := append(prefixNames, name)
+	msgFeatures := parentFeatures.forMessage(dp)
+
+	if dp.GetOptions().GetMessageSetWireFormat() {
+		mr.src.warn(subPath(path, messageOptionsPath), "message_set_wire_format is not supported and is ignored")
+	}
+	for i, fd := range dp.Extension {
+		mr.src.warn(subPath(path, messageExtensionPath, int32(i)), "extensions are not supported; %s is ignored", fd.GetName())
+	}
+
+	// Wrap fields. Deprecated fields may be omitted, in which case they are
+	// decoded as unknown fields and skipped in JSON.
 	fields := []*field{}
-	for _, fd := range dp.Field {
-		fields = append(fields, newField(fd, ns, mr))
+	omitted := []*field{}
+	for i, fd := range dp.Field {
+		scope := msgFeatures
+		if fd.OneofIndex != nil {
+			scope = scope.merge(dp.OneofDecl[fd.GetOneofIndex()].GetOptions().GetFeatures())
+		}
+		f := newField(fd, subPath(path, messageFieldPath, int32(i)), scope, ns, mr)
+		if f.isOneofMember() {
+			mr.src.warnEscaped(f.path, "field", fd.GetName(), f.oneofClassName())
+		} else {
+			mr.src.warnEscaped(f.path, "field", fd.GetName(), f.varName())
+		}
+		if fd.GetOptions() != nil && fd.GetOptions().Jstype != nil {
+			mr.src.warn(subPath(f.path, fieldOptionsPath), "jstype is ignored; 64 bit integers are always generated as long.js values")
+		}
+		if f.isDeprecated() && mr.opts.OmitDeprecated {
+			omitted = append(omitted, f)
+			continue
+		}
+		fields = append(fields, f)
 	}
 
 	// Oneofs: group each field by it's corresponding oneof.
@@ -712,22 +1120,53 @@ func writeDescriptor(w *writer, dp *desc.DescriptorProto, ns *Namespace, mr *mod
 		oneofFields[i] = l
 	}
 
-	// Wrap oneofs.
+	// Wrap oneofs, skipping the synthetic oneofs of proto3 optional fields.
 	oneofs := []*oneof{}
+	oneofByIndex := map[int32]*oneof{}
 	for i, od := range dp.OneofDecl {
+		if len(oneofFields[int32(i)]) == 0 {
+			continue
+		}
+		ooName := tsMemberName(tsName(od.GetName()))
+		ooPath := subPath(path, messageOneofPath, int32(i))
+		mr.src.warnEscaped(ooPath, "oneof", od.GetName(), ooName)
 		oo := &oneof{
 			odp:         od,
+			path:        ooPath,
+			name:        ooName,
 			fields:      oneofFields[int32(i)],
 			typeName:    "oneof_type",
-			fqNamespace: strings.Join(append(nextNames, od.GetName()), "."),
+			fqNamespace: strings.Join(append(nextNames, ooName), "."),
 		}
 		oneofs = append(oneofs, oo)
+		oneofByIndex[int32(i)] = oo
+	}
+
+	// The presence methods of a field mustn't collide with other members.
+	members := map[string]string{}
+	for _, f := range fields {
+		if !f.isOneofMember() {
+			members[f.varName()] = "field " + f.fd.GetName()
+		}
+	}
+	for _, oo := range oneofs {
+		members[oo.name] = "oneof " + oo.odp.GetName()
+	}
+	for _, f := range fields {
+		if !f.hasPresence() {
+			continue
+		}
+		for _, method := range []string{f.hasName(), f.clearName()} {
+			if member, ok := members[method]; ok {
+				mr.src.fail(f.path, "field %s generates method %s, which collides with %s", f.fd.GetName(), method, member)
+			}
+		}
 	}
 
 	// Now point each field at it's oneof.
 	for _, field := range fields {
 		if field.isOneofMember() {
-			field.oneof = oneofs[field.fd.GetOneofIndex()]
+			field.oneof = oneofByIndex[field.fd.GetOneofIndex()]
 		}
 	}
 
@@ -735,33 +1174,75 @@ func writeDescriptor(w *writer, dp *desc.DescriptorProto, ns *Namespace, mr *mod
 		w.p("export namespace %s {", strings.Join(prefixNames, "."))
 	}
 
+	writeInitInterface(w, name, fields, oneofs)
+	writeObjectInterface(w, name, fields, oneofs)
+
 	// Message
-	w.p("export class %s implements %s.Message {", dp.GetName(), libMod.alias)
+	mr.src.writeComment(w, path, dp.GetOptions().GetDeprecated())
+	w.p("export class %s implements %s.Message {", name, libMod.alias)
+	w.p("static readonly typeName = %s;", jsString(fqName))
+	w.ln()
+	writeFieldTable(w, fields, libMod)
+	if !dp.GetOptions().GetMapEntry() {
+		mr.messages = append(mr.messages, strings.Join(nextNames, "."))
+	}
 	for _, f := range fields {
 		if f.isOneofMember() {
 			continue
 		}
+		if f.hasPresence() {
+			w.p("private %s: %s | undefined;", f.storageName(), f.labeledType())
+			continue
+		}
+		mr.src.writeComment(w, f.path, f.isDeprecated())
 		w.p("%s: %s;", f.varName(), f.labeledType())
 	}
 	for _, oo := range oneofs {
-		w.p("%s: %s.%s;", oo.odp.GetName(), oo.fqNamespace, oo.typeName)
+		mr.src.writeComment(w, oo.path, false)
+		w.p("%s: %s.%s;", oo.name, oo.fqNamespace, oo.typeName)
+	}
+	keepUnknown := !mr.opts.DiscardUnknownFields
+	if keepUnknown {
+		w.p("// The encoding of fields which were not recognized when decoding.")
+		w.p("unknownFields: Uint8Array[];")
 	}
 	w.ln()
 
 	// Constructor
-	w.p("constructor() {")
+	if len(fields) < 1 {
+		w.p("constructor(_?: %sInit) {", name)
+	} else {
+		w.p("constructor(init?: %sInit) {", name)
+	}
 	for _, f := range fields {
 		if f.isOneofMember() {
 			continue
 		}
+		if f.hasPresence() {
+			w.p("this.%s = undefined;", f.storageName())
+			continue
+		}
 		w.p("this.%s = %s;", f.varName(), f.defaultValue())
 	}
 	for _, oo := range oneofs {
-		w.p("this.%s = %s.OneofNotSet.singleton;", oo.odp.GetName(), libMod.alias)
+		w.p("this.%s = %s.OneofNotSet.singleton;", oo.name, libMod.alias)
+	}
+	if keepUnknown {
+		w.p("this.unknownFields = [];")
+	}
+	if len(fields) > 0 {
+		writeInitAssignments(w, fields, oneofs, libMod)
 	}
 	w.p("}") // constructor
 	w.ln()
 
+	// Accessors for fields with presence.
+	for _, f := range fields {
+		if f.hasPresence() {
+			writePresenceAccessors(w, f)
+		}
+	}
+
 	// MergeFrom
 	w.p("MergeFrom(d: %s.Internal.Decoder): void {", libMod.alias)
 	w.p("while (!d.isEOF()) {")
@@ -775,15 +1256,20 @@ func writeDescriptor(w *writer, dp *desc.DescriptorProto, ns *Namespace, mr *mod
 		w.p("break;")
 	}
 	w.p("default:")
-	w.pdebug("skipping unknown field:${fn} wt:${wt}")
-	w.p("d.skipWireType(wt)")
+	if keepUnknown {
+		w.pdebug("preserving unknown field:${fn} wt:${wt}")
+		w.p("this.unknownFields.push(d.readUnknown(wt, fn));")
+	} else {
+		w.pdebug("skipping unknown field:${fn} wt:${wt}")
+		w.p("d.skipWireType(wt, fn)")
+	}
 	w.p("}") // switch
 	w.p("}") // while
 	w.p("}") // MergeFrom
 	w.ln()
 
 	// WriteTo
-	if len(fields) < 1 {
+	if len(fields) < 1 && !keepUnknown {
 		w.p("WriteTo(_: %s.Internal.Encoder): void {}", libMod.alias)
 	} else {
 		w.p("WriteTo(e: %s.Internal.Encoder): void {", libMod.alias)
@@ -796,11 +1282,26 @@ func writeDescriptor(w *writer, dp *desc.DescriptorProto, ns *Namespace, mr *mod
 			w.pdebug("maybe wrote field %d, (%s)", f.fd.GetNumber(), f.fd.GetName())
 		}
 		for _, oo := range oneofs {
-			w.p("%s.WriteTo(this.%s, e);", oo.fqNamespace, oo.odp.GetName())
+			w.p("%s.WriteTo(this.%s, e);", oo.fqNamespace, oo.name)
+		}
+		if keepUnknown {
+			w.p("e.writeUnknown(this.unknownFields);")
 		}
 
 		w.p("}") // WriteTo
 	}
+	w.ln()
+
+	// JSON
+	if wkt := wellKnownMessage(fqName); wkt != "" {
+		writeWellKnownJSON(w, wkt, libMod)
+	} else {
+		writeJSONMethods(w, fields, omitted, oneofs, libMod)
+	}
+	w.ln()
+	writeObjectMethods(w, name, fields, oneofs, libMod)
+	w.ln()
+	writeEqualsMethods(w, name, fields, oneofs, libMod, keepUnknown)
 	w.p("}") // class
 
 	if len(prefixNames) > 0 {
@@ -814,71 +1315,251 @@ func writeDescriptor(w *writer, dp *desc.DescriptorProto, ns *Namespace, mr *mod
 	}
 
 	// Write enums.
-	for _, edp := range dp.EnumType {
-		writeEnum(w, edp, nextNames)
+	for i, edp := range dp.EnumType {
+		writeEnum(w, edp, mr, libMod, subPath(path, messageEnumPath, int32(i)), fqName, nextNames)
 	}
 
 	// Nested types.
-	for _, ndp := range dp.NestedType {
-		writeDescriptor(w, ndp, ns, mr, libMod, nextNames)
+	for i, ndp := range dp.NestedType {
+		writeDescriptor(w, ndp, subPath(path, messageNestedPath, int32(i)), ns, mr, libMod, msgFeatures, fqName, nextNames)
 	}
 }
 
 type method struct {
 	mdp                               *desc.MethodDescriptorProto
+	path                              []int32
 	TsName, InputTsName, OutputTsName string
 }
 
-func newMethod(mdp *desc.MethodDescriptorProto, ns *Namespace, mr *moduleResolver) method {
-	m := method{mdp: mdp}
-	m.TsName = mdp.GetName()
+func newMethod(mdp *desc.MethodDescriptorProto, path []int32, ns *Namespace, mr *moduleResolver) method {
+	m := method{mdp: mdp, path: path}
+	m.TsName = tsMemberName(mdp.GetName())
+	mr.src.warnEscaped(path, "method", mdp.GetName(), m.TsName)
 
-	_, typeName, _, typeFdp := ns.FindFullyQualifiedName(mdp.GetInputType())
-	m.InputTsName = typeName
-	if mod := mr.ToRelativeModule(typeFdp); mod != nil {
-		m.InputTsName = mod.alias + "." + m.InputTsName
-	}
+	m.InputTsName = methodTypeTsName(mdp.GetInputType(), path, ns, mr)
+	m.OutputTsName = methodTypeTsName(mdp.GetOutputType(), path, ns, mr)
+	return m
+}
 
-	_, typeName, _, typeFdp = ns.FindFullyQualifiedName(mdp.GetOutputType())
-	m.OutputTsName = typeName
+// methodTypeTsName resolves the input or output type of a method. Well known
+// types generated as library types are always used as their library class.
+func methodTypeTsName(typeName string, path []int32, ns *Namespace, mr *moduleResolver) string {
+	_, name, _, typeFdp, err := ns.FindFullyQualifiedName(typeName)
+	if err != nil {
+		mr.src.fail(path, "%v", err)
+	}
+	if lt := libraryType(typeName, mr.opts); lt != "" {
+		return mr.libMod.alias + "." + lt
+	}
+	tsName := tsTypeName(name)
 	if mod := mr.ToRelativeModule(typeFdp); mod != nil {
-		m.OutputTsName = mod.alias + "." + m.OutputTsName
+		tsName = mod.alias + "." + tsName
 	}
-	return m
+	return tsName
+}
+
+// methodKinds name the Grpc.MethodKind of a method by whether its client and
+// server stream.
+var methodKinds = map[[2]bool]string{
+	{false, false}: "Unary",
+	{false, true}:  "ServerStreaming",
+	{true, false}:  "ClientStreaming",
+	{true, true}:   "BidiStreaming",
+}
+
+var idempotencyLevels = map[desc.MethodOptions_IdempotencyLevel]string{
+	desc.MethodOptions_IDEMPOTENCY_UNKNOWN: "IdempotencyUnknown",
+	desc.MethodOptions_NO_SIDE_EFFECTS:     "NoSideEffects",
+	desc.MethodOptions_IDEMPOTENT:          "Idempotent",
 }
 
-func (m method) isStreaming() bool {
-	return m.mdp.GetClientStreaming() || m.mdp.GetServerStreaming()
+// writeServiceDescriptor writes <Service>Descriptor, which describes the
+// service and each of its methods. Clients pass the method descriptors to the
+// ClientConn as their last call option and dispatchers are built from them.
+func writeServiceDescriptor(w *writer, sdp *desc.ServiceDescriptorProto, fqname string, methods []method, libMod *modRef) {
+	w.p("export const %sDescriptor = {", sdp.GetName())
+	w.p("typeName: %s,", jsString(fqname))
+	w.p("methods: {")
+	for _, m := range methods {
+		kind := methodKinds[[2]bool{m.mdp.GetClientStreaming(), m.mdp.GetServerStreaming()}]
+		w.p("%s: new %s.Grpc.MethodDescriptor({", m.TsName, libMod.alias)
+		w.p("service: %s,", jsString(fqname))
+		w.p("name: %s,", jsString(m.mdp.GetName()))
+		w.p("input: %s,", m.InputTsName)
+		w.p("output: %s,", m.OutputTsName)
+		w.p("kind: %s.Grpc.MethodKind.%s,", libMod.alias, kind)
+		w.p("idempotency: %s.Grpc.IdempotencyLevel.%s,", libMod.alias, idempotencyLevels[m.mdp.GetOptions().GetIdempotencyLevel()])
+		w.p("}),")
+	}
+	w.p("},")
+	w.p("};")
+	w.ln()
 }
 
-func writeService(w *writer, sdp *desc.ServiceDescriptorProto, pkg string, ns *Namespace, mr *moduleResolver, libMod *modRef) {
+func writeService(w *writer, sdp *desc.ServiceDescriptorProto, path []int32, pkg string, ns *Namespace, mr *moduleResolver, libMod *modRef) {
 	methods := []method{}
-	for _, mdp := range sdp.Method {
-		methods = append(methods, newMethod(mdp, ns, mr))
+	for i, mdp := range sdp.Method {
+		methods = append(methods, newMethod(mdp, subPath(path, serviceMethodPath, int32(i)), ns, mr))
 	}
 	fqname := sdp.GetName()
 	if pkg != "" {
 		fqname = pkg + "." + fqname
 	}
 
-	// Client
+	writeServiceDescriptor(w, sdp, fqname, methods, libMod)
+
+	if mr.opts.HasPlugin("grpc") || mr.opts.HasPlugin("connect") {
+		writeClient(w, sdp, path, fqname, methods, mr, libMod)
+	}
+	if mr.opts.HasPlugin("grpc") {
+		writeServer(w, sdp, path, methods, mr, libMod)
+	}
+	if mr.opts.HasPlugin("connect") {
+		writeConnectClient(w, sdp, path, fqname, methods, mr, libMod)
+	}
+	if mr.opts.HasPlugin("twirp") {
+		writeTwirp(w, sdp, path, methods, mr, libMod)
+	}
+	if mr.opts.HasPlugin("rest") {
+		writeRestClient(w, sdp, path, methods, ns, mr, libMod)
+	}
+}
+
+// writeClient writes <Service>Client, which calls the service through any
+// Grpc.ClientConn.
+func writeClient(w *writer, sdp *desc.ServiceDescriptorProto, path []int32, fqname string, methods []method, mr *moduleResolver, libMod *modRef) {
+	mr.src.writeComment(w, path, sdp.GetOptions().GetDeprecated())
 	w.p("export class %sClient {", sdp.GetName())
 	w.p("private cc: %s.Grpc.ClientConn;", libMod.alias)
 	w.p("constructor(cc: %s.Grpc.ClientConn) {", libMod.alias)
 	w.p("this.cc = cc;")
 	w.p("}")
 	for _, m := range methods {
-		if m.isStreaming() {
-			continue
-		}
 		w.ln()
+		mr.src.writeComment(w, m.path, m.mdp.GetOptions().GetDeprecated())
+		writeClientMethod(w, sdp, fqname, m, true, libMod)
+	}
+	w.p("}")
+	w.ln()
+}
+
+// writeClientMethod writes the method of a client calling m through this.cc.
+// If check is set, the streaming calls, which are optional in
+// Grpc.ClientConn, fail with Unimplemented when this.cc doesn't implement
+// them.
+func writeClientMethod(w *writer, sdp *desc.ServiceDescriptorProto, fqname string, m method, check bool, libMod *modRef) {
+	name := fmt.Sprintf("'/%s/%s'", fqname, m.mdp.GetName())
+	d := fmt.Sprintf("%sDescriptor.methods.%s", sdp.GetName(), m.TsName)
+	checkStream := func(call, kind string) {
+		if check {
+			writeStreamCheck(w, call, kind, libMod)
+		}
+	}
+	switch {
+	case m.mdp.GetClientStreaming() && m.mdp.GetServerStreaming():
+		w.p("%s(...co: %s.Grpc.CallOption[]): %s.Grpc.BidiStream<%s, %s> {", m.TsName, libMod.alias, libMod.alias, m.InputTsName, m.OutputTsName)
+		checkStream("BidiStream", "bidirectional")
+		w.p("return this.cc.BidiStream<%s, %s>(%s, () => new %s(), ...co, %s);", m.InputTsName, m.OutputTsName, name, m.OutputTsName, d)
+		w.p("}")
+	case m.mdp.GetServerStreaming():
+		w.p("%s(min: %s, ...co: %s.Grpc.CallOption[]): AsyncIterable<%s> {", m.TsName, m.InputTsName, libMod.alias, m.OutputTsName)
+		checkStream("ServerStream", "server")
+		w.p("return this.cc.ServerStream(%s, min, () => new %s(), ...co, %s);", name, m.OutputTsName, d)
+		w.p("}")
+	case m.mdp.GetClientStreaming():
+		w.p("async %s(mins: AsyncIterable<%s>, ...co: %s.Grpc.CallOption[]): Promise<%s> {", m.TsName, m.InputTsName, libMod.alias, m.OutputTsName)
+		checkStream("ClientStream", "client")
+		w.p("let mout = new %s();", m.OutputTsName)
+		w.p("await this.cc.ClientStream(%s, mins, mout, ...co, %s);", name, d)
+		w.p("return mout;")
+		w.p("}")
+	default:
 		w.p("async %s(min: %s, ...co: %s.Grpc.CallOption[]): Promise<%s> {", m.TsName, m.InputTsName, libMod.alias, m.OutputTsName)
 		w.p("let mout = new %s();", m.OutputTsName)
-		w.p("await this.cc.Invoke('/%s/%s', min, mout, ...co);", fqname, m.mdp.GetName())
+		w.p("await this.cc.Invoke(%s, min, mout, ...co, %s);", name, d)
 		w.p("return mout;")
 		w.p("}")
 	}
+}
+
+// writeStreamCheck writes the check that the ClientConn implements the
+// optional call of a streaming method, failing with Unimplemented if not.
+func writeStreamCheck(w *writer, call, kind string, libMod *modRef) {
+	w.p("if (this.cc.%s === undefined) {", call)
+	w.p("throw new %s.Grpc.GrpcError(%s.Grpc.Code.Unimplemented, %s);", libMod.alias, libMod.alias, jsString("the ClientConn does not support "+kind+" streaming"))
+	w.p("}")
+}
+
+// writeConnectClient writes <Service>ConnectClient, the client of the service
+// at a base URL over the Connect protocol. fetch can't stream requests
+// everywhere, so client and bidirectional streaming methods are skipped with
+// a warning.
+func writeConnectClient(w *writer, sdp *desc.ServiceDescriptorProto, path []int32, fqname string, methods []method, mr *moduleResolver, libMod *modRef) {
+	mr.src.writeComment(w, path, sdp.GetOptions().GetDeprecated())
+	w.p("export class %sConnectClient {", sdp.GetName())
+	w.p("private cc: %s.Connect.Transport;", libMod.alias)
+	w.p("constructor(baseUrl: string, options?: %s.Connect.Options) {", libMod.alias)
+	w.p("this.cc = new %s.Connect.Transport(baseUrl, options);", libMod.alias)
 	w.p("}")
+	for _, m := range methods {
+		if m.mdp.GetClientStreaming() {
+			kind := "client"
+			if m.mdp.GetServerStreaming() {
+				kind = "bidirectional"
+			}
+			mr.src.warn(m.path, "%s streaming method %q is not supported by connect and was skipped", kind, m.mdp.GetName())
+			continue
+		}
+		w.ln()
+		mr.src.writeComment(w, m.path, m.mdp.GetOptions().GetDeprecated())
+		writeClientMethod(w, sdp, fqname, m, false, libMod)
+	}
+	w.p("}")
+	w.ln()
+}
+
+// writeServer writes the <Service>Server interface implemented by servers,
+// and <Service>Dispatcher, which routes calls by their method path to an
+// implementation, decoding requests and encoding responses.
+func writeServer(w *writer, sdp *desc.ServiceDescriptorProto, path []int32, methods []method, mr *moduleResolver, libMod *modRef) {
+	mr.src.writeComment(w, path, sdp.GetOptions().GetDeprecated())
+	w.p("export interface %sServer {", sdp.GetName())
+	for _, m := range methods {
+		mr.src.writeComment(w, m.path, m.mdp.GetOptions().GetDeprecated())
+		in := m.InputTsName
+		if m.mdp.GetClientStreaming() {
+			in = fmt.Sprintf("AsyncIterable<%s>", m.InputTsName)
+		}
+		out := fmt.Sprintf("Promise<%s>", m.OutputTsName)
+		if m.mdp.GetServerStreaming() {
+			out = fmt.Sprintf("AsyncIterable<%s>", m.OutputTsName)
+		}
+		if m.mdp.GetClientStreaming() {
+			w.p("%s(mins: %s): %s;", m.TsName, in, out)
+		} else {
+			w.p("%s(min: %s): %s;", m.TsName, in, out)
+		}
+	}
+	w.p("}")
+	w.ln()
+
+	w.p("export function %sDispatcher(impl: %sServer): %s.Grpc.Dispatcher {", sdp.GetName(), sdp.GetName(), libMod.alias)
+	w.p("return new %s.Grpc.Dispatcher([", libMod.alias)
+	for _, m := range methods {
+		kind, arg := "unaryMethod", "min"
+		switch {
+		case m.mdp.GetClientStreaming() && m.mdp.GetServerStreaming():
+			kind, arg = "bidiStreamMethod", "mins"
+		case m.mdp.GetServerStreaming():
+			kind = "serverStreamMethod"
+		case m.mdp.GetClientStreaming():
+			kind, arg = "clientStreamMethod", "mins"
+		}
+		w.p("  %s.Grpc.%s(%sDescriptor.methods.%s, %s => impl.%s(%s)),", libMod.alias, kind, sdp.GetName(), m.TsName, arg, m.TsName, arg)
+	}
+	w.p("]);")
+	w.p("}")
+	w.ln()
 }
 
 // writer is a little helper for output printing. It indents code
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"fmt"
+	desc "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"strings"
+)
+
+// writeFieldTable writes the static table describing the fields of a
+// message, which the runtime library's reflection functions are built on.
+func writeFieldTable(w *writer, fields []*field, libMod *modRef) {
+	if len(fields) < 1 {
+		w.p("static readonly fields: %s.FieldInfo[] = [];", libMod.alias)
+		w.ln()
+		return
+	}
+	w.p("static readonly fields: %s.FieldInfo[] = [", libMod.alias)
+	for _, f := range fields {
+		w.p("  %s,", f.fieldInfo(libMod))
+	}
+	w.p("];")
+	w.ln()
+}
+
+// fieldInfo returns the FieldInfo literal describing the field. Message and
+// enum types are referenced lazily, as they may be declared later in the
+// file.
+func (f field) fieldInfo(libMod *modRef) string {
+	props := []string{
+		"name: " + jsString(f.fd.GetName()),
+		fmt.Sprintf("number: %d", f.fd.GetNumber()),
+		"type: " + fieldTypeName(libMod, f.fd.GetType()),
+		"label: " + libMod.alias + ".FieldLabel." + strings.TrimPrefix(f.fd.GetLabel().String(), "LABEL_"),
+		"jsonName: " + jsString(f.jsonName()),
+	}
+	if f.isOneofMember() {
+		props = append(props,
+			"member: "+jsString(f.oneof.name),
+			"oneof: "+jsString(f.oneof.odp.GetName()),
+			fmt.Sprintf("oneofCase: () => %s.%s", f.oneof.fqNamespace, f.oneofClassName()))
+	} else {
+		props = append(props, "member: "+jsString(f.varName()))
+	}
+	ref := &f
+	if f.isMap {
+		k, v := f.mapFields()
+		props = append(props, fmt.Sprintf("map: { key: %s, value: %s }",
+			fieldTypeName(libMod, k.fd.GetType()), fieldTypeName(libMod, v.fd.GetType())))
+		ref = v
+	}
+	switch {
+	case ref.isMessage():
+		props = append(props, "messageType: () => "+ref.typeTsName)
+	case ref.fd.GetType() == desc.FieldDescriptorProto_TYPE_ENUM:
+		props = append(props, "enumType: () => "+ref.typeTsName+"Info")
+	}
+	return "{ " + strings.Join(props, ", ") + " }"
+}
+
+func fieldTypeName(libMod *modRef, t desc.FieldDescriptorProto_Type) string {
+	return libMod.alias + ".FieldType." + strings.TrimPrefix(t.String(), "TYPE_")
+}
+
+// writeEnumInfo writes the EnumInfo describing an enum, as the const enum
+// itself has no runtime representation.
+func writeEnumInfo(w *writer, edp *desc.EnumDescriptorProto, name, fqName string, libMod *modRef) {
+	w.p("export const %sInfo: %s.EnumInfo = {", name, libMod.alias)
+	w.p("typeName: %s,", jsString(fqName))
+	w.p("values: [")
+	for _, v := range edp.Value {
+		w.p("  { name: %s, number: %d },", jsString(v.GetName()), v.GetNumber())
+	}
+	w.p("],")
+	w.p("};")
+}
+
+// writeRegistrations adds the messages and enums of the current file, and
+// its embedded descriptor, to the runtime library's global registry, so that
+// they can be found by their fully qualified names.
+func writeRegistrations(w *writer, mr *moduleResolver, libMod *modRef) {
+	for _, m := range mr.messages {
+		w.p("%s.globalRegistry.add(%s);", libMod.alias, m)
+	}
+	for _, e := range mr.enums {
+		w.p("%s.globalRegistry.addEnum(%s);", libMod.alias, e)
+	}
+	if mr.opts.EmbedDescriptors {
+		w.p("%s.globalRegistry.addFile(fileDescriptor);", libMod.alias)
+	}
+}
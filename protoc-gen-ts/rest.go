@@ -0,0 +1,321 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"github.com/golang/protobuf/proto"
+	desc "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"strings"
+)
+
+// httpRuleField is the number of the google.api.http extension of
+// google.protobuf.MethodOptions.
+const httpRuleField = 72295728
+
+// httpRule is a google.api.HttpRule, which maps a method to a REST endpoint.
+type httpRule struct {
+	method, path       string
+	body, responseBody string
+}
+
+// methodHTTPRule returns the google.api.http rule of a method, or nil if it
+// has none. The extension is not linked into the generator, so it is decoded
+// from the marshaled options, which keep unknown extensions. Additional
+// bindings are ignored, as clients only need one.
+func methodHTTPRule(mdp *desc.MethodDescriptorProto) (*httpRule, error) {
+	if mdp.Options == nil {
+		return nil, nil
+	}
+	opts, err := proto.Marshal(mdp.Options)
+	if err != nil {
+		return nil, err
+	}
+	var b []byte
+	found := false
+	err = consumeFields(opts, func(num int32, v []byte) error {
+		if num == httpRuleField {
+			// Occurrences of a message field are merged.
+			b = append(b, v...)
+			found = true
+		}
+		return nil
+	})
+	if err != nil || !found {
+		return nil, err
+	}
+
+	r := &httpRule{}
+	err = consumeFields(b, func(num int32, v []byte) error {
+		switch num {
+		case 2:
+			r.method, r.path = "GET", string(v)
+		case 3:
+			r.method, r.path = "PUT", string(v)
+		case 4:
+			r.method, r.path = "POST", string(v)
+		case 5:
+			r.method, r.path = "DELETE", string(v)
+		case 6:
+			r.method, r.path = "PATCH", string(v)
+		case 7:
+			r.body = string(v)
+		case 8:
+			// CustomHttpPattern
+			r.method, r.path = "", ""
+			return consumeFields(v, func(num int32, v []byte) error {
+				switch num {
+				case 1:
+					r.method = string(v)
+				case 2:
+					r.path = string(v)
+				}
+				return nil
+			})
+		case 12:
+			r.responseBody = string(v)
+		}
+		return nil
+	})
+	return r, err
+}
+
+var errTruncated = errors.New("truncated message")
+
+// consumeFields calls f with each length delimited field of the encoded
+// message b, skipping the others.
+func consumeFields(b []byte, f func(num int32, v []byte) error) error {
+	for len(b) > 0 {
+		num, typ, v, n, err := consumeField(b)
+		if err != nil {
+			return err
+		}
+		b = b[n:]
+		if typ != proto.WireBytes {
+			continue
+		}
+		if err := f(num, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// consumeField decodes the field at the start of b, returning its number and
+// wire type, its value if it is length delimited, and its encoded length.
+func consumeField(b []byte) (num int32, typ int, v []byte, n int, err error) {
+	tag, n := proto.DecodeVarint(b)
+	if n == 0 {
+		return 0, 0, nil, 0, errTruncated
+	}
+	num, typ = int32(tag>>3), int(tag&7)
+	switch typ {
+	case proto.WireVarint:
+		_, m := proto.DecodeVarint(b[n:])
+		if m == 0 {
+			return 0, 0, nil, 0, errTruncated
+		}
+		n += m
+	case proto.WireFixed64:
+		n += 8
+	case proto.WireFixed32:
+		n += 4
+	case proto.WireBytes:
+		l, m := proto.DecodeVarint(b[n:])
+		if m == 0 || l > uint64(len(b)-n-m) {
+			return 0, 0, nil, 0, errTruncated
+		}
+		n += m
+		v = b[n : n+int(l)]
+		n += int(l)
+	case proto.WireStartGroup:
+		for {
+			_, t, _, m, err := consumeField(b[n:])
+			if err != nil {
+				return 0, 0, nil, 0, err
+			}
+			n += m
+			if t == proto.WireEndGroup {
+				break
+			}
+		}
+	case proto.WireEndGroup:
+	default:
+		return 0, 0, nil, 0, fmt.Errorf("invalid wire type %d", typ)
+	}
+	if n > len(b) {
+		return 0, 0, nil, 0, errTruncated
+	}
+	return num, typ, v, n, nil
+}
+
+// restPath converts a path template, e.g. "/v1/{name=shelves/*}:publish",
+// to the parts of a Rest.Rule's path: literal strings, and variables bound
+// to a field path of the request by the JSON names of its fields. Variables
+// matching more than one segment keep their slashes when expanded.
+func restPath(template string, dp *desc.DescriptorProto, ns *Namespace) ([]string, error) {
+	if !strings.HasPrefix(template, "/") {
+		return nil, fmt.Errorf("path template %q must start with /", template)
+	}
+	parts := []string{}
+	for t := template; t != ""; {
+		i := strings.IndexAny(t, "{}*")
+		if i < 0 {
+			parts = append(parts, jsString(t))
+			break
+		}
+		if t[i] != '{' {
+			return nil, fmt.Errorf("path template %q has a %q outside of a variable, which can't be expanded", template, t[i])
+		}
+		if i > 0 {
+			parts = append(parts, jsString(t[:i]))
+		}
+		j := strings.Index(t, "}")
+		if j < 0 {
+			return nil, fmt.Errorf("path template %q has an unterminated variable", template)
+		}
+		name, pattern := t[i+1:j], "*"
+		if k := strings.Index(name, "="); k >= 0 {
+			name, pattern = name[:k], name[k+1:]
+		}
+		fieldPath, err := restFieldPath(dp, name, ns)
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, fmt.Sprintf("{ field: [%s], multi: %t }", strings.Join(fieldPath, ", "), pattern != "*"))
+		t = t[j+1:]
+	}
+	return parts, nil
+}
+
+// restFieldPath resolves a field path of the request bound to the path, e.g.
+// "book.name", to the JSON names of its fields.
+func restFieldPath(dp *desc.DescriptorProto, path string, ns *Namespace) ([]string, error) {
+	names := []string{}
+	fields := strings.Split(path, ".")
+	for i, name := range fields {
+		fd := findField(dp, name)
+		if fd == nil {
+			return nil, fmt.Errorf("%s has no field %q, which is bound to the path", dp.GetName(), name)
+		}
+		if fd.GetLabel() == desc.FieldDescriptorProto_LABEL_REPEATED {
+			return nil, fmt.Errorf("repeated field %q can't be bound to the path", path)
+		}
+		names = append(names, jsString(field{fd: fd}.jsonName()))
+		isMessage := fd.GetType() == desc.FieldDescriptorProto_TYPE_MESSAGE || fd.GetType() == desc.FieldDescriptorProto_TYPE_GROUP
+		if i == len(fields)-1 {
+			if isMessage {
+				return nil, fmt.Errorf("message field %q can't be bound to the path", path)
+			}
+			break
+		}
+		if !isMessage {
+			return nil, fmt.Errorf("field %q of %q is not a message", name, path)
+		}
+		_, _, t, _, err := ns.FindFullyQualifiedName(fd.GetTypeName())
+		if err != nil {
+			return nil, err
+		}
+		dp = t.(*desc.DescriptorProto)
+	}
+	return names, nil
+}
+
+func findField(dp *desc.DescriptorProto, name string) *desc.FieldDescriptorProto {
+	for _, fd := range dp.Field {
+		if fd.GetName() == name {
+			return fd
+		}
+	}
+	return nil
+}
+
+// restBodyField returns the JSON name of the body or response_body field of
+// a rule, or "" for none.
+func restBodyField(dp *desc.DescriptorProto, name string) (string, error) {
+	if name == "" || name == "*" {
+		return name, nil
+	}
+	fd := findField(dp, name)
+	if fd == nil {
+		return "", fmt.Errorf("%s has no body field %q", dp.GetName(), name)
+	}
+	return field{fd: fd}.jsonName(), nil
+}
+
+// writeRestClient writes <Service>RestClient, which calls the methods that
+// have a google.api.http rule at their REST endpoints, sending and receiving
+// proto3 JSON. It is not written for services without any rules.
+func writeRestClient(w *writer, sdp *desc.ServiceDescriptorProto, path []int32, methods []method, ns *Namespace, mr *moduleResolver, libMod *modRef) {
+	type restMethod struct {
+		method
+		rule               *httpRule
+		parts              []string
+		body, responseBody string
+	}
+	rest := []restMethod{}
+	for _, m := range methods {
+		rule, err := methodHTTPRule(m.mdp)
+		if err != nil {
+			mr.src.fail(m.path, "invalid google.api.http option: %v", err)
+		}
+		if rule == nil {
+			continue
+		}
+		if m.mdp.GetClientStreaming() || m.mdp.GetServerStreaming() {
+			mr.src.warn(m.path, "streaming method %q is not supported by the REST client and was skipped", m.mdp.GetName())
+			continue
+		}
+		if rule.method == "" || rule.method == "*" || rule.path == "" {
+			mr.src.warn(m.path, "method %q has no HTTP method or path in its google.api.http option and was skipped", m.mdp.GetName())
+			continue
+		}
+		_, _, in, _, err := ns.FindFullyQualifiedName(m.mdp.GetInputType())
+		if err != nil {
+			mr.src.fail(m.path, "%v", err)
+		}
+		_, _, out, _, err := ns.FindFullyQualifiedName(m.mdp.GetOutputType())
+		if err != nil {
+			mr.src.fail(m.path, "%v", err)
+		}
+		rm := restMethod{method: m, rule: rule}
+		if rm.parts, err = restPath(rule.path, in.(*desc.DescriptorProto), ns); err != nil {
+			mr.src.fail(m.path, "invalid google.api.http option: %v", err)
+		}
+		if rm.body, err = restBodyField(in.(*desc.DescriptorProto), rule.body); err != nil {
+			mr.src.fail(m.path, "invalid google.api.http option: %v", err)
+		}
+		if rule.responseBody == "*" {
+			rule.responseBody = ""
+		}
+		if rm.responseBody, err = restBodyField(out.(*desc.DescriptorProto), rule.responseBody); err != nil {
+			mr.src.fail(m.path, "invalid google.api.http option: %v", err)
+		}
+		rest = append(rest, rm)
+	}
+	if len(rest) == 0 {
+		return
+	}
+
+	mr.src.writeComment(w, path, sdp.GetOptions().GetDeprecated())
+	w.p("export class %sRestClient {", sdp.GetName())
+	w.p("private t: %s.Rest.Transport;", libMod.alias)
+	w.p("constructor(baseUrl: string, options?: %s.Rest.Options) {", libMod.alias)
+	w.p("this.t = new %s.Rest.Transport(baseUrl, options);", libMod.alias)
+	w.p("}")
+	for _, m := range rest {
+		w.ln()
+		mr.src.writeComment(w, m.path, m.mdp.GetOptions().GetDeprecated())
+		w.p("async %s(min: %s): Promise<%s> {", m.TsName, m.InputTsName, m.OutputTsName)
+		w.p("let mout = new %s();", m.OutputTsName)
+		w.p("await this.t.call({")
+		w.p("method: %s,", jsString(m.rule.method))
+		w.p("path: [%s],", strings.Join(m.parts, ", "))
+		w.p("body: %s,", jsString(m.body))
+		w.p("responseBody: %s,", jsString(m.responseBody))
+		w.p("}, min, mout);")
+		w.p("return mout;")
+		w.p("}")
+	}
+	w.p("}")
+	w.ln()
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"github.com/golang/protobuf/proto"
+	desc "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"reflect"
+	"testing"
+)
+
+// bytesField encodes a length delimited field.
+func bytesField(num int, v []byte) []byte {
+	b := proto.EncodeVarint(uint64(num)<<3 | proto.WireBytes)
+	b = append(b, proto.EncodeVarint(uint64(len(v)))...)
+	return append(b, v...)
+}
+
+func TestMethodHTTPRule(t *testing.T) {
+	custom := append(bytesField(1, []byte("HEAD")), bytesField(2, []byte("/v1/x"))...)
+	// A group, field 5, holding varint field 1 and fixed32 field 2.
+	group := []byte{5<<3 | proto.WireStartGroup, 1 << 3, 1, 2<<3 | proto.WireFixed32, 0, 0, 0, 0, 5<<3 | proto.WireEndGroup}
+	tests := []struct {
+		options []byte
+		want    *httpRule
+	}{
+		{nil, nil},
+		{append(append(proto.EncodeVarint(33<<3), 1), group...), nil},
+		{
+			append(group, bytesField(httpRuleField, append(bytesField(2, []byte("/v1/{name}")), bytesField(7, []byte("*"))...))...),
+			&httpRule{method: "GET", path: "/v1/{name}", body: "*"},
+		},
+		{
+			// Occurrences of the option are merged.
+			append(bytesField(httpRuleField, bytesField(4, []byte("/v1/a"))), bytesField(httpRuleField, bytesField(12, []byte("b")))...),
+			&httpRule{method: "POST", path: "/v1/a", responseBody: "b"},
+		},
+		{bytesField(httpRuleField, bytesField(8, custom)), &httpRule{method: "HEAD", path: "/v1/x"}},
+	}
+	for i, tt := range tests {
+		opts := &desc.MethodOptions{}
+		if err := proto.Unmarshal(tt.options, opts); err != nil {
+			t.Fatalf("%d: %v", i, err)
+		}
+		got, err := methodHTTPRule(&desc.MethodDescriptorProto{Options: opts})
+		if err != nil {
+			t.Errorf("%d: %v", i, err)
+		} else if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%d: got %+v, want %+v", i, got, tt.want)
+		}
+	}
+}
+
+func TestConsumeFieldsTruncated(t *testing.T) {
+	for _, b := range [][]byte{{1 << 3}, {2<<3 | proto.WireBytes, 5, 'a'}, {3<<3 | proto.WireFixed64, 0}, {4<<3 | proto.WireStartGroup}} {
+		if err := consumeFields(b, func(int32, []byte) error { return nil }); err == nil {
+			t.Errorf("consumeFields(%v) succeeded, want an error", b)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	desc "github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// writeTwirp writes <Service>TwirpClient, which calls the service over the
+// Twirp protocol, the <Service>TwirpServer interface implemented by servers
+// and <Service>TwirpHandler, which serves an implementation. Twirp has no
+// streaming, so streaming methods are skipped with a warning.
+func writeTwirp(w *writer, sdp *desc.ServiceDescriptorProto, path []int32, methods []method, mr *moduleResolver, libMod *modRef) {
+	unary := []method{}
+	for _, m := range methods {
+		if m.mdp.GetClientStreaming() || m.mdp.GetServerStreaming() {
+			mr.src.warn(m.path, "streaming method %q is not supported by twirp and was skipped", m.mdp.GetName())
+			continue
+		}
+		unary = append(unary, m)
+	}
+
+	// Client
+	mr.src.writeComment(w, path, sdp.GetOptions().GetDeprecated())
+	w.p("export class %sTwirpClient {", sdp.GetName())
+	w.p("private t: %s.Twirp.Transport;", libMod.alias)
+	w.p("constructor(baseUrl: string, options?: %s.Twirp.Options) {", libMod.alias)
+	w.p("this.t = new %s.Twirp.Transport(baseUrl, options);", libMod.alias)
+	w.p("}")
+	for _, m := range unary {
+		w.ln()
+		mr.src.writeComment(w, m.path, m.mdp.GetOptions().GetDeprecated())
+		w.p("async %s(min: %s): Promise<%s> {", m.TsName, m.InputTsName, m.OutputTsName)
+		w.p("let mout = new %s();", m.OutputTsName)
+		w.p("await this.t.call(%sDescriptor.methods.%s, min, mout);", sdp.GetName(), m.TsName)
+		w.p("return mout;")
+		w.p("}")
+	}
+	w.p("}")
+	w.ln()
+
+	// Server
+	mr.src.writeComment(w, path, sdp.GetOptions().GetDeprecated())
+	w.p("export interface %sTwirpServer {", sdp.GetName())
+	for _, m := range unary {
+		mr.src.writeComment(w, m.path, m.mdp.GetOptions().GetDeprecated())
+		w.p("%s(min: %s): Promise<%s>;", m.TsName, m.InputTsName, m.OutputTsName)
+	}
+	w.p("}")
+	w.ln()
+
+	w.p("export function %sTwirpHandler(impl: %sTwirpServer, options?: %s.Twirp.HandlerOptions): %s.Twirp.Handler {", sdp.GetName(), sdp.GetName(), libMod.alias, libMod.alias)
+	w.p("return new %s.Twirp.Handler([", libMod.alias)
+	for _, m := range unary {
+		w.p("  %s.Twirp.method(%sDescriptor.methods.%s, min => impl.%s(min)),", libMod.alias, sdp.GetName(), m.TsName, m.TsName)
+	}
+	w.p("], options);")
+	w.p("}")
+	w.ln()
+}
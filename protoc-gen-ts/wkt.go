@@ -0,0 +1,277 @@
+package main
+
+import (
+	"fmt"
+	desc "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"strings"
+)
+
+// Well known types which may be generated as types from the runtime library,
+// rather than as their generated classes.
+const (
+	timestampName = ".google.protobuf.Timestamp"
+	durationName  = ".google.protobuf.Duration"
+	anyName       = ".google.protobuf.Any"
+	structName    = ".google.protobuf.Struct"
+	valueName     = ".google.protobuf.Value"
+	listValueName = ".google.protobuf.ListValue"
+	fieldMaskName = ".google.protobuf.FieldMask"
+)
+
+// wrapperTypes maps the wrapper well known types to the type of their value
+// field.
+var wrapperTypes = map[string]desc.FieldDescriptorProto_Type{
+	".google.protobuf.DoubleValue": desc.FieldDescriptorProto_TYPE_DOUBLE,
+	".google.protobuf.FloatValue":  desc.FieldDescriptorProto_TYPE_FLOAT,
+	".google.protobuf.Int64Value":  desc.FieldDescriptorProto_TYPE_INT64,
+	".google.protobuf.UInt64Value": desc.FieldDescriptorProto_TYPE_UINT64,
+	".google.protobuf.Int32Value":  desc.FieldDescriptorProto_TYPE_INT32,
+	".google.protobuf.UInt32Value": desc.FieldDescriptorProto_TYPE_UINT32,
+	".google.protobuf.BoolValue":   desc.FieldDescriptorProto_TYPE_BOOL,
+	".google.protobuf.StringValue": desc.FieldDescriptorProto_TYPE_STRING,
+	".google.protobuf.BytesValue":  desc.FieldDescriptorProto_TYPE_BYTES,
+}
+
+// libraryType returns the runtime library class which stands in for the
+// message type typeName, or "" if the generated class is used. The library
+// classes are encoded identically to the generated ones.
+func libraryType(typeName string, opts *Options) string {
+	switch {
+	case typeName == timestampName && opts.Timestamp != "message":
+		return "Timestamp"
+	case typeName == durationName && opts.Duration != "message":
+		return "Duration"
+	case wrapperTypes[typeName] != 0 && opts.Wrappers != "message":
+		return strings.TrimPrefix(typeName, ".google.protobuf.")
+	case isStructType(typeName) && opts.Struct != "message":
+		return strings.TrimPrefix(typeName, ".google.protobuf.")
+	}
+	return ""
+}
+
+// isStructType reports whether typeName is one of the types which represent
+// arbitrary JSON: Struct, Value and ListValue.
+func isStructType(typeName string) bool {
+	return typeName == structName || typeName == valueName || typeName == listValueName
+}
+
+// convertedType returns typeName if fields of the type are generated as a
+// typescript type which is converted to and from the library class, or "".
+func convertedType(typeName string, opts *Options) string {
+	switch {
+	case typeName == timestampName && opts.Timestamp == "date":
+		return typeName
+	case wrapperTypes[typeName] != 0 && opts.Wrappers == "primitive":
+		return typeName
+	case isStructType(typeName) && opts.Struct == "json":
+		return typeName
+	}
+	return ""
+}
+
+// wrappedField returns a field of the type wrapped by a converted wrapper
+// type, for its typescript type and default value.
+func (f field) wrappedField() *field {
+	t := wrapperTypes[f.converted]
+	return &field{
+		fd: &desc.FieldDescriptorProto{
+			Type:  &t,
+			Label: desc.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+		},
+		mr: f.mr,
+	}
+}
+
+// convertedTsType is the typescript type of a converted field.
+func (f field) convertedTsType() string {
+	switch f.converted {
+	case timestampName:
+		return "Date"
+	case structName:
+		return f.mr.libMod.alias + ".JsonObject"
+	case valueName:
+		return f.mr.libMod.alias + ".JsonValue"
+	case listValueName:
+		return f.mr.libMod.alias + ".JsonValue[]"
+	}
+	return f.wrappedField().tsType()
+}
+
+// fromMessage converts v, the decoded library class, to the field's type.
+func (f field) fromMessage(v string) string {
+	switch {
+	case f.converted == timestampName:
+		return v + ".toDate()"
+	case f.converted != "":
+		return v + ".value"
+	}
+	return v
+}
+
+// toMessage converts v of the field's type to the library class it is
+// encoded with.
+func (f field) toMessage(v string) string {
+	switch {
+	case f.converted == timestampName:
+		return fmt.Sprintf("%s.fromDate(%s)", f.typeTsName, v)
+	case f.converted != "":
+		return fmt.Sprintf("new %s(%s)", f.typeTsName, v)
+	}
+	return v
+}
+
+// newMessage returns an expression for an empty value of a message field.
+func (f field) newMessage() string {
+	switch f.converted {
+	case "":
+		return fmt.Sprintf("new %s()", f.typeTsName)
+	case timestampName:
+		return "new Date(0)"
+	case structName:
+		return "{}"
+	case valueName:
+		return "null"
+	case listValueName:
+		return "[]"
+	}
+	return f.wrappedField().defaultValue()
+}
+
+// unsetValue is the value of a singular message field which is not set.
+// Fields converted to a JsonValue are undefined when unset, as null is the
+// NullValue.
+func (f field) unsetValue() string {
+	if f.converted == valueName {
+		return "undefined"
+	}
+	return "null"
+}
+
+// isSet returns a condition which is true when v, a value of a singular
+// message field, is set.
+func (f field) isSet(v string) string {
+	if f.converted == valueName {
+		return v + " !== undefined"
+	}
+	return v + " != null"
+}
+
+// isUnset negates isSet.
+func (f field) isUnset(v string) string {
+	if f.converted == valueName {
+		return v + " === undefined"
+	}
+	return v + " == null"
+}
+
+// wellKnownMessage returns the fully qualified name of a message if it is a
+// well known type with a special JSON representation, or "".
+func wellKnownMessage(fqName string) string {
+	fqn := "." + fqName
+	if fqn == timestampName || fqn == durationName || fqn == anyName || fqn == fieldMaskName || wrapperTypes[fqn] != 0 || isStructType(fqn) {
+		return fqn
+	}
+	return ""
+}
+
+// writeWellKnownJSON writes the JSON methods of a well known type declared
+// in the current file, which convert through the runtime library's class.
+func writeWellKnownJSON(w *writer, fqn string, libMod *modRef) {
+	if fqn == anyName {
+		writeAnyMethods(w, libMod)
+		return
+	}
+	if fqn == fieldMaskName {
+		writeFieldMaskJSON(w, libMod)
+		return
+	}
+	class := libMod.alias + "." + strings.TrimPrefix(fqn, ".google.protobuf.")
+	if isStructType(fqn) {
+		writeStructJSON(w, class, libMod)
+		return
+	}
+	fields := []string{"value"}
+	if fqn == timestampName || fqn == durationName {
+		fields = []string{"seconds", "nanos"}
+	}
+	w.p("MergeFromJSON(j: %s.JsonValue, _: %s.JsonOptions = {}): void {", libMod.alias, libMod.alias)
+	w.p("const v = new %s();", class)
+	w.p("v.MergeFromJSON(j);")
+	for _, f := range fields {
+		w.p("this.%s = v.%s;", f, f)
+	}
+	w.p("}")
+	w.ln()
+	w.p("ToJSON(_: %s.JsonOptions = {}): %s.JsonValue {", libMod.alias, libMod.alias)
+	w.p("return new %s(this.%s).ToJSON();", class, strings.Join(fields, ", this."))
+	w.p("}")
+}
+
+// writeStructJSON writes the JSON methods of Struct, Value or ListValue,
+// which convert through the wire format of the runtime library's class as
+// their fields are nested messages.
+func writeStructJSON(w *writer, class string, libMod *modRef) {
+	w.p("MergeFromJSON(j: %s.JsonValue, _: %s.JsonOptions = {}): void {", libMod.alias, libMod.alias)
+	w.p("const v = new %s();", class)
+	w.p("v.MergeFromJSON(j);")
+	w.p("%s.Unmarshal(%s.Marshal(v), this);", libMod.alias, libMod.alias)
+	w.p("}")
+	w.ln()
+	w.p("ToJSON(_: %s.JsonOptions = {}): %s.JsonValue {", libMod.alias, libMod.alias)
+	w.p("const v = new %s();", class)
+	w.p("%s.Unmarshal(%s.Marshal(this), v);", libMod.alias, libMod.alias)
+	w.p("return v.ToJSON();")
+	w.p("}")
+}
+
+// writeFieldMaskJSON writes the JSON methods of FieldMask, which is a string
+// of comma separated paths with their names in lowerCamelCase.
+func writeFieldMaskJSON(w *writer, libMod *modRef) {
+	w.p("MergeFromJSON(j: %s.JsonValue, _: %s.JsonOptions = {}): void {", libMod.alias, libMod.alias)
+	w.p("this.paths = %s.Internal.fieldMaskFromJSON(j);", libMod.alias)
+	w.p("}")
+	w.ln()
+	w.p("ToJSON(_: %s.JsonOptions = {}): %s.JsonValue {", libMod.alias, libMod.alias)
+	w.p("return %s.Internal.fieldMaskToJSON(this.paths);", libMod.alias)
+	w.p("}")
+}
+
+// writeAnyMethods writes the pack and unpack helpers of google.protobuf.Any,
+// and its JSON methods which resolve the packed type through
+// JsonOptions.typeRegistry.
+func writeAnyMethods(w *writer, libMod *modRef) {
+	pb := libMod.alias
+	w.p("// pack returns an Any holding m, with a type URL made of urlPrefix and")
+	w.p("// the fully qualified name of m's type.")
+	w.p("static pack(m: %s.Message, urlPrefix: string = %s.Internal.typeUrlPrefix): Any {", pb, pb)
+	w.p("const a = new Any();")
+	w.p("a.type_url = urlPrefix + %s.typeNameOf(m);", pb)
+	w.p("a.value = %s.Marshal(m);", pb)
+	w.p("return a;")
+	w.p("}")
+	w.ln()
+	w.p("// is reports whether the Any holds a message of type cls.")
+	w.p("is(cls: %s.MessageClass): boolean {", pb)
+	w.p("return %s.Internal.typeUrlName(this.type_url) == cls.typeName;", pb)
+	w.p("}")
+	w.ln()
+	w.p("// unpack decodes the held message, which must be of type cls.")
+	w.p("unpack<T extends %s.Message>(cls: %s.MessageClass<T>): T {", pb, pb)
+	w.p("const m = new cls();")
+	w.p("this.unpackTo(m);")
+	w.p("return m;")
+	w.p("}")
+	w.ln()
+	w.p("// unpackTo merges the held message into m, which must be of its type.")
+	w.p("unpackTo(m: %s.Message): void {", pb)
+	w.p("%s.Internal.anyUnpackTo(this.type_url, this.value, m);", pb)
+	w.p("}")
+	w.ln()
+	w.p("MergeFromJSON(j: %s.JsonValue, o: %s.JsonOptions = {}): void {", pb, pb)
+	w.p("[this.type_url, this.value] = %s.Internal.anyFromJSON(j, o);", pb)
+	w.p("}")
+	w.ln()
+	w.p("ToJSON(o: %s.JsonOptions = {}): %s.JsonValue {", pb, pb)
+	w.p("return %s.Internal.anyToJSON(this.type_url, this.value, o);", pb)
+	w.p("}")
+}
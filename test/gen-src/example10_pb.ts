@@ -0,0 +1,573 @@
+// Generated by the protocol buffer compiler.  DO NOT EDIT!
+// Source: example10.proto
+
+import * as __pb__ from '../../lib/protobuf'
+
+
+export interface example10Init {
+  astruct?: __pb__.JsonObject;
+  avalue?: __pb__.JsonValue;
+  alist?: __pb__.JsonValue[];
+  many?: __pb__.JsonValue[];
+  amap?: Map<string, __pb__.JsonValue>;
+  aoneof?: example10.aoneof.oneof_type;
+  onevalue?: __pb__.JsonValue;
+  onestring?: string;
+}
+
+export interface Iexample10 {
+  astruct?: __pb__.JsonObject;
+  avalue?: __pb__.JsonValue;
+  alist?: __pb__.JsonValue[];
+  many?: __pb__.JsonValue[];
+  amap?: { [k: string]: __pb__.JsonValue };
+  onevalue?: __pb__.JsonValue;
+  onestring?: string;
+}
+
+/**
+ * Generated with wkt_struct=json, strip_source_info and
+ * discard_unknown_fields.
+ */
+export class example10 implements __pb__.Message {
+  static readonly typeName = "foo.structs.example10";
+
+  static readonly fields: __pb__.FieldInfo[] = [
+    { name: "astruct", number: 1, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.OPTIONAL, jsonName: "astruct", member: "astruct", messageType: () => __pb__.Struct },
+    { name: "avalue", number: 2, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.OPTIONAL, jsonName: "avalue", member: "avalue", messageType: () => __pb__.Value },
+    { name: "alist", number: 3, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.OPTIONAL, jsonName: "alist", member: "alist", messageType: () => __pb__.ListValue },
+    { name: "many", number: 4, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.REPEATED, jsonName: "many", member: "many", messageType: () => __pb__.Value },
+    { name: "amap", number: 5, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.REPEATED, jsonName: "amap", member: "amap", map: { key: __pb__.FieldType.STRING, value: __pb__.FieldType.MESSAGE }, messageType: () => __pb__.Value },
+    { name: "onevalue", number: 6, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.OPTIONAL, jsonName: "onevalue", member: "aoneof", oneof: "aoneof", oneofCase: () => example10.aoneof.onevalue, messageType: () => __pb__.Value },
+    { name: "onestring", number: 7, type: __pb__.FieldType.STRING, label: __pb__.FieldLabel.OPTIONAL, jsonName: "onestring", member: "aoneof", oneof: "aoneof", oneofCase: () => example10.aoneof.onestring },
+  ];
+
+  astruct: __pb__.JsonObject | null;
+  avalue: __pb__.JsonValue | undefined;
+  alist: __pb__.JsonValue[] | null;
+  many: __pb__.JsonValue[];
+  amap: Map<string, __pb__.JsonValue>;
+  aoneof: example10.aoneof.oneof_type;
+
+  constructor(init?: example10Init) {
+    this.astruct = null;
+    this.avalue = undefined;
+    this.alist = null;
+    this.many = [];
+    this.amap = new Map<string, __pb__.JsonValue>();
+    this.aoneof = __pb__.OneofNotSet.singleton;
+    if (init !== undefined) {
+      if (init.astruct !== undefined) this.astruct = init.astruct;
+      if (init.avalue !== undefined) this.avalue = init.avalue;
+      if (init.alist !== undefined) this.alist = init.alist;
+      if (init.many !== undefined) this.many = init.many.slice();
+      if (init.amap !== undefined) {
+        for (const [k, v] of init.amap) {
+          this.amap.set(k, v);
+        }
+      }
+      if (init.aoneof !== undefined) this.aoneof = init.aoneof;
+      if (init.onevalue !== undefined) this.aoneof = new example10.aoneof.onevalue(init.onevalue);
+      if (init.onestring !== undefined) this.aoneof = new example10.aoneof.onestring(init.onestring);
+    }
+  }
+
+  MergeFrom(d: __pb__.Internal.Decoder): void {
+    while (!d.isEOF()) {
+      let [fn, wt] = d.readTag();
+      switch(fn) {
+        case 1:
+        {
+          let msg = this.astruct == null ? new __pb__.Struct() : new __pb__.Struct(this.astruct);
+          msg.MergeFrom(d.readDecoder());
+          this.astruct = msg.value;
+        }
+        break;
+        case 2:
+        {
+          let msg = this.avalue === undefined ? new __pb__.Value() : new __pb__.Value(this.avalue);
+          msg.MergeFrom(d.readDecoder());
+          this.avalue = msg.value;
+        }
+        break;
+        case 3:
+        {
+          let msg = this.alist == null ? new __pb__.ListValue() : new __pb__.ListValue(this.alist);
+          msg.MergeFrom(d.readDecoder());
+          this.alist = msg.value;
+        }
+        break;
+        case 4:
+        {
+          let obj = new __pb__.Value();
+          obj.MergeFrom(d.readDecoder());
+          this.many.push(obj.value)
+        }
+        break;
+        case 5:
+        {
+          let obj = new example10.AmapEntry();
+          obj.MergeFrom(d.readDecoder());
+          this.amap.set(obj.key, obj.value == null ? null : obj.value);
+        }
+        break;
+        case 6:
+        {
+          let msg = new __pb__.Value();
+          msg.MergeFrom(d.readDecoder());
+          this.aoneof = new example10.aoneof.onevalue(msg.value);
+        }
+        break;
+        case 7:
+        this.aoneof = new example10.aoneof.onestring(d.readValidString());
+        break;
+        default:
+        d.skipWireType(wt, fn)
+      }
+    }
+  }
+
+  WriteTo(e: __pb__.Internal.Encoder): void {
+    {
+      const msg = this.astruct;
+      if (msg != null) {
+        let nested = new __pb__.Internal.Encoder();
+        new __pb__.Struct(msg).WriteTo(nested);
+        e.writeEncoder(nested, 1);
+      }
+    }
+    {
+      const msg = this.avalue;
+      if (msg !== undefined) {
+        let nested = new __pb__.Internal.Encoder();
+        new __pb__.Value(msg).WriteTo(nested);
+        e.writeEncoder(nested, 2);
+      }
+    }
+    {
+      const msg = this.alist;
+      if (msg != null) {
+        let nested = new __pb__.Internal.Encoder();
+        new __pb__.ListValue(msg).WriteTo(nested);
+        e.writeEncoder(nested, 3);
+      }
+    }
+    {
+      for (const msg of this.many) {
+        let nested = new __pb__.Internal.Encoder();
+        new __pb__.Value(msg).WriteTo(nested);
+        e.writeEncoder(nested, 4);
+      }
+    }
+    for (const [k, v] of this.amap) {
+      let obj = new example10.AmapEntry();
+      obj.key = k;
+      obj.value = v;
+      let nested = new __pb__.Internal.Encoder();
+      obj.WriteTo(nested);
+      e.writeEncoder(nested, 5);
+    }
+    example10.aoneof.WriteTo(this.aoneof, e);
+  }
+
+  MergeFromJSON(j: __pb__.JsonValue, o: __pb__.JsonOptions = {}): void {
+    const obj = __pb__.Internal.objectFromJSON(j);
+    for (const k in obj) {
+      const v = obj[k];
+      if (v === null && k != "avalue" && k != "onevalue") {
+        continue;
+      }
+      switch (k) {
+        case "astruct":
+        {
+          let msg = new __pb__.Struct();
+          msg.MergeFromJSON(v, o);
+          this.astruct = msg.value;
+        }
+        break;
+        case "avalue":
+        {
+          let msg = new __pb__.Value();
+          msg.MergeFromJSON(v, o);
+          this.avalue = msg.value;
+        }
+        break;
+        case "alist":
+        {
+          let msg = new __pb__.ListValue();
+          msg.MergeFromJSON(v, o);
+          this.alist = msg.value;
+        }
+        break;
+        case "many":
+        for (const elem of __pb__.Internal.arrayFromJSON(v)) {
+          {
+            let msg = new __pb__.Value();
+            msg.MergeFromJSON(elem, o);
+            this.many.push(msg.value);
+          }
+        }
+        break;
+        case "amap":
+        {
+          const m = __pb__.Internal.objectFromJSON(v);
+          for (const mk in m) {
+            {
+              let msg = new __pb__.Value();
+              msg.MergeFromJSON(m[mk], o);
+              this.amap.set(mk, msg.value);
+            }
+          }
+        }
+        break;
+        case "onevalue":
+        {
+          let msg = new __pb__.Value();
+          msg.MergeFromJSON(v, o);
+          this.aoneof = new example10.aoneof.onevalue(msg.value);
+        }
+        break;
+        case "onestring":
+        this.aoneof = new example10.aoneof.onestring(__pb__.Internal.stringFromJSON(v));
+        break;
+        default:
+        __pb__.Internal.unknownFieldFromJSON(k, o);
+      }
+    }
+  }
+
+  ToJSON(o: __pb__.JsonOptions = {}): __pb__.JsonValue {
+    const j: __pb__.JsonObject = {};
+    if (o.emitDefaults || this.astruct != null) {
+      const msg = this.astruct;
+      j["astruct"] = msg == null ? null : new __pb__.Struct(msg).ToJSON(o);
+    }
+    if (o.emitDefaults || this.avalue !== undefined) {
+      const msg = this.avalue;
+      j["avalue"] = msg === undefined ? null : new __pb__.Value(msg).ToJSON(o);
+    }
+    if (o.emitDefaults || this.alist != null) {
+      const msg = this.alist;
+      j["alist"] = msg == null ? null : new __pb__.ListValue(msg).ToJSON(o);
+    }
+    if (o.emitDefaults || this.many.length > 0) {
+      j["many"] = this.many.map(elem => new __pb__.Value(elem).ToJSON(o));
+    }
+    if (o.emitDefaults || this.amap.size > 0) {
+      const m: __pb__.JsonObject = {};
+      for (const [k, v] of this.amap) {
+        m[String(k)] = new __pb__.Value(v).ToJSON(o);
+      }
+      j["amap"] = m;
+    }
+    switch (this.aoneof.kind) {
+      case 6:
+      {
+        const msg = (this.aoneof as example10.aoneof.onevalue).value;
+        j["onevalue"] = new __pb__.Value((msg === undefined ? null : msg)).ToJSON(o);
+      }
+      break;
+      case 7:
+      j["onestring"] = (this.aoneof as example10.aoneof.onestring).value;
+      break;
+    }
+    return j;
+  }
+
+  // toObject returns the message as a plain object, holding no classes.
+  toObject(): Iexample10 {
+    const o: Iexample10 = {};
+    if (this.astruct != null) {
+      o.astruct = this.astruct;
+    }
+    if (this.avalue !== undefined) {
+      o.avalue = this.avalue;
+    }
+    if (this.alist != null) {
+      o.alist = this.alist;
+    }
+    o.many = this.many.slice();
+    {
+      const m: { [k: string]: __pb__.JsonValue } = {};
+      for (const [k, v] of this.amap) {
+        m[k] = v;
+      }
+      o.amap = m;
+    }
+    if (this.aoneof instanceof example10.aoneof.onevalue) {
+      o.onevalue = this.aoneof.value;
+    }
+    if (this.aoneof instanceof example10.aoneof.onestring) {
+      o.onestring = this.aoneof.value;
+    }
+    return o;
+  }
+
+  // fromObject returns a message from its plain object form.
+  static fromObject(o: Iexample10): example10 {
+    const m = new example10();
+    if (o.astruct !== undefined) m.astruct = o.astruct;
+    if (o.avalue !== undefined) m.avalue = o.avalue;
+    if (o.alist !== undefined) m.alist = o.alist;
+    if (o.many !== undefined) m.many = o.many.slice();
+    if (o.amap !== undefined) {
+      const obj = o.amap;
+      for (const k of Object.keys(obj)) {
+        m.amap.set(k, obj[k]);
+      }
+    }
+    if (o.onevalue !== undefined) m.aoneof = new example10.aoneof.onevalue(o.onevalue);
+    if (o.onestring !== undefined) m.aoneof = new example10.aoneof.onestring(o.onestring);
+    return m;
+  }
+
+  // equals reports whether other holds the same values as the message.
+  equals(other: example10): boolean {
+    if (this === other) return true;
+    if (!__pb__.Internal.optionalEqual(this.astruct, other.astruct, __pb__.Internal.jsonEqual)) return false;
+    if (!__pb__.Internal.jsonEqual(this.avalue, other.avalue)) return false;
+    if (!__pb__.Internal.optionalEqual(this.alist, other.alist, __pb__.Internal.jsonEqual)) return false;
+    if (!__pb__.Internal.arrayEqual(this.many, other.many, __pb__.Internal.jsonEqual)) return false;
+    if (!__pb__.Internal.mapEqual(this.amap, other.amap, __pb__.Internal.jsonEqual)) return false;
+    if (this.aoneof.kind !== other.aoneof.kind) return false;
+    if (this.aoneof instanceof example10.aoneof.onevalue && other.aoneof instanceof example10.aoneof.onevalue && !__pb__.Internal.jsonEqual(this.aoneof.value, other.aoneof.value)) return false;
+    if (this.aoneof instanceof example10.aoneof.onestring && other.aoneof instanceof example10.aoneof.onestring && this.aoneof.value !== other.aoneof.value) return false;
+    return true;
+  }
+
+  // clone returns a deep copy of the message.
+  clone(): example10 {
+    const m = new example10();
+    m.astruct = this.astruct == null ? null : __pb__.Internal.cloneJSON(this.astruct) as __pb__.JsonObject;
+    m.avalue = this.avalue === undefined ? undefined : __pb__.Internal.cloneJSON(this.avalue);
+    m.alist = this.alist == null ? null : __pb__.Internal.cloneJSON(this.alist) as __pb__.JsonValue[];
+    m.many = this.many.map(v => __pb__.Internal.cloneJSON(v));
+    for (const [k, v] of this.amap) {
+      m.amap.set(k, __pb__.Internal.cloneJSON(v));
+    }
+    if (this.aoneof instanceof example10.aoneof.onevalue) m.aoneof = new example10.aoneof.onevalue(__pb__.Internal.cloneJSON(this.aoneof.value));
+    if (this.aoneof instanceof example10.aoneof.onestring) m.aoneof = new example10.aoneof.onestring(this.aoneof.value);
+    return m;
+  }
+
+  // hashCode returns a hash of the message's values, which is equal for
+  // equal messages and stable across runs.
+  hashCode(): number {
+    let h = 0;
+    h = __pb__.Internal.hashCombine(h, this.astruct == null ? 0 : __pb__.Internal.hashJSON(this.astruct));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashJSON(this.avalue));
+    h = __pb__.Internal.hashCombine(h, this.alist == null ? 0 : __pb__.Internal.hashJSON(this.alist));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashArray(this.many, __pb__.Internal.hashJSON));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashMap(this.amap, __pb__.Internal.hashString, __pb__.Internal.hashJSON));
+    h = __pb__.Internal.hashCombine(h, this.aoneof.kind);
+    if (this.aoneof instanceof example10.aoneof.onevalue) h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashJSON(this.aoneof.value));
+    if (this.aoneof instanceof example10.aoneof.onestring) h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashString(this.aoneof.value));
+    return h;
+  }
+}
+
+export namespace example10.aoneof {
+  export class onevalue {
+    static readonly kind = 6;
+    readonly kind = 6;
+    value: __pb__.JsonValue | undefined;
+    constructor(v: __pb__.JsonValue | undefined) {
+      this.value = v;
+    }
+  }
+
+  export class onestring {
+    static readonly kind = 7;
+    readonly kind = 7;
+    value: string;
+    constructor(v: string) {
+      this.value = v;
+    }
+  }
+
+  export type oneof_type = __pb__.OneofNotSet | onevalue | onestring;
+
+  export function WriteTo(oo: oneof_type, e: __pb__.Internal.Encoder):void {
+    switch (oo.kind) {
+      case 6:
+      {
+        let nested = new __pb__.Internal.Encoder();
+        let msg = (oo as onevalue).value;
+        if (msg !== undefined) {
+          new __pb__.Value(msg).WriteTo(nested);
+        }
+        e.writeEncoder(nested, 6);
+        return
+      }
+      case 7:
+      e.writeTag(7, 2);
+      e.writeString((oo as onestring).value);
+      return;
+    }
+  }
+}
+
+export namespace example10 {
+  export interface AmapEntryInit {
+    key?: string;
+    value?: __pb__.JsonValue;
+  }
+
+  export interface IAmapEntry {
+    key?: string;
+    value?: __pb__.JsonValue;
+  }
+
+  export class AmapEntry implements __pb__.Message {
+    static readonly typeName = "foo.structs.example10.AmapEntry";
+
+    static readonly fields: __pb__.FieldInfo[] = [
+      { name: "key", number: 1, type: __pb__.FieldType.STRING, label: __pb__.FieldLabel.OPTIONAL, jsonName: "key", member: "key" },
+      { name: "value", number: 2, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.OPTIONAL, jsonName: "value", member: "value", messageType: () => __pb__.Value },
+    ];
+
+    key: string;
+    value: __pb__.JsonValue | undefined;
+
+    constructor(init?: AmapEntryInit) {
+      this.key = "";
+      this.value = undefined;
+      if (init !== undefined) {
+        if (init.key !== undefined) this.key = init.key;
+        if (init.value !== undefined) this.value = init.value;
+      }
+    }
+
+    MergeFrom(d: __pb__.Internal.Decoder): void {
+      while (!d.isEOF()) {
+        let [fn, wt] = d.readTag();
+        switch(fn) {
+          case 1:
+          this.key = d.readValidString();
+          break;
+          case 2:
+          {
+            let msg = this.value === undefined ? new __pb__.Value() : new __pb__.Value(this.value);
+            msg.MergeFrom(d.readDecoder());
+            this.value = msg.value;
+          }
+          break;
+          default:
+          d.skipWireType(wt, fn)
+        }
+      }
+    }
+
+    WriteTo(e: __pb__.Internal.Encoder): void {
+      if (this.key != "") {
+        e.writeTag(1, 2);
+        e.writeString(this.key);
+      }
+      {
+        const msg = this.value;
+        if (msg !== undefined) {
+          let nested = new __pb__.Internal.Encoder();
+          new __pb__.Value(msg).WriteTo(nested);
+          e.writeEncoder(nested, 2);
+        }
+      }
+    }
+
+    MergeFromJSON(j: __pb__.JsonValue, o: __pb__.JsonOptions = {}): void {
+      const obj = __pb__.Internal.objectFromJSON(j);
+      for (const k in obj) {
+        const v = obj[k];
+        if (v === null && k != "value") {
+          continue;
+        }
+        switch (k) {
+          case "key":
+          this.key = __pb__.Internal.stringFromJSON(v);
+          break;
+          case "value":
+          {
+            let msg = new __pb__.Value();
+            msg.MergeFromJSON(v, o);
+            this.value = msg.value;
+          }
+          break;
+          default:
+          __pb__.Internal.unknownFieldFromJSON(k, o);
+        }
+      }
+    }
+
+    ToJSON(o: __pb__.JsonOptions = {}): __pb__.JsonValue {
+      const j: __pb__.JsonObject = {};
+      if (o.emitDefaults || this.key != "") {
+        j["key"] = this.key;
+      }
+      if (o.emitDefaults || this.value !== undefined) {
+        const msg = this.value;
+        j["value"] = msg === undefined ? null : new __pb__.Value(msg).ToJSON(o);
+      }
+      return j;
+    }
+
+    // toObject returns the message as a plain object, holding no classes.
+    toObject(): IAmapEntry {
+      const o: IAmapEntry = {};
+      o.key = this.key;
+      if (this.value !== undefined) {
+        o.value = this.value;
+      }
+      return o;
+    }
+
+    // fromObject returns a message from its plain object form.
+    static fromObject(o: IAmapEntry): AmapEntry {
+      const m = new AmapEntry();
+      if (o.key !== undefined) m.key = o.key;
+      if (o.value !== undefined) m.value = o.value;
+      return m;
+    }
+
+    // equals reports whether other holds the same values as the message.
+    equals(other: AmapEntry): boolean {
+      if (this === other) return true;
+      if (this.key !== other.key) return false;
+      if (!__pb__.Internal.jsonEqual(this.value, other.value)) return false;
+      return true;
+    }
+
+    // clone returns a deep copy of the message.
+    clone(): AmapEntry {
+      const m = new AmapEntry();
+      m.key = this.key;
+      m.value = this.value === undefined ? undefined : __pb__.Internal.cloneJSON(this.value);
+      return m;
+    }
+
+    // hashCode returns a hash of the message's values, which is equal for
+    // equal messages and stable across runs.
+    hashCode(): number {
+      let h = 0;
+      h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashString(this.key));
+      h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashJSON(this.value));
+      return h;
+    }
+  }
+}
+
+// fileDescriptor is the google.protobuf.FileDescriptorProto of example10.proto.
+export const fileDescriptor = new __pb__.FileDescriptor(
+  "example10.proto",
+  "Cg9leGFtcGxlMTAucHJvdG8SC2Zvby5zdHJ1Y3RzGhxnb29nbGUvcHJvdG9idWYvc3RydWN0LnBy" +
+    "b3RvIrMDCglleGFtcGxlMTASMQoHYXN0cnVjdBgBIAEoCzIXLmdvb2dsZS5wcm90b2J1Zi5TdHJ1" +
+    "Y3RSB2FzdHJ1Y3QSLgoGYXZhbHVlGAIgASgLMhYuZ29vZ2xlLnByb3RvYnVmLlZhbHVlUgZhdmFs" +
+    "dWUSMAoFYWxpc3QYAyABKAsyGi5nb29nbGUucHJvdG9idWYuTGlzdFZhbHVlUgVhbGlzdBIqCgRt" +
+    "YW55GAQgAygLMhYuZ29vZ2xlLnByb3RvYnVmLlZhbHVlUgRtYW55EjQKBGFtYXAYBSADKAsyIC5m" +
+    "b28uc3RydWN0cy5leGFtcGxlMTAuQW1hcEVudHJ5UgRhbWFwEjQKCG9uZXZhbHVlGAYgASgLMhYu" +
+    "Z29vZ2xlLnByb3RvYnVmLlZhbHVlSABSCG9uZXZhbHVlEh4KCW9uZXN0cmluZxgHIAEoCUgAUglv" +
+    "bmVzdHJpbmcaTwoJQW1hcEVudHJ5EhAKA2tleRgBIAEoCVIDa2V5EiwKBXZhbHVlGAIgASgLMhYu" +
+    "Z29vZ2xlLnByb3RvYnVmLlZhbHVlUgV2YWx1ZToCOAFCCAoGYW9uZW9mYgZwcm90bzM=",
+  ["google/protobuf/struct.proto"]
+);
+
+__pb__.globalRegistry.add(example10);
+__pb__.globalRegistry.addFile(fileDescriptor);
@@ -0,0 +1,715 @@
+// Generated by the protocol buffer compiler.  DO NOT EDIT!
+// Source: example11.proto
+
+import * as __pb__ from '../../lib/protobuf'
+import * as __long from 'long'
+import {fromString as __longFromString } from 'long'
+
+
+export interface example11Init {
+  aint64?: __long;
+  manyuint64?: __long[];
+  abytes?: Uint8Array;
+  entries?: Map<number, example11.Entry | example11.EntryInit>;
+  longmap?: Map<string, Uint8Array>;
+}
+
+export interface Iexample11 {
+  aint64?: number;
+  manyuint64?: number[];
+  abytes?: number[];
+  entries?: [number, example11.IEntry][];
+  longmap?: [string, number[]][];
+}
+
+/**
+ * Generated with object_int64=number, object_bytes=array and
+ * object_maps=entries.
+ */
+export class example11 implements __pb__.Message {
+  static readonly typeName = "foo.objects.example11";
+
+  static readonly fields: __pb__.FieldInfo[] = [
+    { name: "aint64", number: 1, type: __pb__.FieldType.INT64, label: __pb__.FieldLabel.OPTIONAL, jsonName: "aint64", member: "aint64" },
+    { name: "manyuint64", number: 2, type: __pb__.FieldType.UINT64, label: __pb__.FieldLabel.REPEATED, jsonName: "manyuint64", member: "manyuint64" },
+    { name: "abytes", number: 3, type: __pb__.FieldType.BYTES, label: __pb__.FieldLabel.OPTIONAL, jsonName: "abytes", member: "abytes" },
+    { name: "entries", number: 4, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.REPEATED, jsonName: "entries", member: "entries", map: { key: __pb__.FieldType.INT32, value: __pb__.FieldType.MESSAGE }, messageType: () => example11.Entry },
+    { name: "longmap", number: 5, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.REPEATED, jsonName: "longmap", member: "longmap", map: { key: __pb__.FieldType.INT64, value: __pb__.FieldType.BYTES } },
+  ];
+
+  aint64: __long;
+  manyuint64: __long[];
+  abytes: Uint8Array;
+  entries: Map<number, example11.Entry>;
+  longmap: Map<string, Uint8Array>;
+  // The encoding of fields which were not recognized when decoding.
+  unknownFields: Uint8Array[];
+
+  constructor(init?: example11Init) {
+    this.aint64 = __long.ZERO;
+    this.manyuint64 = [];
+    this.abytes = new Uint8Array(0);
+    this.entries = new Map<number, example11.Entry>();
+    this.longmap = new Map<string, Uint8Array>();
+    this.unknownFields = [];
+    if (init !== undefined) {
+      if (init.aint64 !== undefined) this.aint64 = init.aint64;
+      if (init.manyuint64 !== undefined) this.manyuint64 = init.manyuint64.slice();
+      if (init.abytes !== undefined) this.abytes = init.abytes;
+      if (init.entries !== undefined) {
+        for (const [k, v] of init.entries) {
+          this.entries.set(k, __pb__.Internal.fromInit(example11.Entry, v));
+        }
+      }
+      if (init.longmap !== undefined) {
+        for (const [k, v] of init.longmap) {
+          this.longmap.set(k, v);
+        }
+      }
+    }
+  }
+
+  MergeFrom(d: __pb__.Internal.Decoder): void {
+    while (!d.isEOF()) {
+      let [fn, wt] = d.readTag();
+      switch(fn) {
+        case 1:
+        this.aint64 = d.readVarintSigned();
+        break;
+        case 2:
+        if (wt == 2) {
+          let packed = d.readDecoder();
+          while (!packed.isEOF()) {
+            this.manyuint64.push(packed.readVarint())
+          }
+        } else {
+          this.manyuint64.push(d.readVarint())
+        }
+        break;
+        case 3:
+        this.abytes = d.readBytes();
+        break;
+        case 4:
+        {
+          let obj = new example11.EntriesEntry();
+          obj.MergeFrom(d.readDecoder());
+          this.entries.set(obj.key, obj.value == null ? new example11.Entry() : obj.value);
+        }
+        break;
+        case 5:
+        {
+          let obj = new example11.LongmapEntry();
+          obj.MergeFrom(d.readDecoder());
+          this.longmap.set(obj.key.toString(), obj.value);
+        }
+        break;
+        default:
+        this.unknownFields.push(d.readUnknown(wt, fn));
+      }
+    }
+  }
+
+  WriteTo(e: __pb__.Internal.Encoder): void {
+    if (this.aint64 != __long.ZERO) {
+      e.writeTag(1, 0);
+      e.writeVarint(this.aint64);
+    }
+    if (this.manyuint64.length > 0) {
+      const packed = new __pb__.Internal.Encoder();
+      for (let elem of this.manyuint64) {
+        packed.writeVarint(elem);
+      }
+      e.writeEncoder(packed, 2);
+    }
+    if (this.abytes.length != 0) {
+      e.writeTag(3, 2);
+      e.writeBytes(this.abytes);
+    }
+    for (const [k, v] of this.entries) {
+      let obj = new example11.EntriesEntry();
+      obj.key = k;
+      obj.value = v;
+      let nested = new __pb__.Internal.Encoder();
+      obj.WriteTo(nested);
+      e.writeEncoder(nested, 4);
+    }
+    for (const [k, v] of this.longmap) {
+      let obj = new example11.LongmapEntry();
+      obj.key = __longFromString(k, false);
+      obj.value = v;
+      let nested = new __pb__.Internal.Encoder();
+      obj.WriteTo(nested);
+      e.writeEncoder(nested, 5);
+    }
+    e.writeUnknown(this.unknownFields);
+  }
+
+  MergeFromJSON(j: __pb__.JsonValue, o: __pb__.JsonOptions = {}): void {
+    const obj = __pb__.Internal.objectFromJSON(j);
+    for (const k in obj) {
+      const v = obj[k];
+      if (v === null) {
+        continue;
+      }
+      switch (k) {
+        case "aint64":
+        this.aint64 = __pb__.Internal.int64FromJSON(v);
+        break;
+        case "manyuint64":
+        for (const elem of __pb__.Internal.arrayFromJSON(v)) {
+          this.manyuint64.push(__pb__.Internal.uint64FromJSON(elem));
+        }
+        break;
+        case "abytes":
+        this.abytes = __pb__.Internal.bytesFromJSON(v);
+        break;
+        case "entries":
+        {
+          const m = __pb__.Internal.objectFromJSON(v);
+          for (const mk in m) {
+            {
+              let msg = new example11.Entry();
+              msg.MergeFromJSON(m[mk], o);
+              this.entries.set(__pb__.Internal.int32FromJSON(mk), msg);
+            }
+          }
+        }
+        break;
+        case "longmap":
+        {
+          const m = __pb__.Internal.objectFromJSON(v);
+          for (const mk in m) {
+            this.longmap.set(__pb__.Internal.int64FromJSON(mk).toString(), __pb__.Internal.bytesFromJSON(m[mk]));
+          }
+        }
+        break;
+        default:
+        __pb__.Internal.unknownFieldFromJSON(k, o);
+      }
+    }
+  }
+
+  ToJSON(o: __pb__.JsonOptions = {}): __pb__.JsonValue {
+    const j: __pb__.JsonObject = {};
+    if (o.emitDefaults || !this.aint64.isZero()) {
+      j["aint64"] = this.aint64.toString();
+    }
+    if (o.emitDefaults || this.manyuint64.length > 0) {
+      j["manyuint64"] = this.manyuint64.map(elem => elem.toString());
+    }
+    if (o.emitDefaults || this.abytes.length > 0) {
+      j["abytes"] = __pb__.Internal.bytesToJSON(this.abytes);
+    }
+    if (o.emitDefaults || this.entries.size > 0) {
+      const m: __pb__.JsonObject = {};
+      for (const [k, v] of this.entries) {
+        m[String(k)] = v.ToJSON(o);
+      }
+      j["entries"] = m;
+    }
+    if (o.emitDefaults || this.longmap.size > 0) {
+      const m: __pb__.JsonObject = {};
+      for (const [k, v] of this.longmap) {
+        m[String(k)] = __pb__.Internal.bytesToJSON(v);
+      }
+      j["longmap"] = m;
+    }
+    return j;
+  }
+
+  // toObject returns the message as a plain object, holding no classes.
+  toObject(): Iexample11 {
+    const o: Iexample11 = {};
+    o.aint64 = this.aint64.toNumber();
+    o.manyuint64 = this.manyuint64.map(v => v.toNumber());
+    o.abytes = Array.from(this.abytes);
+    o.entries = Array.from(this.entries, ([k, v]): [number, example11.IEntry] => [k, v.toObject()]);
+    o.longmap = Array.from(this.longmap, ([k, v]): [string, number[]] => [k, Array.from(v)]);
+    return o;
+  }
+
+  // fromObject returns a message from its plain object form.
+  static fromObject(o: Iexample11): example11 {
+    const m = new example11();
+    if (o.aint64 !== undefined) m.aint64 = __long.fromNumber(o.aint64, false);
+    if (o.manyuint64 !== undefined) m.manyuint64 = o.manyuint64.map(v => __long.fromNumber(v, true));
+    if (o.abytes !== undefined) m.abytes = new Uint8Array(o.abytes);
+    if (o.entries !== undefined) {
+      for (const [k, v] of o.entries) {
+        m.entries.set(k, example11.Entry.fromObject(v));
+      }
+    }
+    if (o.longmap !== undefined) {
+      for (const [k, v] of o.longmap) {
+        m.longmap.set(k, new Uint8Array(v));
+      }
+    }
+    return m;
+  }
+
+  // equals reports whether other holds the same values as the message.
+  equals(other: example11): boolean {
+    if (this === other) return true;
+    if (!this.aint64.equals(other.aint64)) return false;
+    if (!__pb__.Internal.arrayEqual(this.manyuint64, other.manyuint64, (x, y) => x.equals(y))) return false;
+    if (!__pb__.Internal.bytesEqual(this.abytes, other.abytes)) return false;
+    if (!__pb__.Internal.mapEqual(this.entries, other.entries, (x, y) => x.equals(y))) return false;
+    if (!__pb__.Internal.mapEqual(this.longmap, other.longmap, __pb__.Internal.bytesEqual)) return false;
+    if (!__pb__.Internal.unknownEqual(this.unknownFields, other.unknownFields)) return false;
+    return true;
+  }
+
+  // clone returns a deep copy of the message.
+  clone(): example11 {
+    const m = new example11();
+    m.aint64 = this.aint64;
+    m.manyuint64 = this.manyuint64.slice();
+    m.abytes = this.abytes.slice();
+    for (const [k, v] of this.entries) {
+      m.entries.set(k, v.clone());
+    }
+    for (const [k, v] of this.longmap) {
+      m.longmap.set(k, v.slice());
+    }
+    m.unknownFields = this.unknownFields.map(u => u.slice());
+    return m;
+  }
+
+  // hashCode returns a hash of the message's values, which is equal for
+  // equal messages and stable across runs.
+  hashCode(): number {
+    let h = 0;
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashLong(this.aint64));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashArray(this.manyuint64, __pb__.Internal.hashLong));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashBytes(this.abytes));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashMap(this.entries, __pb__.Internal.hashNumber, v => v.hashCode()));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashMap(this.longmap, __pb__.Internal.hashString, __pb__.Internal.hashBytes));
+    return h;
+  }
+}
+
+export namespace example11 {
+  export interface EntriesEntryInit {
+    key?: number;
+    value?: example11.Entry | example11.EntryInit;
+  }
+
+  export interface IEntriesEntry {
+    key?: number;
+    value?: example11.IEntry;
+  }
+
+  export class EntriesEntry implements __pb__.Message {
+    static readonly typeName = "foo.objects.example11.EntriesEntry";
+
+    static readonly fields: __pb__.FieldInfo[] = [
+      { name: "key", number: 1, type: __pb__.FieldType.INT32, label: __pb__.FieldLabel.OPTIONAL, jsonName: "key", member: "key" },
+      { name: "value", number: 2, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.OPTIONAL, jsonName: "value", member: "value", messageType: () => example11.Entry },
+    ];
+
+    key: number;
+    value: example11.Entry | null;
+    // The encoding of fields which were not recognized when decoding.
+    unknownFields: Uint8Array[];
+
+    constructor(init?: EntriesEntryInit) {
+      this.key = 0;
+      this.value = null;
+      this.unknownFields = [];
+      if (init !== undefined) {
+        if (init.key !== undefined) this.key = init.key;
+        if (init.value !== undefined) this.value = __pb__.Internal.fromInit(example11.Entry, init.value);
+      }
+    }
+
+    MergeFrom(d: __pb__.Internal.Decoder): void {
+      while (!d.isEOF()) {
+        let [fn, wt] = d.readTag();
+        switch(fn) {
+          case 1:
+          this.key = d.readVarInt32();
+          break;
+          case 2:
+          if (this.value == null) this.value = new example11.Entry();
+          this.value.MergeFrom(d.readDecoder());
+          break;
+          default:
+          this.unknownFields.push(d.readUnknown(wt, fn));
+        }
+      }
+    }
+
+    WriteTo(e: __pb__.Internal.Encoder): void {
+      if (this.key != 0) {
+        e.writeTag(1, 0);
+        e.writeNumberAsVarint(this.key);
+      }
+      {
+        const msg = this.value;
+        if (msg != null) {
+          let nested = new __pb__.Internal.Encoder();
+          msg.WriteTo(nested);
+          e.writeEncoder(nested, 2);
+        }
+      }
+      e.writeUnknown(this.unknownFields);
+    }
+
+    MergeFromJSON(j: __pb__.JsonValue, o: __pb__.JsonOptions = {}): void {
+      const obj = __pb__.Internal.objectFromJSON(j);
+      for (const k in obj) {
+        const v = obj[k];
+        if (v === null) {
+          continue;
+        }
+        switch (k) {
+          case "key":
+          this.key = __pb__.Internal.int32FromJSON(v);
+          break;
+          case "value":
+          if (this.value == null) this.value = new example11.Entry();
+          this.value.MergeFromJSON(v, o);
+          break;
+          default:
+          __pb__.Internal.unknownFieldFromJSON(k, o);
+        }
+      }
+    }
+
+    ToJSON(o: __pb__.JsonOptions = {}): __pb__.JsonValue {
+      const j: __pb__.JsonObject = {};
+      if (o.emitDefaults || this.key != 0) {
+        j["key"] = this.key;
+      }
+      if (o.emitDefaults || this.value != null) {
+        const msg = this.value;
+        j["value"] = msg == null ? null : msg.ToJSON(o);
+      }
+      return j;
+    }
+
+    // toObject returns the message as a plain object, holding no classes.
+    toObject(): IEntriesEntry {
+      const o: IEntriesEntry = {};
+      o.key = this.key;
+      if (this.value != null) {
+        o.value = this.value.toObject();
+      }
+      return o;
+    }
+
+    // fromObject returns a message from its plain object form.
+    static fromObject(o: IEntriesEntry): EntriesEntry {
+      const m = new EntriesEntry();
+      if (o.key !== undefined) m.key = o.key;
+      if (o.value !== undefined) m.value = example11.Entry.fromObject(o.value);
+      return m;
+    }
+
+    // equals reports whether other holds the same values as the message.
+    equals(other: EntriesEntry): boolean {
+      if (this === other) return true;
+      if (this.key !== other.key) return false;
+      if (!__pb__.Internal.optionalEqual(this.value, other.value, (x, y) => x.equals(y))) return false;
+      if (!__pb__.Internal.unknownEqual(this.unknownFields, other.unknownFields)) return false;
+      return true;
+    }
+
+    // clone returns a deep copy of the message.
+    clone(): EntriesEntry {
+      const m = new EntriesEntry();
+      m.key = this.key;
+      m.value = this.value == null ? null : this.value.clone();
+      m.unknownFields = this.unknownFields.map(u => u.slice());
+      return m;
+    }
+
+    // hashCode returns a hash of the message's values, which is equal for
+    // equal messages and stable across runs.
+    hashCode(): number {
+      let h = 0;
+      h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashNumber(this.key));
+      h = __pb__.Internal.hashCombine(h, this.value == null ? 0 : this.value.hashCode());
+      return h;
+    }
+  }
+}
+
+export namespace example11 {
+  export interface LongmapEntryInit {
+    key?: __long;
+    value?: Uint8Array;
+  }
+
+  export interface ILongmapEntry {
+    key?: number;
+    value?: number[];
+  }
+
+  export class LongmapEntry implements __pb__.Message {
+    static readonly typeName = "foo.objects.example11.LongmapEntry";
+
+    static readonly fields: __pb__.FieldInfo[] = [
+      { name: "key", number: 1, type: __pb__.FieldType.INT64, label: __pb__.FieldLabel.OPTIONAL, jsonName: "key", member: "key" },
+      { name: "value", number: 2, type: __pb__.FieldType.BYTES, label: __pb__.FieldLabel.OPTIONAL, jsonName: "value", member: "value" },
+    ];
+
+    key: __long;
+    value: Uint8Array;
+    // The encoding of fields which were not recognized when decoding.
+    unknownFields: Uint8Array[];
+
+    constructor(init?: LongmapEntryInit) {
+      this.key = __long.ZERO;
+      this.value = new Uint8Array(0);
+      this.unknownFields = [];
+      if (init !== undefined) {
+        if (init.key !== undefined) this.key = init.key;
+        if (init.value !== undefined) this.value = init.value;
+      }
+    }
+
+    MergeFrom(d: __pb__.Internal.Decoder): void {
+      while (!d.isEOF()) {
+        let [fn, wt] = d.readTag();
+        switch(fn) {
+          case 1:
+          this.key = d.readVarintSigned();
+          break;
+          case 2:
+          this.value = d.readBytes();
+          break;
+          default:
+          this.unknownFields.push(d.readUnknown(wt, fn));
+        }
+      }
+    }
+
+    WriteTo(e: __pb__.Internal.Encoder): void {
+      if (this.key != __long.ZERO) {
+        e.writeTag(1, 0);
+        e.writeVarint(this.key);
+      }
+      if (this.value.length != 0) {
+        e.writeTag(2, 2);
+        e.writeBytes(this.value);
+      }
+      e.writeUnknown(this.unknownFields);
+    }
+
+    MergeFromJSON(j: __pb__.JsonValue, o: __pb__.JsonOptions = {}): void {
+      const obj = __pb__.Internal.objectFromJSON(j);
+      for (const k in obj) {
+        const v = obj[k];
+        if (v === null) {
+          continue;
+        }
+        switch (k) {
+          case "key":
+          this.key = __pb__.Internal.int64FromJSON(v);
+          break;
+          case "value":
+          this.value = __pb__.Internal.bytesFromJSON(v);
+          break;
+          default:
+          __pb__.Internal.unknownFieldFromJSON(k, o);
+        }
+      }
+    }
+
+    ToJSON(o: __pb__.JsonOptions = {}): __pb__.JsonValue {
+      const j: __pb__.JsonObject = {};
+      if (o.emitDefaults || !this.key.isZero()) {
+        j["key"] = this.key.toString();
+      }
+      if (o.emitDefaults || this.value.length > 0) {
+        j["value"] = __pb__.Internal.bytesToJSON(this.value);
+      }
+      return j;
+    }
+
+    // toObject returns the message as a plain object, holding no classes.
+    toObject(): ILongmapEntry {
+      const o: ILongmapEntry = {};
+      o.key = this.key.toNumber();
+      o.value = Array.from(this.value);
+      return o;
+    }
+
+    // fromObject returns a message from its plain object form.
+    static fromObject(o: ILongmapEntry): LongmapEntry {
+      const m = new LongmapEntry();
+      if (o.key !== undefined) m.key = __long.fromNumber(o.key, false);
+      if (o.value !== undefined) m.value = new Uint8Array(o.value);
+      return m;
+    }
+
+    // equals reports whether other holds the same values as the message.
+    equals(other: LongmapEntry): boolean {
+      if (this === other) return true;
+      if (!this.key.equals(other.key)) return false;
+      if (!__pb__.Internal.bytesEqual(this.value, other.value)) return false;
+      if (!__pb__.Internal.unknownEqual(this.unknownFields, other.unknownFields)) return false;
+      return true;
+    }
+
+    // clone returns a deep copy of the message.
+    clone(): LongmapEntry {
+      const m = new LongmapEntry();
+      m.key = this.key;
+      m.value = this.value.slice();
+      m.unknownFields = this.unknownFields.map(u => u.slice());
+      return m;
+    }
+
+    // hashCode returns a hash of the message's values, which is equal for
+    // equal messages and stable across runs.
+    hashCode(): number {
+      let h = 0;
+      h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashLong(this.key));
+      h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashBytes(this.value));
+      return h;
+    }
+  }
+}
+
+export namespace example11 {
+  export interface EntryInit {
+    name?: string;
+  }
+
+  export interface IEntry {
+    name?: string;
+  }
+
+  export class Entry implements __pb__.Message {
+    static readonly typeName = "foo.objects.example11.Entry";
+
+    static readonly fields: __pb__.FieldInfo[] = [
+      { name: "name", number: 1, type: __pb__.FieldType.STRING, label: __pb__.FieldLabel.OPTIONAL, jsonName: "name", member: "name" },
+    ];
+
+    name: string;
+    // The encoding of fields which were not recognized when decoding.
+    unknownFields: Uint8Array[];
+
+    constructor(init?: EntryInit) {
+      this.name = "";
+      this.unknownFields = [];
+      if (init !== undefined) {
+        if (init.name !== undefined) this.name = init.name;
+      }
+    }
+
+    MergeFrom(d: __pb__.Internal.Decoder): void {
+      while (!d.isEOF()) {
+        let [fn, wt] = d.readTag();
+        switch(fn) {
+          case 1:
+          this.name = d.readValidString();
+          break;
+          default:
+          this.unknownFields.push(d.readUnknown(wt, fn));
+        }
+      }
+    }
+
+    WriteTo(e: __pb__.Internal.Encoder): void {
+      if (this.name != "") {
+        e.writeTag(1, 2);
+        e.writeString(this.name);
+      }
+      e.writeUnknown(this.unknownFields);
+    }
+
+    MergeFromJSON(j: __pb__.JsonValue, o: __pb__.JsonOptions = {}): void {
+      const obj = __pb__.Internal.objectFromJSON(j);
+      for (const k in obj) {
+        const v = obj[k];
+        if (v === null) {
+          continue;
+        }
+        switch (k) {
+          case "name":
+          this.name = __pb__.Internal.stringFromJSON(v);
+          break;
+          default:
+          __pb__.Internal.unknownFieldFromJSON(k, o);
+        }
+      }
+    }
+
+    ToJSON(o: __pb__.JsonOptions = {}): __pb__.JsonValue {
+      const j: __pb__.JsonObject = {};
+      if (o.emitDefaults || this.name != "") {
+        j["name"] = this.name;
+      }
+      return j;
+    }
+
+    // toObject returns the message as a plain object, holding no classes.
+    toObject(): IEntry {
+      const o: IEntry = {};
+      o.name = this.name;
+      return o;
+    }
+
+    // fromObject returns a message from its plain object form.
+    static fromObject(o: IEntry): Entry {
+      const m = new Entry();
+      if (o.name !== undefined) m.name = o.name;
+      return m;
+    }
+
+    // equals reports whether other holds the same values as the message.
+    equals(other: Entry): boolean {
+      if (this === other) return true;
+      if (this.name !== other.name) return false;
+      if (!__pb__.Internal.unknownEqual(this.unknownFields, other.unknownFields)) return false;
+      return true;
+    }
+
+    // clone returns a deep copy of the message.
+    clone(): Entry {
+      const m = new Entry();
+      m.name = this.name;
+      m.unknownFields = this.unknownFields.map(u => u.slice());
+      return m;
+    }
+
+    // hashCode returns a hash of the message's values, which is equal for
+    // equal messages and stable across runs.
+    hashCode(): number {
+      let h = 0;
+      h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashString(this.name));
+      return h;
+    }
+  }
+}
+
+// fileDescriptor is the google.protobuf.FileDescriptorProto of example11.proto.
+export const fileDescriptor = new __pb__.FileDescriptor(
+  "example11.proto",
+  "Cg9leGFtcGxlMTEucHJvdG8SC2Zvby5vYmplY3RzIowDCglleGFtcGxlMTESFgoGYWludDY0GAEg" +
+    "ASgDUgZhaW50NjQSHgoKbWFueXVpbnQ2NBgCIAMoBFIKbWFueXVpbnQ2NBIWCgZhYnl0ZXMYAyAB" +
+    "KAxSBmFieXRlcxI9CgdlbnRyaWVzGAQgAygLMiMuZm9vLm9iamVjdHMuZXhhbXBsZTExLkVudHJp" +
+    "ZXNFbnRyeVIHZW50cmllcxI9Cgdsb25nbWFwGAUgAygLMiMuZm9vLm9iamVjdHMuZXhhbXBsZTEx" +
+    "LkxvbmdtYXBFbnRyeVIHbG9uZ21hcBpYCgxFbnRyaWVzRW50cnkSEAoDa2V5GAEgASgFUgNrZXkS" +
+    "MgoFdmFsdWUYAiABKAsyHC5mb28ub2JqZWN0cy5leGFtcGxlMTEuRW50cnlSBXZhbHVlOgI4ARo6" +
+    "CgxMb25nbWFwRW50cnkSEAoDa2V5GAEgASgDUgNrZXkSFAoFdmFsdWUYAiABKAxSBXZhbHVlOgI4" +
+    "ARobCgVFbnRyeRISCgRuYW1lGAEgASgJUgRuYW1lSoQECgYSBAAAEAEKCAoBDBIDAAASCggKAQIS" +
+    "AwIAFApeCgIEABIEBgAQARpSIEdlbmVyYXRlZCB3aXRoIG9iamVjdF9pbnQ2ND1udW1iZXIsIG9i" +
+    "amVjdF9ieXRlcz1hcnJheSBhbmQKIG9iamVjdF9tYXBzPWVudHJpZXMuCgoKCgMEAAESAwYIEQoL" +
+    "CgQEAAIAEgMHAhMKDAoFBAACAAUSAwcCBwoMCgUEAAIAARIDBwgOCgwKBQQAAgADEgMHERIKCwoE" +
+    "BAACARIDCAIhCgwKBQQAAgEEEgMIAgoKDAoFBAACAQUSAwgLEQoMCgUEAAIBARIDCBIcCgwKBQQA" +
+    "AgEDEgMIHyAKCwoEBAACAhIDCQITCgwKBQQAAgIFEgMJAgcKDAoFBAACAgESAwkIDgoMCgUEAAIC" +
+    "AxIDCRESCgsKBAQAAgMSAwoCIAoMCgUEAAIDBhIDCgITCgwKBQQAAgMBEgMKFBsKDAoFBAACAwMS" +
+    "AwoeHwoLCgQEAAIEEgMLAiAKDAoFBAACBAYSAwsCEwoMCgUEAAIEARIDCxQbCgwKBQQAAgQDEgML" +
+    "Hh8KDAoEBAADAhIEDQIPAwoMCgUEAAMCARIDDQoPCg0KBgQAAwICABIDDgQUCg4KBwQAAwICAAUS" +
+    "Aw4ECgoOCgcEAAMCAgABEgMOCw8KDgoHBAADAgIAAxIDDhITYgZwcm90bzM=",
+  []
+);
+
+__pb__.globalRegistry.add(example11);
+__pb__.globalRegistry.add(example11.Entry);
+__pb__.globalRegistry.addFile(fileDescriptor);
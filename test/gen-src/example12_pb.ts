@@ -0,0 +1,296 @@
+// Generated by the protocol buffer compiler.  DO NOT EDIT!
+// Source: example12.proto
+
+import * as __pb__ from '../../lib/protobuf'
+
+
+export interface example12Init {
+  kept?: number;
+  state?: example12.State;
+}
+
+export interface Iexample12 {
+  kept?: number;
+  state?: example12.State;
+}
+
+/**
+ * Generated with omit_deprecated.
+ */
+export class example12 implements __pb__.Message {
+  static readonly typeName = "foo.deprecated.example12";
+
+  static readonly fields: __pb__.FieldInfo[] = [
+    { name: "kept", number: 1, type: __pb__.FieldType.INT32, label: __pb__.FieldLabel.OPTIONAL, jsonName: "kept", member: "kept" },
+    { name: "state", number: 4, type: __pb__.FieldType.ENUM, label: __pb__.FieldLabel.OPTIONAL, jsonName: "state", member: "state", enumType: () => example12.StateInfo },
+  ];
+
+  kept: number;
+  state: example12.State;
+  // The encoding of fields which were not recognized when decoding.
+  unknownFields: Uint8Array[];
+
+  constructor(init?: example12Init) {
+    this.kept = 0;
+    this.state = 0;
+    this.unknownFields = [];
+    if (init !== undefined) {
+      if (init.kept !== undefined) this.kept = init.kept;
+      if (init.state !== undefined) this.state = init.state;
+    }
+  }
+
+  MergeFrom(d: __pb__.Internal.Decoder): void {
+    while (!d.isEOF()) {
+      let [fn, wt] = d.readTag();
+      switch(fn) {
+        case 1:
+        this.kept = d.readVarInt32();
+        break;
+        case 4:
+        this.state = d.readVarintSignedAsNumber();
+        break;
+        default:
+        this.unknownFields.push(d.readUnknown(wt, fn));
+      }
+    }
+  }
+
+  WriteTo(e: __pb__.Internal.Encoder): void {
+    if (this.kept != 0) {
+      e.writeTag(1, 0);
+      e.writeNumberAsVarint(this.kept);
+    }
+    if (this.state != 0) {
+      e.writeTag(4, 0);
+      e.writeNumberAsVarint(this.state);
+    }
+    e.writeUnknown(this.unknownFields);
+  }
+
+  MergeFromJSON(j: __pb__.JsonValue, o: __pb__.JsonOptions = {}): void {
+    const obj = __pb__.Internal.objectFromJSON(j);
+    for (const k in obj) {
+      const v = obj[k];
+      if (v === null) {
+        continue;
+      }
+      switch (k) {
+        case "kept":
+        this.kept = __pb__.Internal.int32FromJSON(v);
+        break;
+        case "state":
+        {
+          let e = example12.StateFromJSON(v, o);
+          if (e !== undefined) {
+            this.state = e;
+          }
+        }
+        break;
+        case "dropped":
+        case "oldChoice":
+        case "old_choice":
+        break;
+        default:
+        __pb__.Internal.unknownFieldFromJSON(k, o);
+      }
+    }
+  }
+
+  ToJSON(o: __pb__.JsonOptions = {}): __pb__.JsonValue {
+    const j: __pb__.JsonObject = {};
+    if (o.emitDefaults || this.kept != 0) {
+      j["kept"] = this.kept;
+    }
+    if (o.emitDefaults || this.state != 0) {
+      j["state"] = example12.StateToJSON(this.state);
+    }
+    return j;
+  }
+
+  // toObject returns the message as a plain object, holding no classes.
+  toObject(): Iexample12 {
+    const o: Iexample12 = {};
+    o.kept = this.kept;
+    o.state = this.state;
+    return o;
+  }
+
+  // fromObject returns a message from its plain object form.
+  static fromObject(o: Iexample12): example12 {
+    const m = new example12();
+    if (o.kept !== undefined) m.kept = o.kept;
+    if (o.state !== undefined) m.state = o.state;
+    return m;
+  }
+
+  // equals reports whether other holds the same values as the message.
+  equals(other: example12): boolean {
+    if (this === other) return true;
+    if (this.kept !== other.kept) return false;
+    if (this.state !== other.state) return false;
+    if (!__pb__.Internal.unknownEqual(this.unknownFields, other.unknownFields)) return false;
+    return true;
+  }
+
+  // clone returns a deep copy of the message.
+  clone(): example12 {
+    const m = new example12();
+    m.kept = this.kept;
+    m.state = this.state;
+    m.unknownFields = this.unknownFields.map(u => u.slice());
+    return m;
+  }
+
+  // hashCode returns a hash of the message's values, which is equal for
+  // equal messages and stable across runs.
+  hashCode(): number {
+    let h = 0;
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashNumber(this.kept));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashNumber(this.state));
+    return h;
+  }
+}
+
+export namespace example12 {
+  export const enum State {
+    STATE_UNSPECIFIED = 0,
+    /**
+     * @deprecated
+     */
+    STATE_OLD = 1,
+  }
+
+  export function StateToJSON(v: State): string | number {
+    switch (v as number) {
+      case 0:
+      return "STATE_UNSPECIFIED";
+      case 1:
+      return "STATE_OLD";
+    }
+    return v;
+  }
+
+  export function StateFromJSON(v: __pb__.JsonValue, o: __pb__.JsonOptions = {}): State | undefined {
+    switch (v) {
+      case "STATE_UNSPECIFIED":
+      return State.STATE_UNSPECIFIED;
+      case "STATE_OLD":
+      return State.STATE_OLD;
+    }
+    return __pb__.Internal.enumFromJSON(v, o);
+  }
+
+  export const StateInfo: __pb__.EnumInfo = {
+    typeName: "foo.deprecated.example12.State",
+    values: [
+      { name: "STATE_UNSPECIFIED", number: 0 },
+      { name: "STATE_OLD", number: 1 },
+    ],
+  };
+}
+
+export namespace example12 {
+  export interface OldInit {}
+
+  export interface IOld {}
+
+  /**
+   * @deprecated
+   */
+  export class Old implements __pb__.Message {
+    static readonly typeName = "foo.deprecated.example12.Old";
+
+    static readonly fields: __pb__.FieldInfo[] = [];
+
+    // The encoding of fields which were not recognized when decoding.
+    unknownFields: Uint8Array[];
+
+    constructor(_?: OldInit) {
+      this.unknownFields = [];
+    }
+
+    MergeFrom(d: __pb__.Internal.Decoder): void {
+      while (!d.isEOF()) {
+        let [fn, wt] = d.readTag();
+        switch(fn) {
+          default:
+          this.unknownFields.push(d.readUnknown(wt, fn));
+        }
+      }
+    }
+
+    WriteTo(e: __pb__.Internal.Encoder): void {
+      e.writeUnknown(this.unknownFields);
+    }
+
+    MergeFromJSON(j: __pb__.JsonValue, o: __pb__.JsonOptions = {}): void {
+      for (const k in __pb__.Internal.objectFromJSON(j)) {
+        __pb__.Internal.unknownFieldFromJSON(k, o);
+      }
+    }
+
+    ToJSON(_: __pb__.JsonOptions = {}): __pb__.JsonValue {
+      return {};
+    }
+
+    // toObject returns the message as a plain object, holding no classes.
+    toObject(): IOld {
+      const o: IOld = {};
+      return o;
+    }
+
+    // fromObject returns a message from its plain object form.
+    static fromObject(o: IOld): Old {
+      const m = new Old();
+      return m;
+    }
+
+    // equals reports whether other holds the same values as the message.
+    equals(other: Old): boolean {
+      if (this === other) return true;
+      if (!__pb__.Internal.unknownEqual(this.unknownFields, other.unknownFields)) return false;
+      return true;
+    }
+
+    // clone returns a deep copy of the message.
+    clone(): Old {
+      const m = new Old();
+      m.unknownFields = this.unknownFields.map(u => u.slice());
+      return m;
+    }
+
+    // hashCode returns a hash of the message's values, which is equal for
+    // equal messages and stable across runs.
+    hashCode(): number {
+      return 0;
+    }
+  }
+}
+
+// fileDescriptor is the google.protobuf.FileDescriptorProto of example12.proto.
+export const fileDescriptor = new __pb__.FileDescriptor(
+  "example12.proto",
+  "Cg9leGFtcGxlMTIucHJvdG8SDmZvby5kZXByZWNhdGVkIuEBCglleGFtcGxlMTISEgoEa2VwdBgB" +
+    "IAEoBVIEa2VwdBIcCgdkcm9wcGVkGAIgASgFQgIYAVIHZHJvcHBlZBIjCgpvbGRfY2hvaWNlGAMg" +
+    "ASgJQgIYAUgAUglvbGRDaG9pY2USNQoFc3RhdGUYBCABKA4yHy5mb28uZGVwcmVjYXRlZC5leGFt" +
+    "cGxlMTIuU3RhdGVSBXN0YXRlGgkKA09sZDoCGAEiMQoFU3RhdGUSFQoRU1RBVEVfVU5TUEVDSUZJ" +
+    "RUQQABIRCglTVEFURV9PTEQQARoCCAFCCAoGY2hvaWNlSt0ECgYSBAAAFgEKCAoBDBIDAAASCggK" +
+    "AQISAwIAFwotCgIEABIEBQAWARohIEdlbmVyYXRlZCB3aXRoIG9taXRfZGVwcmVjYXRlZC4KCgoK" +
+    "AwQAARIDBQgRCgsKBAQAAgASAwYCEQoMCgUEAAIABRIDBgIHCgwKBQQAAgABEgMGCAwKDAoFBAAC" +
+    "AAMSAwYPEAoLCgQEAAIBEgMHAigKDAoFBAACAQUSAwcCBwoMCgUEAAIBARIDBwgPCgwKBQQAAgED" +
+    "EgMHEhMKDAoFBAACAQgSAwcUJwoNCgYEAAIBCAMSAwcVJgoMCgQEAAgAEgQJAgsDCgwKBQQACAAB" +
+    "EgMJCA4KCwoEBAACAhIDCgQuCgwKBQQAAgIFEgMKBAoKDAoFBAACAgESAwoLFQoMCgUEAAICAxID" +
+    "ChgZCgwKBQQAAgIIEgMKGi0KDQoGBAACAggDEgMKGywKDAoEBAAEABIEDQIQAwoMCgUEAAQAARID" +
+    "DQcMCg0KBgQABAACABIDDgQaCg4KBwQABAACAAESAw4EFQoOCgcEAAQAAgACEgMOGBkKDQoGBAAE" +
+    "AAIBEgMPBCYKDgoHBAAEAAIBARIDDwQNCg4KBwQABAACAQISAw8QEQoOCgcEAAQAAgEDEgMPEiUK" +
+    "DwoIBAAEAAIBAwESAw8TJAoLCgQEAAIDEgMRAhIKDAoFBAACAwYSAxECBwoMCgUEAAIDARIDEQgN" +
+    "CgwKBQQAAgMDEgMREBEKDAoEBAADABIEEwIVAwoMCgUEAAMAARIDEwoNCgwKBQQAAwAHEgMUBB0K" +
+    "DQoGBAADAAcDEgMUBB1iBnByb3RvMw==",
+  []
+);
+
+__pb__.globalRegistry.add(example12);
+__pb__.globalRegistry.add(example12.Old);
+__pb__.globalRegistry.addEnum(example12.StateInfo);
+__pb__.globalRegistry.addFile(fileDescriptor);
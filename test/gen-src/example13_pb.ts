@@ -0,0 +1,326 @@
+// Generated by the protocol buffer compiler.  DO NOT EDIT!
+// Source: example13.proto
+
+import * as __pb__ from '../../lib/protobuf'
+
+
+export interface SizeRequestInit {
+  inches?: number;
+}
+
+export interface ISizeRequest {
+  inches?: number;
+}
+
+/**
+ * Generated with plugin=twirp.
+ */
+export class SizeRequest implements __pb__.Message {
+  static readonly typeName = "foo.twirp.SizeRequest";
+
+  static readonly fields: __pb__.FieldInfo[] = [
+    { name: "inches", number: 1, type: __pb__.FieldType.INT32, label: __pb__.FieldLabel.OPTIONAL, jsonName: "inches", member: "inches" },
+  ];
+
+  inches: number;
+  // The encoding of fields which were not recognized when decoding.
+  unknownFields: Uint8Array[];
+
+  constructor(init?: SizeRequestInit) {
+    this.inches = 0;
+    this.unknownFields = [];
+    if (init !== undefined) {
+      if (init.inches !== undefined) this.inches = init.inches;
+    }
+  }
+
+  MergeFrom(d: __pb__.Internal.Decoder): void {
+    while (!d.isEOF()) {
+      let [fn, wt] = d.readTag();
+      switch(fn) {
+        case 1:
+        this.inches = d.readVarInt32();
+        break;
+        default:
+        this.unknownFields.push(d.readUnknown(wt, fn));
+      }
+    }
+  }
+
+  WriteTo(e: __pb__.Internal.Encoder): void {
+    if (this.inches != 0) {
+      e.writeTag(1, 0);
+      e.writeNumberAsVarint(this.inches);
+    }
+    e.writeUnknown(this.unknownFields);
+  }
+
+  MergeFromJSON(j: __pb__.JsonValue, o: __pb__.JsonOptions = {}): void {
+    const obj = __pb__.Internal.objectFromJSON(j);
+    for (const k in obj) {
+      const v = obj[k];
+      if (v === null) {
+        continue;
+      }
+      switch (k) {
+        case "inches":
+        this.inches = __pb__.Internal.int32FromJSON(v);
+        break;
+        default:
+        __pb__.Internal.unknownFieldFromJSON(k, o);
+      }
+    }
+  }
+
+  ToJSON(o: __pb__.JsonOptions = {}): __pb__.JsonValue {
+    const j: __pb__.JsonObject = {};
+    if (o.emitDefaults || this.inches != 0) {
+      j["inches"] = this.inches;
+    }
+    return j;
+  }
+
+  // toObject returns the message as a plain object, holding no classes.
+  toObject(): ISizeRequest {
+    const o: ISizeRequest = {};
+    o.inches = this.inches;
+    return o;
+  }
+
+  // fromObject returns a message from its plain object form.
+  static fromObject(o: ISizeRequest): SizeRequest {
+    const m = new SizeRequest();
+    if (o.inches !== undefined) m.inches = o.inches;
+    return m;
+  }
+
+  // equals reports whether other holds the same values as the message.
+  equals(other: SizeRequest): boolean {
+    if (this === other) return true;
+    if (this.inches !== other.inches) return false;
+    if (!__pb__.Internal.unknownEqual(this.unknownFields, other.unknownFields)) return false;
+    return true;
+  }
+
+  // clone returns a deep copy of the message.
+  clone(): SizeRequest {
+    const m = new SizeRequest();
+    m.inches = this.inches;
+    m.unknownFields = this.unknownFields.map(u => u.slice());
+    return m;
+  }
+
+  // hashCode returns a hash of the message's values, which is equal for
+  // equal messages and stable across runs.
+  hashCode(): number {
+    let h = 0;
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashNumber(this.inches));
+    return h;
+  }
+}
+
+export interface HatInit {
+  size?: number;
+  color?: string;
+}
+
+export interface IHat {
+  size?: number;
+  color?: string;
+}
+
+export class Hat implements __pb__.Message {
+  static readonly typeName = "foo.twirp.Hat";
+
+  static readonly fields: __pb__.FieldInfo[] = [
+    { name: "size", number: 1, type: __pb__.FieldType.INT32, label: __pb__.FieldLabel.OPTIONAL, jsonName: "size", member: "size" },
+    { name: "color", number: 2, type: __pb__.FieldType.STRING, label: __pb__.FieldLabel.OPTIONAL, jsonName: "color", member: "color" },
+  ];
+
+  size: number;
+  color: string;
+  // The encoding of fields which were not recognized when decoding.
+  unknownFields: Uint8Array[];
+
+  constructor(init?: HatInit) {
+    this.size = 0;
+    this.color = "";
+    this.unknownFields = [];
+    if (init !== undefined) {
+      if (init.size !== undefined) this.size = init.size;
+      if (init.color !== undefined) this.color = init.color;
+    }
+  }
+
+  MergeFrom(d: __pb__.Internal.Decoder): void {
+    while (!d.isEOF()) {
+      let [fn, wt] = d.readTag();
+      switch(fn) {
+        case 1:
+        this.size = d.readVarInt32();
+        break;
+        case 2:
+        this.color = d.readValidString();
+        break;
+        default:
+        this.unknownFields.push(d.readUnknown(wt, fn));
+      }
+    }
+  }
+
+  WriteTo(e: __pb__.Internal.Encoder): void {
+    if (this.size != 0) {
+      e.writeTag(1, 0);
+      e.writeNumberAsVarint(this.size);
+    }
+    if (this.color != "") {
+      e.writeTag(2, 2);
+      e.writeString(this.color);
+    }
+    e.writeUnknown(this.unknownFields);
+  }
+
+  MergeFromJSON(j: __pb__.JsonValue, o: __pb__.JsonOptions = {}): void {
+    const obj = __pb__.Internal.objectFromJSON(j);
+    for (const k in obj) {
+      const v = obj[k];
+      if (v === null) {
+        continue;
+      }
+      switch (k) {
+        case "size":
+        this.size = __pb__.Internal.int32FromJSON(v);
+        break;
+        case "color":
+        this.color = __pb__.Internal.stringFromJSON(v);
+        break;
+        default:
+        __pb__.Internal.unknownFieldFromJSON(k, o);
+      }
+    }
+  }
+
+  ToJSON(o: __pb__.JsonOptions = {}): __pb__.JsonValue {
+    const j: __pb__.JsonObject = {};
+    if (o.emitDefaults || this.size != 0) {
+      j["size"] = this.size;
+    }
+    if (o.emitDefaults || this.color != "") {
+      j["color"] = this.color;
+    }
+    return j;
+  }
+
+  // toObject returns the message as a plain object, holding no classes.
+  toObject(): IHat {
+    const o: IHat = {};
+    o.size = this.size;
+    o.color = this.color;
+    return o;
+  }
+
+  // fromObject returns a message from its plain object form.
+  static fromObject(o: IHat): Hat {
+    const m = new Hat();
+    if (o.size !== undefined) m.size = o.size;
+    if (o.color !== undefined) m.color = o.color;
+    return m;
+  }
+
+  // equals reports whether other holds the same values as the message.
+  equals(other: Hat): boolean {
+    if (this === other) return true;
+    if (this.size !== other.size) return false;
+    if (this.color !== other.color) return false;
+    if (!__pb__.Internal.unknownEqual(this.unknownFields, other.unknownFields)) return false;
+    return true;
+  }
+
+  // clone returns a deep copy of the message.
+  clone(): Hat {
+    const m = new Hat();
+    m.size = this.size;
+    m.color = this.color;
+    m.unknownFields = this.unknownFields.map(u => u.slice());
+    return m;
+  }
+
+  // hashCode returns a hash of the message's values, which is equal for
+  // equal messages and stable across runs.
+  hashCode(): number {
+    let h = 0;
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashNumber(this.size));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashString(this.color));
+    return h;
+  }
+}
+
+export const HaberdasherDescriptor = {
+  typeName: "foo.twirp.Haberdasher",
+  methods: {
+    MakeHat: new __pb__.Grpc.MethodDescriptor({
+      service: "foo.twirp.Haberdasher",
+      name: "MakeHat",
+      input: SizeRequest,
+      output: Hat,
+      kind: __pb__.Grpc.MethodKind.Unary,
+      idempotency: __pb__.Grpc.IdempotencyLevel.IdempotencyUnknown,
+    }),
+  },
+};
+
+/**
+ * Haberdasher makes hats.
+ */
+export class HaberdasherTwirpClient {
+  private t: __pb__.Twirp.Transport;
+  constructor(baseUrl: string, options?: __pb__.Twirp.Options) {
+    this.t = new __pb__.Twirp.Transport(baseUrl, options);
+  }
+
+  /**
+   * MakeHat makes a hat of the requested size.
+   */
+  async MakeHat(min: SizeRequest): Promise<Hat> {
+    let mout = new Hat();
+    await this.t.call(HaberdasherDescriptor.methods.MakeHat, min, mout);
+    return mout;
+  }
+}
+
+/**
+ * Haberdasher makes hats.
+ */
+export interface HaberdasherTwirpServer {
+  /**
+   * MakeHat makes a hat of the requested size.
+   */
+  MakeHat(min: SizeRequest): Promise<Hat>;
+}
+
+export function HaberdasherTwirpHandler(impl: HaberdasherTwirpServer, options?: __pb__.Twirp.HandlerOptions): __pb__.Twirp.Handler {
+  return new __pb__.Twirp.Handler([
+    __pb__.Twirp.method(HaberdasherDescriptor.methods.MakeHat, min => impl.MakeHat(min)),
+  ], options);
+}
+
+// fileDescriptor is the google.protobuf.FileDescriptorProto of example13.proto.
+export const fileDescriptor = new __pb__.FileDescriptor(
+  "example13.proto",
+  "Cg9leGFtcGxlMTMucHJvdG8SCWZvby50d2lycCIlCgtTaXplUmVxdWVzdBIWCgZpbmNoZXMYASAB" +
+    "KAVSBmluY2hlcyIvCgNIYXQSEgoEc2l6ZRgBIAEoBVIEc2l6ZRIUCgVjb2xvchgCIAEoCVIFY29s" +
+    "b3IyQgoLSGFiZXJkYXNoZXISMwoHTWFrZUhhdBIWLmZvby50d2lycC5TaXplUmVxdWVzdBoOLmZv" +
+    "by50d2lycC5IYXQiAEqpAwoGEgQAABIBCggKAQwSAwAAEgoICgECEgMCABIKKgoCBAASBAUABwEa" +
+    "HiBHZW5lcmF0ZWQgd2l0aCBwbHVnaW49dHdpcnAuCgoKCgMEAAESAwUIEwoLCgQEAAIAEgMGAhMK" +
+    "DAoFBAACAAUSAwYCBwoMCgUEAAIAARIDBggOCgwKBQQAAgADEgMGERIKCgoCBAESBAkADAEKCgoD" +
+    "BAEBEgMJCAsKCwoEBAECABIDCgIRCgwKBQQBAgAFEgMKAgcKDAoFBAECAAESAwoIDAoMCgUEAQIA" +
+    "AxIDCg8QCgsKBAQBAgESAwsCEwoMCgUEAQIBBRIDCwIICgwKBQQBAgEBEgMLCQ4KDAoFBAECAQMS" +
+    "AwsREgolCgIGABIEDwASARoZIEhhYmVyZGFzaGVyIG1ha2VzIGhhdHMuCgoKCgMGAAESAw8IEwo5" +
+    "CgQGAAIAEgMRAisaLCBNYWtlSGF0IG1ha2VzIGEgaGF0IG9mIHRoZSByZXF1ZXN0ZWQgc2l6ZS4K" +
+    "CgwKBQYAAgABEgMRBg0KDAoFBgACAAISAxEOGQoMCgUGAAIAAxIDESQnYgZwcm90bzM=",
+  []
+);
+
+__pb__.globalRegistry.add(SizeRequest);
+__pb__.globalRegistry.add(Hat);
+__pb__.globalRegistry.addFile(fileDescriptor);
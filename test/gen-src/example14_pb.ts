@@ -0,0 +1,1169 @@
+// Generated by the protocol buffer compiler.  DO NOT EDIT!
+// Source: example14.proto
+
+import * as __pb__ from '../../lib/protobuf'
+import * as __long from 'long'
+import {fromString as __longFromString } from 'long'
+
+
+export interface BookInit {
+  name?: string;
+  title?: string;
+  page_count?: number;
+}
+
+export interface IBook {
+  name?: string;
+  title?: string;
+  page_count?: number;
+}
+
+/**
+ * Generated with plugin=rest.
+ */
+export class Book implements __pb__.Message {
+  static readonly typeName = "foo.rest.Book";
+
+  static readonly fields: __pb__.FieldInfo[] = [
+    { name: "name", number: 1, type: __pb__.FieldType.STRING, label: __pb__.FieldLabel.OPTIONAL, jsonName: "name", member: "name" },
+    { name: "title", number: 2, type: __pb__.FieldType.STRING, label: __pb__.FieldLabel.OPTIONAL, jsonName: "title", member: "title" },
+    { name: "page_count", number: 3, type: __pb__.FieldType.INT32, label: __pb__.FieldLabel.OPTIONAL, jsonName: "pageCount", member: "page_count" },
+  ];
+
+  name: string;
+  title: string;
+  page_count: number;
+  // The encoding of fields which were not recognized when decoding.
+  unknownFields: Uint8Array[];
+
+  constructor(init?: BookInit) {
+    this.name = "";
+    this.title = "";
+    this.page_count = 0;
+    this.unknownFields = [];
+    if (init !== undefined) {
+      if (init.name !== undefined) this.name = init.name;
+      if (init.title !== undefined) this.title = init.title;
+      if (init.page_count !== undefined) this.page_count = init.page_count;
+    }
+  }
+
+  MergeFrom(d: __pb__.Internal.Decoder): void {
+    while (!d.isEOF()) {
+      let [fn, wt] = d.readTag();
+      switch(fn) {
+        case 1:
+        this.name = d.readValidString();
+        break;
+        case 2:
+        this.title = d.readValidString();
+        break;
+        case 3:
+        this.page_count = d.readVarInt32();
+        break;
+        default:
+        this.unknownFields.push(d.readUnknown(wt, fn));
+      }
+    }
+  }
+
+  WriteTo(e: __pb__.Internal.Encoder): void {
+    if (this.name != "") {
+      e.writeTag(1, 2);
+      e.writeString(this.name);
+    }
+    if (this.title != "") {
+      e.writeTag(2, 2);
+      e.writeString(this.title);
+    }
+    if (this.page_count != 0) {
+      e.writeTag(3, 0);
+      e.writeNumberAsVarint(this.page_count);
+    }
+    e.writeUnknown(this.unknownFields);
+  }
+
+  MergeFromJSON(j: __pb__.JsonValue, o: __pb__.JsonOptions = {}): void {
+    const obj = __pb__.Internal.objectFromJSON(j);
+    for (const k in obj) {
+      const v = obj[k];
+      if (v === null) {
+        continue;
+      }
+      switch (k) {
+        case "name":
+        this.name = __pb__.Internal.stringFromJSON(v);
+        break;
+        case "title":
+        this.title = __pb__.Internal.stringFromJSON(v);
+        break;
+        case "pageCount":
+        case "page_count":
+        this.page_count = __pb__.Internal.int32FromJSON(v);
+        break;
+        default:
+        __pb__.Internal.unknownFieldFromJSON(k, o);
+      }
+    }
+  }
+
+  ToJSON(o: __pb__.JsonOptions = {}): __pb__.JsonValue {
+    const j: __pb__.JsonObject = {};
+    if (o.emitDefaults || this.name != "") {
+      j["name"] = this.name;
+    }
+    if (o.emitDefaults || this.title != "") {
+      j["title"] = this.title;
+    }
+    if (o.emitDefaults || this.page_count != 0) {
+      j[(o.useProtoNames ? "page_count" : "pageCount")] = this.page_count;
+    }
+    return j;
+  }
+
+  // toObject returns the message as a plain object, holding no classes.
+  toObject(): IBook {
+    const o: IBook = {};
+    o.name = this.name;
+    o.title = this.title;
+    o.page_count = this.page_count;
+    return o;
+  }
+
+  // fromObject returns a message from its plain object form.
+  static fromObject(o: IBook): Book {
+    const m = new Book();
+    if (o.name !== undefined) m.name = o.name;
+    if (o.title !== undefined) m.title = o.title;
+    if (o.page_count !== undefined) m.page_count = o.page_count;
+    return m;
+  }
+
+  // equals reports whether other holds the same values as the message.
+  equals(other: Book): boolean {
+    if (this === other) return true;
+    if (this.name !== other.name) return false;
+    if (this.title !== other.title) return false;
+    if (this.page_count !== other.page_count) return false;
+    if (!__pb__.Internal.unknownEqual(this.unknownFields, other.unknownFields)) return false;
+    return true;
+  }
+
+  // clone returns a deep copy of the message.
+  clone(): Book {
+    const m = new Book();
+    m.name = this.name;
+    m.title = this.title;
+    m.page_count = this.page_count;
+    m.unknownFields = this.unknownFields.map(u => u.slice());
+    return m;
+  }
+
+  // hashCode returns a hash of the message's values, which is equal for
+  // equal messages and stable across runs.
+  hashCode(): number {
+    let h = 0;
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashString(this.name));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashString(this.title));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashNumber(this.page_count));
+    return h;
+  }
+}
+
+export interface ShelfInit {
+  id?: __long;
+}
+
+export interface IShelf {
+  id?: string;
+}
+
+export class Shelf implements __pb__.Message {
+  static readonly typeName = "foo.rest.Shelf";
+
+  static readonly fields: __pb__.FieldInfo[] = [
+    { name: "id", number: 1, type: __pb__.FieldType.INT64, label: __pb__.FieldLabel.OPTIONAL, jsonName: "id", member: "id" },
+  ];
+
+  id: __long;
+  // The encoding of fields which were not recognized when decoding.
+  unknownFields: Uint8Array[];
+
+  constructor(init?: ShelfInit) {
+    this.id = __long.ZERO;
+    this.unknownFields = [];
+    if (init !== undefined) {
+      if (init.id !== undefined) this.id = init.id;
+    }
+  }
+
+  MergeFrom(d: __pb__.Internal.Decoder): void {
+    while (!d.isEOF()) {
+      let [fn, wt] = d.readTag();
+      switch(fn) {
+        case 1:
+        this.id = d.readVarintSigned();
+        break;
+        default:
+        this.unknownFields.push(d.readUnknown(wt, fn));
+      }
+    }
+  }
+
+  WriteTo(e: __pb__.Internal.Encoder): void {
+    if (this.id != __long.ZERO) {
+      e.writeTag(1, 0);
+      e.writeVarint(this.id);
+    }
+    e.writeUnknown(this.unknownFields);
+  }
+
+  MergeFromJSON(j: __pb__.JsonValue, o: __pb__.JsonOptions = {}): void {
+    const obj = __pb__.Internal.objectFromJSON(j);
+    for (const k in obj) {
+      const v = obj[k];
+      if (v === null) {
+        continue;
+      }
+      switch (k) {
+        case "id":
+        this.id = __pb__.Internal.int64FromJSON(v);
+        break;
+        default:
+        __pb__.Internal.unknownFieldFromJSON(k, o);
+      }
+    }
+  }
+
+  ToJSON(o: __pb__.JsonOptions = {}): __pb__.JsonValue {
+    const j: __pb__.JsonObject = {};
+    if (o.emitDefaults || !this.id.isZero()) {
+      j["id"] = this.id.toString();
+    }
+    return j;
+  }
+
+  // toObject returns the message as a plain object, holding no classes.
+  toObject(): IShelf {
+    const o: IShelf = {};
+    o.id = this.id.toString();
+    return o;
+  }
+
+  // fromObject returns a message from its plain object form.
+  static fromObject(o: IShelf): Shelf {
+    const m = new Shelf();
+    if (o.id !== undefined) m.id = __longFromString(o.id, false);
+    return m;
+  }
+
+  // equals reports whether other holds the same values as the message.
+  equals(other: Shelf): boolean {
+    if (this === other) return true;
+    if (!this.id.equals(other.id)) return false;
+    if (!__pb__.Internal.unknownEqual(this.unknownFields, other.unknownFields)) return false;
+    return true;
+  }
+
+  // clone returns a deep copy of the message.
+  clone(): Shelf {
+    const m = new Shelf();
+    m.id = this.id;
+    m.unknownFields = this.unknownFields.map(u => u.slice());
+    return m;
+  }
+
+  // hashCode returns a hash of the message's values, which is equal for
+  // equal messages and stable across runs.
+  hashCode(): number {
+    let h = 0;
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashLong(this.id));
+    return h;
+  }
+}
+
+export interface GetBookRequestInit {
+  name?: string;
+  include_reviews?: boolean;
+  fields?: string[];
+  shelf?: Shelf | ShelfInit;
+}
+
+export interface IGetBookRequest {
+  name?: string;
+  include_reviews?: boolean;
+  fields?: string[];
+  shelf?: IShelf;
+}
+
+export class GetBookRequest implements __pb__.Message {
+  static readonly typeName = "foo.rest.GetBookRequest";
+
+  static readonly fields: __pb__.FieldInfo[] = [
+    { name: "name", number: 1, type: __pb__.FieldType.STRING, label: __pb__.FieldLabel.OPTIONAL, jsonName: "name", member: "name" },
+    { name: "include_reviews", number: 2, type: __pb__.FieldType.BOOL, label: __pb__.FieldLabel.OPTIONAL, jsonName: "includeReviews", member: "include_reviews" },
+    { name: "fields", number: 3, type: __pb__.FieldType.STRING, label: __pb__.FieldLabel.REPEATED, jsonName: "fields", member: "fields" },
+    { name: "shelf", number: 4, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.OPTIONAL, jsonName: "shelf", member: "shelf", messageType: () => Shelf },
+  ];
+
+  name: string;
+  include_reviews: boolean;
+  fields: string[];
+  shelf: Shelf | null;
+  // The encoding of fields which were not recognized when decoding.
+  unknownFields: Uint8Array[];
+
+  constructor(init?: GetBookRequestInit) {
+    this.name = "";
+    this.include_reviews = false;
+    this.fields = [];
+    this.shelf = null;
+    this.unknownFields = [];
+    if (init !== undefined) {
+      if (init.name !== undefined) this.name = init.name;
+      if (init.include_reviews !== undefined) this.include_reviews = init.include_reviews;
+      if (init.fields !== undefined) this.fields = init.fields.slice();
+      if (init.shelf !== undefined) this.shelf = __pb__.Internal.fromInit(Shelf, init.shelf);
+    }
+  }
+
+  MergeFrom(d: __pb__.Internal.Decoder): void {
+    while (!d.isEOF()) {
+      let [fn, wt] = d.readTag();
+      switch(fn) {
+        case 1:
+        this.name = d.readValidString();
+        break;
+        case 2:
+        this.include_reviews = d.readBool();
+        break;
+        case 3:
+        this.fields.push(d.readValidString())
+        break;
+        case 4:
+        if (this.shelf == null) this.shelf = new Shelf();
+        this.shelf.MergeFrom(d.readDecoder());
+        break;
+        default:
+        this.unknownFields.push(d.readUnknown(wt, fn));
+      }
+    }
+  }
+
+  WriteTo(e: __pb__.Internal.Encoder): void {
+    if (this.name != "") {
+      e.writeTag(1, 2);
+      e.writeString(this.name);
+    }
+    if (this.include_reviews != false) {
+      e.writeTag(2, 0);
+      e.writeBool(this.include_reviews);
+    }
+    for (let elem of this.fields) {
+      e.writeTag(3, 2);
+      e.writeString(elem);
+    }
+    {
+      const msg = this.shelf;
+      if (msg != null) {
+        let nested = new __pb__.Internal.Encoder();
+        msg.WriteTo(nested);
+        e.writeEncoder(nested, 4);
+      }
+    }
+    e.writeUnknown(this.unknownFields);
+  }
+
+  MergeFromJSON(j: __pb__.JsonValue, o: __pb__.JsonOptions = {}): void {
+    const obj = __pb__.Internal.objectFromJSON(j);
+    for (const k in obj) {
+      const v = obj[k];
+      if (v === null) {
+        continue;
+      }
+      switch (k) {
+        case "name":
+        this.name = __pb__.Internal.stringFromJSON(v);
+        break;
+        case "includeReviews":
+        case "include_reviews":
+        this.include_reviews = __pb__.Internal.boolFromJSON(v);
+        break;
+        case "fields":
+        for (const elem of __pb__.Internal.arrayFromJSON(v)) {
+          this.fields.push(__pb__.Internal.stringFromJSON(elem));
+        }
+        break;
+        case "shelf":
+        if (this.shelf == null) this.shelf = new Shelf();
+        this.shelf.MergeFromJSON(v, o);
+        break;
+        default:
+        __pb__.Internal.unknownFieldFromJSON(k, o);
+      }
+    }
+  }
+
+  ToJSON(o: __pb__.JsonOptions = {}): __pb__.JsonValue {
+    const j: __pb__.JsonObject = {};
+    if (o.emitDefaults || this.name != "") {
+      j["name"] = this.name;
+    }
+    if (o.emitDefaults || this.include_reviews != false) {
+      j[(o.useProtoNames ? "include_reviews" : "includeReviews")] = this.include_reviews;
+    }
+    if (o.emitDefaults || this.fields.length > 0) {
+      j["fields"] = this.fields.slice();
+    }
+    if (o.emitDefaults || this.shelf != null) {
+      const msg = this.shelf;
+      j["shelf"] = msg == null ? null : msg.ToJSON(o);
+    }
+    return j;
+  }
+
+  // toObject returns the message as a plain object, holding no classes.
+  toObject(): IGetBookRequest {
+    const o: IGetBookRequest = {};
+    o.name = this.name;
+    o.include_reviews = this.include_reviews;
+    o.fields = this.fields.slice();
+    if (this.shelf != null) {
+      o.shelf = this.shelf.toObject();
+    }
+    return o;
+  }
+
+  // fromObject returns a message from its plain object form.
+  static fromObject(o: IGetBookRequest): GetBookRequest {
+    const m = new GetBookRequest();
+    if (o.name !== undefined) m.name = o.name;
+    if (o.include_reviews !== undefined) m.include_reviews = o.include_reviews;
+    if (o.fields !== undefined) m.fields = o.fields.slice();
+    if (o.shelf !== undefined) m.shelf = Shelf.fromObject(o.shelf);
+    return m;
+  }
+
+  // equals reports whether other holds the same values as the message.
+  equals(other: GetBookRequest): boolean {
+    if (this === other) return true;
+    if (this.name !== other.name) return false;
+    if (this.include_reviews !== other.include_reviews) return false;
+    if (!__pb__.Internal.arrayEqual(this.fields, other.fields)) return false;
+    if (!__pb__.Internal.optionalEqual(this.shelf, other.shelf, (x, y) => x.equals(y))) return false;
+    if (!__pb__.Internal.unknownEqual(this.unknownFields, other.unknownFields)) return false;
+    return true;
+  }
+
+  // clone returns a deep copy of the message.
+  clone(): GetBookRequest {
+    const m = new GetBookRequest();
+    m.name = this.name;
+    m.include_reviews = this.include_reviews;
+    m.fields = this.fields.slice();
+    m.shelf = this.shelf == null ? null : this.shelf.clone();
+    m.unknownFields = this.unknownFields.map(u => u.slice());
+    return m;
+  }
+
+  // hashCode returns a hash of the message's values, which is equal for
+  // equal messages and stable across runs.
+  hashCode(): number {
+    let h = 0;
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashString(this.name));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashBool(this.include_reviews));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashArray(this.fields, __pb__.Internal.hashString));
+    h = __pb__.Internal.hashCombine(h, this.shelf == null ? 0 : this.shelf.hashCode());
+    return h;
+  }
+}
+
+export interface CreateBookRequestInit {
+  shelf?: Shelf | ShelfInit;
+  book?: Book | BookInit;
+  book_id?: string;
+}
+
+export interface ICreateBookRequest {
+  shelf?: IShelf;
+  book?: IBook;
+  book_id?: string;
+}
+
+export class CreateBookRequest implements __pb__.Message {
+  static readonly typeName = "foo.rest.CreateBookRequest";
+
+  static readonly fields: __pb__.FieldInfo[] = [
+    { name: "shelf", number: 1, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.OPTIONAL, jsonName: "shelf", member: "shelf", messageType: () => Shelf },
+    { name: "book", number: 2, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.OPTIONAL, jsonName: "book", member: "book", messageType: () => Book },
+    { name: "book_id", number: 3, type: __pb__.FieldType.STRING, label: __pb__.FieldLabel.OPTIONAL, jsonName: "bookId", member: "book_id" },
+  ];
+
+  shelf: Shelf | null;
+  book: Book | null;
+  book_id: string;
+  // The encoding of fields which were not recognized when decoding.
+  unknownFields: Uint8Array[];
+
+  constructor(init?: CreateBookRequestInit) {
+    this.shelf = null;
+    this.book = null;
+    this.book_id = "";
+    this.unknownFields = [];
+    if (init !== undefined) {
+      if (init.shelf !== undefined) this.shelf = __pb__.Internal.fromInit(Shelf, init.shelf);
+      if (init.book !== undefined) this.book = __pb__.Internal.fromInit(Book, init.book);
+      if (init.book_id !== undefined) this.book_id = init.book_id;
+    }
+  }
+
+  MergeFrom(d: __pb__.Internal.Decoder): void {
+    while (!d.isEOF()) {
+      let [fn, wt] = d.readTag();
+      switch(fn) {
+        case 1:
+        if (this.shelf == null) this.shelf = new Shelf();
+        this.shelf.MergeFrom(d.readDecoder());
+        break;
+        case 2:
+        if (this.book == null) this.book = new Book();
+        this.book.MergeFrom(d.readDecoder());
+        break;
+        case 3:
+        this.book_id = d.readValidString();
+        break;
+        default:
+        this.unknownFields.push(d.readUnknown(wt, fn));
+      }
+    }
+  }
+
+  WriteTo(e: __pb__.Internal.Encoder): void {
+    {
+      const msg = this.shelf;
+      if (msg != null) {
+        let nested = new __pb__.Internal.Encoder();
+        msg.WriteTo(nested);
+        e.writeEncoder(nested, 1);
+      }
+    }
+    {
+      const msg = this.book;
+      if (msg != null) {
+        let nested = new __pb__.Internal.Encoder();
+        msg.WriteTo(nested);
+        e.writeEncoder(nested, 2);
+      }
+    }
+    if (this.book_id != "") {
+      e.writeTag(3, 2);
+      e.writeString(this.book_id);
+    }
+    e.writeUnknown(this.unknownFields);
+  }
+
+  MergeFromJSON(j: __pb__.JsonValue, o: __pb__.JsonOptions = {}): void {
+    const obj = __pb__.Internal.objectFromJSON(j);
+    for (const k in obj) {
+      const v = obj[k];
+      if (v === null) {
+        continue;
+      }
+      switch (k) {
+        case "shelf":
+        if (this.shelf == null) this.shelf = new Shelf();
+        this.shelf.MergeFromJSON(v, o);
+        break;
+        case "book":
+        if (this.book == null) this.book = new Book();
+        this.book.MergeFromJSON(v, o);
+        break;
+        case "bookId":
+        case "book_id":
+        this.book_id = __pb__.Internal.stringFromJSON(v);
+        break;
+        default:
+        __pb__.Internal.unknownFieldFromJSON(k, o);
+      }
+    }
+  }
+
+  ToJSON(o: __pb__.JsonOptions = {}): __pb__.JsonValue {
+    const j: __pb__.JsonObject = {};
+    if (o.emitDefaults || this.shelf != null) {
+      const msg = this.shelf;
+      j["shelf"] = msg == null ? null : msg.ToJSON(o);
+    }
+    if (o.emitDefaults || this.book != null) {
+      const msg = this.book;
+      j["book"] = msg == null ? null : msg.ToJSON(o);
+    }
+    if (o.emitDefaults || this.book_id != "") {
+      j[(o.useProtoNames ? "book_id" : "bookId")] = this.book_id;
+    }
+    return j;
+  }
+
+  // toObject returns the message as a plain object, holding no classes.
+  toObject(): ICreateBookRequest {
+    const o: ICreateBookRequest = {};
+    if (this.shelf != null) {
+      o.shelf = this.shelf.toObject();
+    }
+    if (this.book != null) {
+      o.book = this.book.toObject();
+    }
+    o.book_id = this.book_id;
+    return o;
+  }
+
+  // fromObject returns a message from its plain object form.
+  static fromObject(o: ICreateBookRequest): CreateBookRequest {
+    const m = new CreateBookRequest();
+    if (o.shelf !== undefined) m.shelf = Shelf.fromObject(o.shelf);
+    if (o.book !== undefined) m.book = Book.fromObject(o.book);
+    if (o.book_id !== undefined) m.book_id = o.book_id;
+    return m;
+  }
+
+  // equals reports whether other holds the same values as the message.
+  equals(other: CreateBookRequest): boolean {
+    if (this === other) return true;
+    if (!__pb__.Internal.optionalEqual(this.shelf, other.shelf, (x, y) => x.equals(y))) return false;
+    if (!__pb__.Internal.optionalEqual(this.book, other.book, (x, y) => x.equals(y))) return false;
+    if (this.book_id !== other.book_id) return false;
+    if (!__pb__.Internal.unknownEqual(this.unknownFields, other.unknownFields)) return false;
+    return true;
+  }
+
+  // clone returns a deep copy of the message.
+  clone(): CreateBookRequest {
+    const m = new CreateBookRequest();
+    m.shelf = this.shelf == null ? null : this.shelf.clone();
+    m.book = this.book == null ? null : this.book.clone();
+    m.book_id = this.book_id;
+    m.unknownFields = this.unknownFields.map(u => u.slice());
+    return m;
+  }
+
+  // hashCode returns a hash of the message's values, which is equal for
+  // equal messages and stable across runs.
+  hashCode(): number {
+    let h = 0;
+    h = __pb__.Internal.hashCombine(h, this.shelf == null ? 0 : this.shelf.hashCode());
+    h = __pb__.Internal.hashCombine(h, this.book == null ? 0 : this.book.hashCode());
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashString(this.book_id));
+    return h;
+  }
+}
+
+export interface UpdateBookRequestInit {
+  book?: Book | BookInit;
+}
+
+export interface IUpdateBookRequest {
+  book?: IBook;
+}
+
+export class UpdateBookRequest implements __pb__.Message {
+  static readonly typeName = "foo.rest.UpdateBookRequest";
+
+  static readonly fields: __pb__.FieldInfo[] = [
+    { name: "book", number: 1, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.OPTIONAL, jsonName: "book", member: "book", messageType: () => Book },
+  ];
+
+  book: Book | null;
+  // The encoding of fields which were not recognized when decoding.
+  unknownFields: Uint8Array[];
+
+  constructor(init?: UpdateBookRequestInit) {
+    this.book = null;
+    this.unknownFields = [];
+    if (init !== undefined) {
+      if (init.book !== undefined) this.book = __pb__.Internal.fromInit(Book, init.book);
+    }
+  }
+
+  MergeFrom(d: __pb__.Internal.Decoder): void {
+    while (!d.isEOF()) {
+      let [fn, wt] = d.readTag();
+      switch(fn) {
+        case 1:
+        if (this.book == null) this.book = new Book();
+        this.book.MergeFrom(d.readDecoder());
+        break;
+        default:
+        this.unknownFields.push(d.readUnknown(wt, fn));
+      }
+    }
+  }
+
+  WriteTo(e: __pb__.Internal.Encoder): void {
+    {
+      const msg = this.book;
+      if (msg != null) {
+        let nested = new __pb__.Internal.Encoder();
+        msg.WriteTo(nested);
+        e.writeEncoder(nested, 1);
+      }
+    }
+    e.writeUnknown(this.unknownFields);
+  }
+
+  MergeFromJSON(j: __pb__.JsonValue, o: __pb__.JsonOptions = {}): void {
+    const obj = __pb__.Internal.objectFromJSON(j);
+    for (const k in obj) {
+      const v = obj[k];
+      if (v === null) {
+        continue;
+      }
+      switch (k) {
+        case "book":
+        if (this.book == null) this.book = new Book();
+        this.book.MergeFromJSON(v, o);
+        break;
+        default:
+        __pb__.Internal.unknownFieldFromJSON(k, o);
+      }
+    }
+  }
+
+  ToJSON(o: __pb__.JsonOptions = {}): __pb__.JsonValue {
+    const j: __pb__.JsonObject = {};
+    if (o.emitDefaults || this.book != null) {
+      const msg = this.book;
+      j["book"] = msg == null ? null : msg.ToJSON(o);
+    }
+    return j;
+  }
+
+  // toObject returns the message as a plain object, holding no classes.
+  toObject(): IUpdateBookRequest {
+    const o: IUpdateBookRequest = {};
+    if (this.book != null) {
+      o.book = this.book.toObject();
+    }
+    return o;
+  }
+
+  // fromObject returns a message from its plain object form.
+  static fromObject(o: IUpdateBookRequest): UpdateBookRequest {
+    const m = new UpdateBookRequest();
+    if (o.book !== undefined) m.book = Book.fromObject(o.book);
+    return m;
+  }
+
+  // equals reports whether other holds the same values as the message.
+  equals(other: UpdateBookRequest): boolean {
+    if (this === other) return true;
+    if (!__pb__.Internal.optionalEqual(this.book, other.book, (x, y) => x.equals(y))) return false;
+    if (!__pb__.Internal.unknownEqual(this.unknownFields, other.unknownFields)) return false;
+    return true;
+  }
+
+  // clone returns a deep copy of the message.
+  clone(): UpdateBookRequest {
+    const m = new UpdateBookRequest();
+    m.book = this.book == null ? null : this.book.clone();
+    m.unknownFields = this.unknownFields.map(u => u.slice());
+    return m;
+  }
+
+  // hashCode returns a hash of the message's values, which is equal for
+  // equal messages and stable across runs.
+  hashCode(): number {
+    let h = 0;
+    h = __pb__.Internal.hashCombine(h, this.book == null ? 0 : this.book.hashCode());
+    return h;
+  }
+}
+
+export interface DeleteBookRequestInit {
+  name?: string;
+}
+
+export interface IDeleteBookRequest {
+  name?: string;
+}
+
+export class DeleteBookRequest implements __pb__.Message {
+  static readonly typeName = "foo.rest.DeleteBookRequest";
+
+  static readonly fields: __pb__.FieldInfo[] = [
+    { name: "name", number: 1, type: __pb__.FieldType.STRING, label: __pb__.FieldLabel.OPTIONAL, jsonName: "name", member: "name" },
+  ];
+
+  name: string;
+  // The encoding of fields which were not recognized when decoding.
+  unknownFields: Uint8Array[];
+
+  constructor(init?: DeleteBookRequestInit) {
+    this.name = "";
+    this.unknownFields = [];
+    if (init !== undefined) {
+      if (init.name !== undefined) this.name = init.name;
+    }
+  }
+
+  MergeFrom(d: __pb__.Internal.Decoder): void {
+    while (!d.isEOF()) {
+      let [fn, wt] = d.readTag();
+      switch(fn) {
+        case 1:
+        this.name = d.readValidString();
+        break;
+        default:
+        this.unknownFields.push(d.readUnknown(wt, fn));
+      }
+    }
+  }
+
+  WriteTo(e: __pb__.Internal.Encoder): void {
+    if (this.name != "") {
+      e.writeTag(1, 2);
+      e.writeString(this.name);
+    }
+    e.writeUnknown(this.unknownFields);
+  }
+
+  MergeFromJSON(j: __pb__.JsonValue, o: __pb__.JsonOptions = {}): void {
+    const obj = __pb__.Internal.objectFromJSON(j);
+    for (const k in obj) {
+      const v = obj[k];
+      if (v === null) {
+        continue;
+      }
+      switch (k) {
+        case "name":
+        this.name = __pb__.Internal.stringFromJSON(v);
+        break;
+        default:
+        __pb__.Internal.unknownFieldFromJSON(k, o);
+      }
+    }
+  }
+
+  ToJSON(o: __pb__.JsonOptions = {}): __pb__.JsonValue {
+    const j: __pb__.JsonObject = {};
+    if (o.emitDefaults || this.name != "") {
+      j["name"] = this.name;
+    }
+    return j;
+  }
+
+  // toObject returns the message as a plain object, holding no classes.
+  toObject(): IDeleteBookRequest {
+    const o: IDeleteBookRequest = {};
+    o.name = this.name;
+    return o;
+  }
+
+  // fromObject returns a message from its plain object form.
+  static fromObject(o: IDeleteBookRequest): DeleteBookRequest {
+    const m = new DeleteBookRequest();
+    if (o.name !== undefined) m.name = o.name;
+    return m;
+  }
+
+  // equals reports whether other holds the same values as the message.
+  equals(other: DeleteBookRequest): boolean {
+    if (this === other) return true;
+    if (this.name !== other.name) return false;
+    if (!__pb__.Internal.unknownEqual(this.unknownFields, other.unknownFields)) return false;
+    return true;
+  }
+
+  // clone returns a deep copy of the message.
+  clone(): DeleteBookRequest {
+    const m = new DeleteBookRequest();
+    m.name = this.name;
+    m.unknownFields = this.unknownFields.map(u => u.slice());
+    return m;
+  }
+
+  // hashCode returns a hash of the message's values, which is equal for
+  // equal messages and stable across runs.
+  hashCode(): number {
+    let h = 0;
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashString(this.name));
+    return h;
+  }
+}
+
+export interface EmptyInit {}
+
+export interface IEmpty {}
+
+export class Empty implements __pb__.Message {
+  static readonly typeName = "foo.rest.Empty";
+
+  static readonly fields: __pb__.FieldInfo[] = [];
+
+  // The encoding of fields which were not recognized when decoding.
+  unknownFields: Uint8Array[];
+
+  constructor(_?: EmptyInit) {
+    this.unknownFields = [];
+  }
+
+  MergeFrom(d: __pb__.Internal.Decoder): void {
+    while (!d.isEOF()) {
+      let [fn, wt] = d.readTag();
+      switch(fn) {
+        default:
+        this.unknownFields.push(d.readUnknown(wt, fn));
+      }
+    }
+  }
+
+  WriteTo(e: __pb__.Internal.Encoder): void {
+    e.writeUnknown(this.unknownFields);
+  }
+
+  MergeFromJSON(j: __pb__.JsonValue, o: __pb__.JsonOptions = {}): void {
+    for (const k in __pb__.Internal.objectFromJSON(j)) {
+      __pb__.Internal.unknownFieldFromJSON(k, o);
+    }
+  }
+
+  ToJSON(_: __pb__.JsonOptions = {}): __pb__.JsonValue {
+    return {};
+  }
+
+  // toObject returns the message as a plain object, holding no classes.
+  toObject(): IEmpty {
+    const o: IEmpty = {};
+    return o;
+  }
+
+  // fromObject returns a message from its plain object form.
+  static fromObject(o: IEmpty): Empty {
+    const m = new Empty();
+    return m;
+  }
+
+  // equals reports whether other holds the same values as the message.
+  equals(other: Empty): boolean {
+    if (this === other) return true;
+    if (!__pb__.Internal.unknownEqual(this.unknownFields, other.unknownFields)) return false;
+    return true;
+  }
+
+  // clone returns a deep copy of the message.
+  clone(): Empty {
+    const m = new Empty();
+    m.unknownFields = this.unknownFields.map(u => u.slice());
+    return m;
+  }
+
+  // hashCode returns a hash of the message's values, which is equal for
+  // equal messages and stable across runs.
+  hashCode(): number {
+    return 0;
+  }
+}
+
+export const LibraryDescriptor = {
+  typeName: "foo.rest.Library",
+  methods: {
+    GetBook: new __pb__.Grpc.MethodDescriptor({
+      service: "foo.rest.Library",
+      name: "GetBook",
+      input: GetBookRequest,
+      output: Book,
+      kind: __pb__.Grpc.MethodKind.Unary,
+      idempotency: __pb__.Grpc.IdempotencyLevel.IdempotencyUnknown,
+    }),
+    GetBookTitle: new __pb__.Grpc.MethodDescriptor({
+      service: "foo.rest.Library",
+      name: "GetBookTitle",
+      input: GetBookRequest,
+      output: Book,
+      kind: __pb__.Grpc.MethodKind.Unary,
+      idempotency: __pb__.Grpc.IdempotencyLevel.IdempotencyUnknown,
+    }),
+    CreateBook: new __pb__.Grpc.MethodDescriptor({
+      service: "foo.rest.Library",
+      name: "CreateBook",
+      input: CreateBookRequest,
+      output: Book,
+      kind: __pb__.Grpc.MethodKind.Unary,
+      idempotency: __pb__.Grpc.IdempotencyLevel.IdempotencyUnknown,
+    }),
+    UpdateBook: new __pb__.Grpc.MethodDescriptor({
+      service: "foo.rest.Library",
+      name: "UpdateBook",
+      input: UpdateBookRequest,
+      output: Book,
+      kind: __pb__.Grpc.MethodKind.Unary,
+      idempotency: __pb__.Grpc.IdempotencyLevel.IdempotencyUnknown,
+    }),
+    DeleteBook: new __pb__.Grpc.MethodDescriptor({
+      service: "foo.rest.Library",
+      name: "DeleteBook",
+      input: DeleteBookRequest,
+      output: Empty,
+      kind: __pb__.Grpc.MethodKind.Unary,
+      idempotency: __pb__.Grpc.IdempotencyLevel.IdempotencyUnknown,
+    }),
+    PublishBook: new __pb__.Grpc.MethodDescriptor({
+      service: "foo.rest.Library",
+      name: "PublishBook",
+      input: DeleteBookRequest,
+      output: Book,
+      kind: __pb__.Grpc.MethodKind.Unary,
+      idempotency: __pb__.Grpc.IdempotencyLevel.IdempotencyUnknown,
+    }),
+    Unmapped: new __pb__.Grpc.MethodDescriptor({
+      service: "foo.rest.Library",
+      name: "Unmapped",
+      input: Empty,
+      output: Empty,
+      kind: __pb__.Grpc.MethodKind.Unary,
+      idempotency: __pb__.Grpc.IdempotencyLevel.IdempotencyUnknown,
+    }),
+  },
+};
+
+/**
+ * Library is mapped to REST endpoints as grpc-gateway would serve them.
+ */
+export class LibraryRestClient {
+  private t: __pb__.Rest.Transport;
+  constructor(baseUrl: string, options?: __pb__.Rest.Options) {
+    this.t = new __pb__.Rest.Transport(baseUrl, options);
+  }
+
+  async GetBook(min: GetBookRequest): Promise<Book> {
+    let mout = new Book();
+    await this.t.call({
+      method: "GET",
+      path: ["/v1/", { field: ["name"], multi: true }],
+      body: "",
+      responseBody: "",
+    }, min, mout);
+    return mout;
+  }
+
+  async GetBookTitle(min: GetBookRequest): Promise<Book> {
+    let mout = new Book();
+    await this.t.call({
+      method: "GET",
+      path: ["/v1/", { field: ["name"], multi: true }, "/title"],
+      body: "",
+      responseBody: "title",
+    }, min, mout);
+    return mout;
+  }
+
+  async CreateBook(min: CreateBookRequest): Promise<Book> {
+    let mout = new Book();
+    await this.t.call({
+      method: "POST",
+      path: ["/v1/shelves/", { field: ["shelf", "id"], multi: false }, "/books"],
+      body: "book",
+      responseBody: "",
+    }, min, mout);
+    return mout;
+  }
+
+  async UpdateBook(min: UpdateBookRequest): Promise<Book> {
+    let mout = new Book();
+    await this.t.call({
+      method: "PATCH",
+      path: ["/v1/", { field: ["book", "name"], multi: true }],
+      body: "*",
+      responseBody: "",
+    }, min, mout);
+    return mout;
+  }
+
+  async DeleteBook(min: DeleteBookRequest): Promise<Empty> {
+    let mout = new Empty();
+    await this.t.call({
+      method: "DELETE",
+      path: ["/v1/", { field: ["name"], multi: true }],
+      body: "",
+      responseBody: "",
+    }, min, mout);
+    return mout;
+  }
+
+  async PublishBook(min: DeleteBookRequest): Promise<Book> {
+    let mout = new Book();
+    await this.t.call({
+      method: "POST",
+      path: ["/v1/", { field: ["name"], multi: true }, ":publish"],
+      body: "*",
+      responseBody: "",
+    }, min, mout);
+    return mout;
+  }
+}
+
+// fileDescriptor is the google.protobuf.FileDescriptorProto of example14.proto.
+export const fileDescriptor = new __pb__.FileDescriptor(
+  "example14.proto",
+  "Cg9leGFtcGxlMTQucHJvdG8SCGZvby5yZXN0Ghxnb29nbGUvYXBpL2Fubm90YXRpb25zLnByb3Rv" +
+    "Ik8KBEJvb2sSEgoEbmFtZRgBIAEoCVIEbmFtZRIUCgV0aXRsZRgCIAEoCVIFdGl0bGUSHQoKcGFn" +
+    "ZV9jb3VudBgDIAEoBVIJcGFnZUNvdW50IhcKBVNoZWxmEg4KAmlkGAEgASgDUgJpZCKMAQoOR2V0" +
+    "Qm9va1JlcXVlc3QSEgoEbmFtZRgBIAEoCVIEbmFtZRInCg9pbmNsdWRlX3Jldmlld3MYAiABKAhS" +
+    "DmluY2x1ZGVSZXZpZXdzEhYKBmZpZWxkcxgDIAMoCVIGZmllbGRzEiUKBXNoZWxmGAQgASgLMg8u" +
+    "Zm9vLnJlc3QuU2hlbGZSBXNoZWxmIncKEUNyZWF0ZUJvb2tSZXF1ZXN0EiUKBXNoZWxmGAEgASgL" +
+    "Mg8uZm9vLnJlc3QuU2hlbGZSBXNoZWxmEiIKBGJvb2sYAiABKAsyDi5mb28ucmVzdC5Cb29rUgRi" +
+    "b29rEhcKB2Jvb2tfaWQYAyABKAlSBmJvb2tJZCI3ChFVcGRhdGVCb29rUmVxdWVzdBIiCgRib29r" +
+    "GAEgASgLMg4uZm9vLnJlc3QuQm9va1IEYm9vayInChFEZWxldGVCb29rUmVxdWVzdBISCgRuYW1l" +
+    "GAEgASgJUgRuYW1lIgcKBUVtcHR5MqgFCgdMaWJyYXJ5ElkKB0dldEJvb2sSGC5mb28ucmVzdC5H" +
+    "ZXRCb29rUmVxdWVzdBoOLmZvby5yZXN0LkJvb2siJILT5JMCHhIcL3YxL3tuYW1lPXNoZWx2ZXMv" +
+    "Ki9ib29rcy8qfRJrCgxHZXRCb29rVGl0bGUSGC5mb28ucmVzdC5HZXRCb29rUmVxdWVzdBoOLmZv" +
+    "by5yZXN0LkJvb2siMYLT5JMCKxIiL3YxL3tuYW1lPXNoZWx2ZXMvKi9ib29rcy8qfS90aXRsZWIF" +
+    "dGl0bGUSZQoKQ3JlYXRlQm9vaxIbLmZvby5yZXN0LkNyZWF0ZUJvb2tSZXF1ZXN0Gg4uZm9vLnJl" +
+    "c3QuQm9vayIqgtPkkwIkOgRib29rIhwvdjEvc2hlbHZlcy97c2hlbGYuaWR9L2Jvb2tzEmcKClVw" +
+    "ZGF0ZUJvb2sSGy5mb28ucmVzdC5VcGRhdGVCb29rUmVxdWVzdBoOLmZvby5yZXN0LkJvb2siLILT" +
+    "5JMCJjoBKjIhL3YxL3tib29rLm5hbWU9c2hlbHZlcy8qL2Jvb2tzLyp9EmAKCkRlbGV0ZUJvb2sS" +
+    "Gy5mb28ucmVzdC5EZWxldGVCb29rUmVxdWVzdBoPLmZvby5yZXN0LkVtcHR5IiSC0+STAh4qHC92" +
+    "MS97bmFtZT1zaGVsdmVzLyovYm9va3MvKn0ScwoLUHVibGlzaEJvb2sSGy5mb28ucmVzdC5EZWxl" +
+    "dGVCb29rUmVxdWVzdBoOLmZvby5yZXN0LkJvb2siN4LT5JMCMUIsEiQvdjEve25hbWU9c2hlbHZl" +
+    "cy8qL2Jvb2tzLyp9OnB1Ymxpc2gKBFBPU1Q6ASoSLgoIVW5tYXBwZWQSDy5mb28ucmVzdC5FbXB0" +
+    "eRoPLmZvby5yZXN0LkVtcHR5IgBKuA0KBhIEAABUAQoICgEMEgMAABIKCAoBAhIDAgARCgkKAgMA" +
+    "EgMEACYKKQoCBAASBAcACwEaHSBHZW5lcmF0ZWQgd2l0aCBwbHVnaW49cmVzdC4KCgoKAwQAARID" +
+    "BwgMCgsKBAQAAgASAwgCEgoMCgUEAAIABRIDCAIICgwKBQQAAgABEgMICQ0KDAoFBAACAAMSAwgQ" +
+    "EQoLCgQEAAIBEgMJAhMKDAoFBAACAQUSAwkCCAoMCgUEAAIBARIDCQkOCgwKBQQAAgEDEgMJERIK" +
+    "CwoEBAACAhIDCgIXCgwKBQQAAgIFEgMKAgcKDAoFBAACAgESAwoIEgoMCgUEAAICAxIDChUWCgoK" +
+    "AgQBEgQNAA8BCgoKAwQBARIDDQgNCgsKBAQBAgASAw4CDwoMCgUEAQIABRIDDgIHCgwKBQQBAgAB" +
+    "EgMOCAoKDAoFBAECAAMSAw4NDgoKCgIEAhIEEQAWAQoKCgMEAgESAxEIFgoLCgQEAgIAEgMSAhIK" +
+    "DAoFBAICAAUSAxICCAoMCgUEAgIAARIDEgkNCgwKBQQCAgADEgMSEBEKCwoEBAICARIDEwIbCgwK" +
+    "BQQCAgEFEgMTAgYKDAoFBAICAQESAxMHFgoMCgUEAgIBAxIDExkaCgsKBAQCAgISAxQCHQoMCgUE" +
+    "AgICBBIDFAIKCgwKBQQCAgIFEgMUCxEKDAoFBAICAgESAxQSGAoMCgUEAgICAxIDFBscCgsKBAQC" +
+    "AgMSAxUCEgoMCgUEAgIDBhIDFQIHCgwKBQQCAgMBEgMVCA0KDAoFBAICAwMSAxUQEQoKCgIEAxIE" +
+    "GAAcAQoKCgMEAwESAxgIGQoLCgQEAwIAEgMZAhIKDAoFBAMCAAYSAxkCBwoMCgUEAwIAARIDGQgN" +
+    "CgwKBQQDAgADEgMZEBEKCwoEBAMCARIDGgIQCgwKBQQDAgEGEgMaAgYKDAoFBAMCAQESAxoHCwoM" +
+    "CgUEAwIBAxIDGg4PCgsKBAQDAgISAxsCFQoMCgUEAwICBRIDGwIICgwKBQQDAgIBEgMbCRAKDAoF" +
+    "BAMCAgMSAxsTFAoKCgIEBBIEHgAgAQoKCgMEBAESAx4IGQoLCgQEBAIAEgMfAhAKDAoFBAQCAAYS" +
+    "Ax8CBgoMCgUEBAIAARIDHwcLCgwKBQQEAgADEgMfDg8KCgoCBAUSBCIAJAEKCgoDBAUBEgMiCBkK" +
+    "CwoEBAUCABIDIwISCgwKBQQFAgAFEgMjAggKDAoFBAUCAAESAyMJDQoMCgUEBQIAAxIDIxARCgkK" +
+    "AgQGEgMmABAKCgoDBAYBEgMmCA0KUwoCBgASBCkAVAEaRyBMaWJyYXJ5IGlzIG1hcHBlZCB0byBS" +
+    "RVNUIGVuZHBvaW50cyBhcyBncnBjLWdhdGV3YXkgd291bGQgc2VydmUgdGhlbS4KCgoKAwYAARID" +
+    "KQgPCgwKBAYAAgASBCoCLgMKDAoFBgACAAESAyoGDQoMCgUGAAIAAhIDKg4cCgwKBQYAAgADEgMq" +
+    "JysKDQoFBgACAAQSBCsELQYKEQoJBgACAASwyrwiEgQrBC0GCgwKBAYAAgESBDACNQMKDAoFBgAC" +
+    "AQESAzAGEgoMCgUGAAIBAhIDMBMhCgwKBQYAAgEDEgMwLDAKDQoFBgACAQQSBDEENAYKEQoJBgAC" +
+    "AQSwyrwiEgQxBDQGCgwKBAYAAgISBDcCPAMKDAoFBgACAgESAzcGEAoMCgUGAAICAhIDNxEiCgwK" +
+    "BQYAAgIDEgM3LTEKDQoFBgACAgQSBDgEOwYKEQoJBgACAgSwyrwiEgQ4BDsGCgwKBAYAAgMSBD4C" +
+    "QwMKDAoFBgACAwESAz4GEAoMCgUGAAIDAhIDPhEiCgwKBQYAAgMDEgM+LTEKDQoFBgACAwQSBD8E" +
+    "QgYKEQoJBgACAwSwyrwiEgQ/BEIGCgwKBAYAAgQSBEUCSQMKDAoFBgACBAESA0UGEAoMCgUGAAIE" +
+    "AhIDRREiCgwKBQYAAgQDEgNFLTIKDQoFBgACBAQSBEYESAYKEQoJBgACBASwyrwiEgRGBEgGCgwK" +
+    "BAYAAgUSBEsCUAMKDAoFBgACBQESA0sGEQoMCgUGAAIFAhIDSxIjCgwKBQYAAgUDEgNLLjIKDQoF" +
+    "BgACBQQSBEwETwYKEQoJBgACBQSwyrwiEgRMBE8GCkkKBAYAAgYSA1MCKBo8IFVubWFwcGVkIGhh" +
+    "cyBubyBnb29nbGUuYXBpLmh0dHAgb3B0aW9uLCBzbyBpdCBpcyBsZWZ0IG91dC4KCgwKBQYAAgYB" +
+    "EgNTBg4KDAoFBgACBgISA1MPFAoMCgUGAAIGAxIDUx8kYgZwcm90bzM=",
+  ["google/api/annotations.proto"]
+);
+
+__pb__.globalRegistry.add(Book);
+__pb__.globalRegistry.add(Shelf);
+__pb__.globalRegistry.add(GetBookRequest);
+__pb__.globalRegistry.add(CreateBookRequest);
+__pb__.globalRegistry.add(UpdateBookRequest);
+__pb__.globalRegistry.add(DeleteBookRequest);
+__pb__.globalRegistry.add(Empty);
+__pb__.globalRegistry.addFile(fileDescriptor);
@@ -2,6 +2,7 @@
 // Source: example1.proto
 
 import * as __pb__ from '../../lib/protobuf'
+import * as ___google_protobuf_any_pb from './google/protobuf/any_pb'
 import * as ___example2_pb from './example2_pb'
 import * as __long from 'long'
 import {fromString as __longFromString } from 'long'
@@ -12,11 +13,62 @@ export const enum AEnum1 {
   B = 2,
 }
 
+export function AEnum1ToJSON(v: AEnum1): string | number {
+  switch (v as number) {
+    case 0:
+    return "A";
+    case 2:
+    return "B";
+  }
+  return v;
+}
+
+export function AEnum1FromJSON(v: __pb__.JsonValue, o: __pb__.JsonOptions = {}): AEnum1 | undefined {
+  switch (v) {
+    case "A":
+    return AEnum1.A;
+    case "B":
+    return AEnum1.B;
+  }
+  return __pb__.Internal.enumFromJSON(v, o);
+}
+
+export const AEnum1Info: __pb__.EnumInfo = {
+  typeName: "foo.bar.AEnum1",
+  values: [
+    { name: "A", number: 0 },
+    { name: "B", number: 2 },
+  ],
+};
+
+export interface example2Init {
+  aint32?: number;
+}
+
+export interface Iexample2 {
+  aint32?: number;
+}
+
+/**
+ * Intentionally, same as below to test namespacing.
+ */
 export class example2 implements __pb__.Message {
+  static readonly typeName = "foo.bar.example2";
+
+  static readonly fields: __pb__.FieldInfo[] = [
+    { name: "aint32", number: 1, type: __pb__.FieldType.INT32, label: __pb__.FieldLabel.OPTIONAL, jsonName: "aint32", member: "aint32" },
+  ];
+
   aint32: number;
+  // The encoding of fields which were not recognized when decoding.
+  unknownFields: Uint8Array[];
 
-  constructor() {
+  constructor(init?: example2Init) {
     this.aint32 = 0;
+    this.unknownFields = [];
+    if (init !== undefined) {
+      if (init.aint32 !== undefined) this.aint32 = init.aint32;
+    }
   }
 
   MergeFrom(d: __pb__.Internal.Decoder): void {
@@ -27,7 +79,7 @@ export class example2 implements __pb__.Message {
         this.aint32 = d.readVarInt32();
         break;
         default:
-        d.skipWireType(wt)
+        this.unknownFields.push(d.readUnknown(wt, fn));
       }
     }
   }
@@ -37,10 +89,191 @@ export class example2 implements __pb__.Message {
       e.writeTag(1, 0);
       e.writeNumberAsVarint(this.aint32);
     }
+    e.writeUnknown(this.unknownFields);
   }
+
+  MergeFromJSON(j: __pb__.JsonValue, o: __pb__.JsonOptions = {}): void {
+    const obj = __pb__.Internal.objectFromJSON(j);
+    for (const k in obj) {
+      const v = obj[k];
+      if (v === null) {
+        continue;
+      }
+      switch (k) {
+        case "aint32":
+        this.aint32 = __pb__.Internal.int32FromJSON(v);
+        break;
+        default:
+        __pb__.Internal.unknownFieldFromJSON(k, o);
+      }
+    }
+  }
+
+  ToJSON(o: __pb__.JsonOptions = {}): __pb__.JsonValue {
+    const j: __pb__.JsonObject = {};
+    if (o.emitDefaults || this.aint32 != 0) {
+      j["aint32"] = this.aint32;
+    }
+    return j;
+  }
+
+  // toObject returns the message as a plain object, holding no classes.
+  toObject(): Iexample2 {
+    const o: Iexample2 = {};
+    o.aint32 = this.aint32;
+    return o;
+  }
+
+  // fromObject returns a message from its plain object form.
+  static fromObject(o: Iexample2): example2 {
+    const m = new example2();
+    if (o.aint32 !== undefined) m.aint32 = o.aint32;
+    return m;
+  }
+
+  // equals reports whether other holds the same values as the message.
+  equals(other: example2): boolean {
+    if (this === other) return true;
+    if (this.aint32 !== other.aint32) return false;
+    if (!__pb__.Internal.unknownEqual(this.unknownFields, other.unknownFields)) return false;
+    return true;
+  }
+
+  // clone returns a deep copy of the message.
+  clone(): example2 {
+    const m = new example2();
+    m.aint32 = this.aint32;
+    m.unknownFields = this.unknownFields.map(u => u.slice());
+    return m;
+  }
+
+  // hashCode returns a hash of the message's values, which is equal for
+  // equal messages and stable across runs.
+  hashCode(): number {
+    let h = 0;
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashNumber(this.aint32));
+    return h;
+  }
+}
+
+export interface example1Init {
+  /**
+   * Scalars.
+   */
+  adouble?: number;
+  afloat?: number;
+  aint32?: number;
+  aint64?: __long;
+  auint32?: number;
+  auint64?: __long;
+  asint32?: number;
+  asint64?: __long;
+  afixed32?: number;
+  afixed64?: __long;
+  asfixed32?: number;
+  asfixed64?: __long;
+  abool?: boolean;
+  astring?: string;
+  abytes?: Uint8Array;
+  aenum1?: AEnum1;
+  aenum2?: example1.AEnum2;
+  aenum22?: ___example2_pb.AEnum2;
+  /**
+   * Repeated
+   */
+  manystring?: string[];
+  manyint64?: __long[];
+  aexample2?: example1.example2 | example1.example2Init;
+  aexample22?: example2 | example2Init;
+  aexample23?: ___example2_pb.example2 | ___example2_pb.example2Init;
+  amap?: Map<string, string>;
+  amap2?: Map<string, ___example2_pb.example2 | ___example2_pb.example2Init>;
+  outoforder?: __long;
+  longmap?: Map<string, string>;
+  anany?: ___google_protobuf_any_pb.Any | ___google_protobuf_any_pb.AnyInit;
+  aoneof?: example1.aoneof.oneof_type;
+  oostring?: string;
+  ooint?: number;
+}
+
+export interface Iexample1 {
+  /**
+   * Scalars.
+   */
+  adouble?: number;
+  afloat?: number;
+  aint32?: number;
+  aint64?: string;
+  auint32?: number;
+  auint64?: string;
+  asint32?: number;
+  asint64?: string;
+  afixed32?: number;
+  afixed64?: string;
+  asfixed32?: number;
+  asfixed64?: string;
+  abool?: boolean;
+  astring?: string;
+  abytes?: string;
+  aenum1?: AEnum1;
+  aenum2?: example1.AEnum2;
+  aenum22?: ___example2_pb.AEnum2;
+  /**
+   * Repeated
+   */
+  manystring?: string[];
+  manyint64?: string[];
+  aexample2?: example1.Iexample2;
+  aexample22?: Iexample2;
+  aexample23?: ___example2_pb.Iexample2;
+  amap?: { [k: string]: string };
+  amap2?: { [k: string]: ___example2_pb.Iexample2 };
+  outoforder?: string;
+  longmap?: { [k: string]: string };
+  anany?: ___google_protobuf_any_pb.IAny;
+  oostring?: string;
+  ooint?: number;
 }
 
 export class example1 implements __pb__.Message {
+  static readonly typeName = "foo.bar.example1";
+
+  static readonly fields: __pb__.FieldInfo[] = [
+    { name: "adouble", number: 1, type: __pb__.FieldType.DOUBLE, label: __pb__.FieldLabel.OPTIONAL, jsonName: "adouble", member: "adouble" },
+    { name: "afloat", number: 2, type: __pb__.FieldType.FLOAT, label: __pb__.FieldLabel.OPTIONAL, jsonName: "afloat", member: "afloat" },
+    { name: "aint32", number: 3, type: __pb__.FieldType.INT32, label: __pb__.FieldLabel.OPTIONAL, jsonName: "aint32", member: "aint32" },
+    { name: "aint64", number: 4, type: __pb__.FieldType.INT64, label: __pb__.FieldLabel.OPTIONAL, jsonName: "aint64", member: "aint64" },
+    { name: "auint32", number: 5, type: __pb__.FieldType.UINT32, label: __pb__.FieldLabel.OPTIONAL, jsonName: "auint32", member: "auint32" },
+    { name: "auint64", number: 6, type: __pb__.FieldType.UINT64, label: __pb__.FieldLabel.OPTIONAL, jsonName: "auint64", member: "auint64" },
+    { name: "asint32", number: 7, type: __pb__.FieldType.SINT32, label: __pb__.FieldLabel.OPTIONAL, jsonName: "asint32", member: "asint32" },
+    { name: "asint64", number: 8, type: __pb__.FieldType.SINT64, label: __pb__.FieldLabel.OPTIONAL, jsonName: "asint64", member: "asint64" },
+    { name: "afixed32", number: 9, type: __pb__.FieldType.FIXED32, label: __pb__.FieldLabel.OPTIONAL, jsonName: "afixed32", member: "afixed32" },
+    { name: "afixed64", number: 10, type: __pb__.FieldType.FIXED64, label: __pb__.FieldLabel.OPTIONAL, jsonName: "afixed64", member: "afixed64" },
+    { name: "asfixed32", number: 11, type: __pb__.FieldType.SFIXED32, label: __pb__.FieldLabel.OPTIONAL, jsonName: "asfixed32", member: "asfixed32" },
+    { name: "asfixed64", number: 12, type: __pb__.FieldType.SFIXED64, label: __pb__.FieldLabel.OPTIONAL, jsonName: "asfixed64", member: "asfixed64" },
+    { name: "abool", number: 13, type: __pb__.FieldType.BOOL, label: __pb__.FieldLabel.OPTIONAL, jsonName: "abool", member: "abool" },
+    { name: "astring", number: 14, type: __pb__.FieldType.STRING, label: __pb__.FieldLabel.OPTIONAL, jsonName: "astring", member: "astring" },
+    { name: "abytes", number: 15, type: __pb__.FieldType.BYTES, label: __pb__.FieldLabel.OPTIONAL, jsonName: "abytes", member: "abytes" },
+    { name: "aenum1", number: 20, type: __pb__.FieldType.ENUM, label: __pb__.FieldLabel.OPTIONAL, jsonName: "aenum1", member: "aenum1", enumType: () => AEnum1Info },
+    { name: "aenum2", number: 21, type: __pb__.FieldType.ENUM, label: __pb__.FieldLabel.OPTIONAL, jsonName: "aenum2", member: "aenum2", enumType: () => example1.AEnum2Info },
+    { name: "aenum22", number: 22, type: __pb__.FieldType.ENUM, label: __pb__.FieldLabel.OPTIONAL, jsonName: "aenum22", member: "aenum22", enumType: () => ___example2_pb.AEnum2Info },
+    { name: "manystring", number: 30, type: __pb__.FieldType.STRING, label: __pb__.FieldLabel.REPEATED, jsonName: "manystring", member: "manystring" },
+    { name: "manyint64", number: 31, type: __pb__.FieldType.INT64, label: __pb__.FieldLabel.REPEATED, jsonName: "manyint64", member: "manyint64" },
+    { name: "aexample2", number: 40, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.OPTIONAL, jsonName: "aexample2", member: "aexample2", messageType: () => example1.example2 },
+    { name: "aexample22", number: 41, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.OPTIONAL, jsonName: "aexample22", member: "aexample22", messageType: () => example2 },
+    { name: "aexample23", number: 42, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.OPTIONAL, jsonName: "aexample23", member: "aexample23", messageType: () => ___example2_pb.example2 },
+    { name: "amap", number: 51, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.REPEATED, jsonName: "amap", member: "amap", map: { key: __pb__.FieldType.STRING, value: __pb__.FieldType.STRING } },
+    { name: "amap2", number: 52, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.REPEATED, jsonName: "amap2", member: "amap2", map: { key: __pb__.FieldType.STRING, value: __pb__.FieldType.MESSAGE }, messageType: () => ___example2_pb.example2 },
+    { name: "outoforder", number: 49, type: __pb__.FieldType.INT64, label: __pb__.FieldLabel.OPTIONAL, jsonName: "outoforder", member: "outoforder" },
+    { name: "oostring", number: 60, type: __pb__.FieldType.STRING, label: __pb__.FieldLabel.OPTIONAL, jsonName: "oostring", member: "aoneof", oneof: "aoneof", oneofCase: () => example1.aoneof.oostring },
+    { name: "ooint", number: 61, type: __pb__.FieldType.INT32, label: __pb__.FieldLabel.OPTIONAL, jsonName: "ooint", member: "aoneof", oneof: "aoneof", oneofCase: () => example1.aoneof.ooint },
+    { name: "longmap", number: 62, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.REPEATED, jsonName: "longmap", member: "longmap", map: { key: __pb__.FieldType.INT64, value: __pb__.FieldType.STRING } },
+    { name: "anany", number: 80, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.OPTIONAL, jsonName: "anany", member: "anany", messageType: () => ___google_protobuf_any_pb.Any },
+  ];
+
+  /**
+   * Scalars.
+   */
   adouble: number;
   afloat: number;
   aint32: number;
@@ -59,6 +292,9 @@ export class example1 implements __pb__.Message {
   aenum1: AEnum1;
   aenum2: example1.AEnum2;
   aenum22: ___example2_pb.AEnum2;
+  /**
+   * Repeated
+   */
   manystring: string[];
   manyint64: __long[];
   aexample2: example1.example2 | null;
@@ -68,9 +304,12 @@ export class example1 implements __pb__.Message {
   amap2: Map<string, ___example2_pb.example2>;
   outoforder: __long;
   longmap: Map<string, string>;
+  anany: ___google_protobuf_any_pb.Any | null;
   aoneof: example1.aoneof.oneof_type;
+  // The encoding of fields which were not recognized when decoding.
+  unknownFields: Uint8Array[];
 
-  constructor() {
+  constructor(init?: example1Init) {
     this.adouble = 0.0;
     this.afloat = 0.0;
     this.aint32 = 0;
@@ -98,7 +337,54 @@ export class example1 implements __pb__.Message {
     this.amap2 = new Map<string, ___example2_pb.example2>();
     this.outoforder = __long.ZERO;
     this.longmap = new Map<string, string>();
+    this.anany = null;
     this.aoneof = __pb__.OneofNotSet.singleton;
+    this.unknownFields = [];
+    if (init !== undefined) {
+      if (init.adouble !== undefined) this.adouble = init.adouble;
+      if (init.afloat !== undefined) this.afloat = init.afloat;
+      if (init.aint32 !== undefined) this.aint32 = init.aint32;
+      if (init.aint64 !== undefined) this.aint64 = init.aint64;
+      if (init.auint32 !== undefined) this.auint32 = init.auint32;
+      if (init.auint64 !== undefined) this.auint64 = init.auint64;
+      if (init.asint32 !== undefined) this.asint32 = init.asint32;
+      if (init.asint64 !== undefined) this.asint64 = init.asint64;
+      if (init.afixed32 !== undefined) this.afixed32 = init.afixed32;
+      if (init.afixed64 !== undefined) this.afixed64 = init.afixed64;
+      if (init.asfixed32 !== undefined) this.asfixed32 = init.asfixed32;
+      if (init.asfixed64 !== undefined) this.asfixed64 = init.asfixed64;
+      if (init.abool !== undefined) this.abool = init.abool;
+      if (init.astring !== undefined) this.astring = init.astring;
+      if (init.abytes !== undefined) this.abytes = init.abytes;
+      if (init.aenum1 !== undefined) this.aenum1 = init.aenum1;
+      if (init.aenum2 !== undefined) this.aenum2 = init.aenum2;
+      if (init.aenum22 !== undefined) this.aenum22 = init.aenum22;
+      if (init.manystring !== undefined) this.manystring = init.manystring.slice();
+      if (init.manyint64 !== undefined) this.manyint64 = init.manyint64.slice();
+      if (init.aexample2 !== undefined) this.aexample2 = __pb__.Internal.fromInit(example1.example2, init.aexample2);
+      if (init.aexample22 !== undefined) this.aexample22 = __pb__.Internal.fromInit(example2, init.aexample22);
+      if (init.aexample23 !== undefined) this.aexample23 = __pb__.Internal.fromInit(___example2_pb.example2, init.aexample23);
+      if (init.amap !== undefined) {
+        for (const [k, v] of init.amap) {
+          this.amap.set(k, v);
+        }
+      }
+      if (init.amap2 !== undefined) {
+        for (const [k, v] of init.amap2) {
+          this.amap2.set(k, __pb__.Internal.fromInit(___example2_pb.example2, v));
+        }
+      }
+      if (init.outoforder !== undefined) this.outoforder = init.outoforder;
+      if (init.longmap !== undefined) {
+        for (const [k, v] of init.longmap) {
+          this.longmap.set(k, v);
+        }
+      }
+      if (init.anany !== undefined) this.anany = __pb__.Internal.fromInit(___google_protobuf_any_pb.Any, init.anany);
+      if (init.aoneof !== undefined) this.aoneof = init.aoneof;
+      if (init.oostring !== undefined) this.aoneof = new example1.aoneof.oostring(init.oostring);
+      if (init.ooint !== undefined) this.aoneof = new example1.aoneof.ooint(init.ooint);
+    }
   }
 
   MergeFrom(d: __pb__.Internal.Decoder): void {
@@ -145,7 +431,7 @@ export class example1 implements __pb__.Message {
         this.abool = d.readBool();
         break;
         case 14:
-        this.astring = d.readString();
+        this.astring = d.readValidString();
         break;
         case 15:
         this.abytes = d.readBytes();
@@ -160,7 +446,7 @@ export class example1 implements __pb__.Message {
         this.aenum22 = d.readVarintSignedAsNumber();
         break;
         case 30:
-        this.manystring.push(d.readString())
+        this.manystring.push(d.readValidString())
         break;
         case 31:
         if (wt == 2) {
@@ -202,7 +488,7 @@ export class example1 implements __pb__.Message {
         this.outoforder = d.readVarintSigned();
         break;
         case 60:
-        this.aoneof = new example1.aoneof.oostring(d.readString());
+        this.aoneof = new example1.aoneof.oostring(d.readValidString());
         break;
         case 61:
         this.aoneof = new example1.aoneof.ooint(d.readVarInt32());
@@ -214,8 +500,12 @@ export class example1 implements __pb__.Message {
           this.longmap.set(obj.key.toString(), obj.value);
         }
         break;
+        case 80:
+        if (this.anany == null) this.anany = new ___google_protobuf_any_pb.Any();
+        this.anany.MergeFrom(d.readDecoder());
+        break;
         default:
-        d.skipWireType(wt)
+        this.unknownFields.push(d.readUnknown(wt, fn));
       }
     }
   }
@@ -297,7 +587,7 @@ export class example1 implements __pb__.Message {
       e.writeTag(30, 2);
       e.writeString(elem);
     }
-    {
+    if (this.manyint64.length > 0) {
       const packed = new __pb__.Internal.Encoder();
       for (let elem of this.manyint64) {
         packed.writeVarint(elem);
@@ -309,7 +599,7 @@ export class example1 implements __pb__.Message {
       if (msg != null) {
         let nested = new __pb__.Internal.Encoder();
         msg.WriteTo(nested);
-        e.writeEncoder(nested, 40)
+        e.writeEncoder(nested, 40);
       }
     }
     {
@@ -317,7 +607,7 @@ export class example1 implements __pb__.Message {
       if (msg != null) {
         let nested = new __pb__.Internal.Encoder();
         msg.WriteTo(nested);
-        e.writeEncoder(nested, 41)
+        e.writeEncoder(nested, 41);
       }
     }
     {
@@ -325,7 +615,7 @@ export class example1 implements __pb__.Message {
       if (msg != null) {
         let nested = new __pb__.Internal.Encoder();
         msg.WriteTo(nested);
-        e.writeEncoder(nested, 42)
+        e.writeEncoder(nested, 42);
       }
     }
     for (const [k, v] of this.amap) {
@@ -356,7 +646,511 @@ export class example1 implements __pb__.Message {
       obj.WriteTo(nested);
       e.writeEncoder(nested, 62);
     }
+    {
+      const msg = this.anany;
+      if (msg != null) {
+        let nested = new __pb__.Internal.Encoder();
+        msg.WriteTo(nested);
+        e.writeEncoder(nested, 80);
+      }
+    }
     example1.aoneof.WriteTo(this.aoneof, e);
+    e.writeUnknown(this.unknownFields);
+  }
+
+  MergeFromJSON(j: __pb__.JsonValue, o: __pb__.JsonOptions = {}): void {
+    const obj = __pb__.Internal.objectFromJSON(j);
+    for (const k in obj) {
+      const v = obj[k];
+      if (v === null) {
+        continue;
+      }
+      switch (k) {
+        case "adouble":
+        this.adouble = __pb__.Internal.doubleFromJSON(v);
+        break;
+        case "afloat":
+        this.afloat = __pb__.Internal.floatFromJSON(v);
+        break;
+        case "aint32":
+        this.aint32 = __pb__.Internal.int32FromJSON(v);
+        break;
+        case "aint64":
+        this.aint64 = __pb__.Internal.int64FromJSON(v);
+        break;
+        case "auint32":
+        this.auint32 = __pb__.Internal.uint32FromJSON(v);
+        break;
+        case "auint64":
+        this.auint64 = __pb__.Internal.uint64FromJSON(v);
+        break;
+        case "asint32":
+        this.asint32 = __pb__.Internal.int32FromJSON(v);
+        break;
+        case "asint64":
+        this.asint64 = __pb__.Internal.int64FromJSON(v);
+        break;
+        case "afixed32":
+        this.afixed32 = __pb__.Internal.uint32FromJSON(v);
+        break;
+        case "afixed64":
+        this.afixed64 = __pb__.Internal.uint64FromJSON(v);
+        break;
+        case "asfixed32":
+        this.asfixed32 = __pb__.Internal.int32FromJSON(v);
+        break;
+        case "asfixed64":
+        this.asfixed64 = __pb__.Internal.int64FromJSON(v);
+        break;
+        case "abool":
+        this.abool = __pb__.Internal.boolFromJSON(v);
+        break;
+        case "astring":
+        this.astring = __pb__.Internal.stringFromJSON(v);
+        break;
+        case "abytes":
+        this.abytes = __pb__.Internal.bytesFromJSON(v);
+        break;
+        case "aenum1":
+        {
+          let e = AEnum1FromJSON(v, o);
+          if (e !== undefined) {
+            this.aenum1 = e;
+          }
+        }
+        break;
+        case "aenum2":
+        {
+          let e = example1.AEnum2FromJSON(v, o);
+          if (e !== undefined) {
+            this.aenum2 = e;
+          }
+        }
+        break;
+        case "aenum22":
+        {
+          let e = ___example2_pb.AEnum2FromJSON(v, o);
+          if (e !== undefined) {
+            this.aenum22 = e;
+          }
+        }
+        break;
+        case "manystring":
+        for (const elem of __pb__.Internal.arrayFromJSON(v)) {
+          this.manystring.push(__pb__.Internal.stringFromJSON(elem));
+        }
+        break;
+        case "manyint64":
+        for (const elem of __pb__.Internal.arrayFromJSON(v)) {
+          this.manyint64.push(__pb__.Internal.int64FromJSON(elem));
+        }
+        break;
+        case "aexample2":
+        if (this.aexample2 == null) this.aexample2 = new example1.example2();
+        this.aexample2.MergeFromJSON(v, o);
+        break;
+        case "aexample22":
+        if (this.aexample22 == null) this.aexample22 = new example2();
+        this.aexample22.MergeFromJSON(v, o);
+        break;
+        case "aexample23":
+        if (this.aexample23 == null) this.aexample23 = new ___example2_pb.example2();
+        this.aexample23.MergeFromJSON(v, o);
+        break;
+        case "amap":
+        {
+          const m = __pb__.Internal.objectFromJSON(v);
+          for (const mk in m) {
+            this.amap.set(mk, __pb__.Internal.stringFromJSON(m[mk]));
+          }
+        }
+        break;
+        case "amap2":
+        {
+          const m = __pb__.Internal.objectFromJSON(v);
+          for (const mk in m) {
+            {
+              let msg = new ___example2_pb.example2();
+              msg.MergeFromJSON(m[mk], o);
+              this.amap2.set(mk, msg);
+            }
+          }
+        }
+        break;
+        case "outoforder":
+        this.outoforder = __pb__.Internal.int64FromJSON(v);
+        break;
+        case "oostring":
+        this.aoneof = new example1.aoneof.oostring(__pb__.Internal.stringFromJSON(v));
+        break;
+        case "ooint":
+        this.aoneof = new example1.aoneof.ooint(__pb__.Internal.int32FromJSON(v));
+        break;
+        case "longmap":
+        {
+          const m = __pb__.Internal.objectFromJSON(v);
+          for (const mk in m) {
+            this.longmap.set(__pb__.Internal.int64FromJSON(mk).toString(), __pb__.Internal.stringFromJSON(m[mk]));
+          }
+        }
+        break;
+        case "anany":
+        if (this.anany == null) this.anany = new ___google_protobuf_any_pb.Any();
+        this.anany.MergeFromJSON(v, o);
+        break;
+        default:
+        __pb__.Internal.unknownFieldFromJSON(k, o);
+      }
+    }
+  }
+
+  ToJSON(o: __pb__.JsonOptions = {}): __pb__.JsonValue {
+    const j: __pb__.JsonObject = {};
+    if (o.emitDefaults || this.adouble != 0.0) {
+      j["adouble"] = __pb__.Internal.floatToJSON(this.adouble);
+    }
+    if (o.emitDefaults || this.afloat != 0.0) {
+      j["afloat"] = __pb__.Internal.floatToJSON(this.afloat);
+    }
+    if (o.emitDefaults || this.aint32 != 0) {
+      j["aint32"] = this.aint32;
+    }
+    if (o.emitDefaults || !this.aint64.isZero()) {
+      j["aint64"] = this.aint64.toString();
+    }
+    if (o.emitDefaults || this.auint32 != 0) {
+      j["auint32"] = this.auint32;
+    }
+    if (o.emitDefaults || !this.auint64.isZero()) {
+      j["auint64"] = this.auint64.toString();
+    }
+    if (o.emitDefaults || this.asint32 != 0) {
+      j["asint32"] = this.asint32;
+    }
+    if (o.emitDefaults || !this.asint64.isZero()) {
+      j["asint64"] = this.asint64.toString();
+    }
+    if (o.emitDefaults || this.afixed32 != 0) {
+      j["afixed32"] = this.afixed32;
+    }
+    if (o.emitDefaults || !this.afixed64.isZero()) {
+      j["afixed64"] = this.afixed64.toString();
+    }
+    if (o.emitDefaults || this.asfixed32 != 0) {
+      j["asfixed32"] = this.asfixed32;
+    }
+    if (o.emitDefaults || !this.asfixed64.isZero()) {
+      j["asfixed64"] = this.asfixed64.toString();
+    }
+    if (o.emitDefaults || this.abool != false) {
+      j["abool"] = this.abool;
+    }
+    if (o.emitDefaults || this.astring != "") {
+      j["astring"] = this.astring;
+    }
+    if (o.emitDefaults || this.abytes.length > 0) {
+      j["abytes"] = __pb__.Internal.bytesToJSON(this.abytes);
+    }
+    if (o.emitDefaults || this.aenum1 != 0) {
+      j["aenum1"] = AEnum1ToJSON(this.aenum1);
+    }
+    if (o.emitDefaults || this.aenum2 != 0) {
+      j["aenum2"] = example1.AEnum2ToJSON(this.aenum2);
+    }
+    if (o.emitDefaults || this.aenum22 != 0) {
+      j["aenum22"] = ___example2_pb.AEnum2ToJSON(this.aenum22);
+    }
+    if (o.emitDefaults || this.manystring.length > 0) {
+      j["manystring"] = this.manystring.slice();
+    }
+    if (o.emitDefaults || this.manyint64.length > 0) {
+      j["manyint64"] = this.manyint64.map(elem => elem.toString());
+    }
+    if (o.emitDefaults || this.aexample2 != null) {
+      const msg = this.aexample2;
+      j["aexample2"] = msg == null ? null : msg.ToJSON(o);
+    }
+    if (o.emitDefaults || this.aexample22 != null) {
+      const msg = this.aexample22;
+      j["aexample22"] = msg == null ? null : msg.ToJSON(o);
+    }
+    if (o.emitDefaults || this.aexample23 != null) {
+      const msg = this.aexample23;
+      j["aexample23"] = msg == null ? null : msg.ToJSON(o);
+    }
+    if (o.emitDefaults || this.amap.size > 0) {
+      const m: __pb__.JsonObject = {};
+      for (const [k, v] of this.amap) {
+        m[String(k)] = v;
+      }
+      j["amap"] = m;
+    }
+    if (o.emitDefaults || this.amap2.size > 0) {
+      const m: __pb__.JsonObject = {};
+      for (const [k, v] of this.amap2) {
+        m[String(k)] = v.ToJSON(o);
+      }
+      j["amap2"] = m;
+    }
+    if (o.emitDefaults || !this.outoforder.isZero()) {
+      j["outoforder"] = this.outoforder.toString();
+    }
+    if (o.emitDefaults || this.longmap.size > 0) {
+      const m: __pb__.JsonObject = {};
+      for (const [k, v] of this.longmap) {
+        m[String(k)] = v;
+      }
+      j["longmap"] = m;
+    }
+    if (o.emitDefaults || this.anany != null) {
+      const msg = this.anany;
+      j["anany"] = msg == null ? null : msg.ToJSON(o);
+    }
+    switch (this.aoneof.kind) {
+      case 60:
+      j["oostring"] = (this.aoneof as example1.aoneof.oostring).value;
+      break;
+      case 61:
+      j["ooint"] = (this.aoneof as example1.aoneof.ooint).value;
+      break;
+    }
+    return j;
+  }
+
+  // toObject returns the message as a plain object, holding no classes.
+  toObject(): Iexample1 {
+    const o: Iexample1 = {};
+    o.adouble = this.adouble;
+    o.afloat = this.afloat;
+    o.aint32 = this.aint32;
+    o.aint64 = this.aint64.toString();
+    o.auint32 = this.auint32;
+    o.auint64 = this.auint64.toString();
+    o.asint32 = this.asint32;
+    o.asint64 = this.asint64.toString();
+    o.afixed32 = this.afixed32;
+    o.afixed64 = this.afixed64.toString();
+    o.asfixed32 = this.asfixed32;
+    o.asfixed64 = this.asfixed64.toString();
+    o.abool = this.abool;
+    o.astring = this.astring;
+    o.abytes = __pb__.Internal.bytesToJSON(this.abytes);
+    o.aenum1 = this.aenum1;
+    o.aenum2 = this.aenum2;
+    o.aenum22 = this.aenum22;
+    o.manystring = this.manystring.slice();
+    o.manyint64 = this.manyint64.map(v => v.toString());
+    if (this.aexample2 != null) {
+      o.aexample2 = this.aexample2.toObject();
+    }
+    if (this.aexample22 != null) {
+      o.aexample22 = this.aexample22.toObject();
+    }
+    if (this.aexample23 != null) {
+      o.aexample23 = this.aexample23.toObject();
+    }
+    {
+      const m: { [k: string]: string } = {};
+      for (const [k, v] of this.amap) {
+        m[k] = v;
+      }
+      o.amap = m;
+    }
+    {
+      const m: { [k: string]: ___example2_pb.Iexample2 } = {};
+      for (const [k, v] of this.amap2) {
+        m[k] = v.toObject();
+      }
+      o.amap2 = m;
+    }
+    o.outoforder = this.outoforder.toString();
+    {
+      const m: { [k: string]: string } = {};
+      for (const [k, v] of this.longmap) {
+        m[k] = v;
+      }
+      o.longmap = m;
+    }
+    if (this.anany != null) {
+      o.anany = this.anany.toObject();
+    }
+    if (this.aoneof instanceof example1.aoneof.oostring) {
+      o.oostring = this.aoneof.value;
+    }
+    if (this.aoneof instanceof example1.aoneof.ooint) {
+      o.ooint = this.aoneof.value;
+    }
+    return o;
+  }
+
+  // fromObject returns a message from its plain object form.
+  static fromObject(o: Iexample1): example1 {
+    const m = new example1();
+    if (o.adouble !== undefined) m.adouble = o.adouble;
+    if (o.afloat !== undefined) m.afloat = o.afloat;
+    if (o.aint32 !== undefined) m.aint32 = o.aint32;
+    if (o.aint64 !== undefined) m.aint64 = __longFromString(o.aint64, false);
+    if (o.auint32 !== undefined) m.auint32 = o.auint32;
+    if (o.auint64 !== undefined) m.auint64 = __longFromString(o.auint64, true);
+    if (o.asint32 !== undefined) m.asint32 = o.asint32;
+    if (o.asint64 !== undefined) m.asint64 = __longFromString(o.asint64, false);
+    if (o.afixed32 !== undefined) m.afixed32 = o.afixed32;
+    if (o.afixed64 !== undefined) m.afixed64 = __longFromString(o.afixed64, true);
+    if (o.asfixed32 !== undefined) m.asfixed32 = o.asfixed32;
+    if (o.asfixed64 !== undefined) m.asfixed64 = __longFromString(o.asfixed64, false);
+    if (o.abool !== undefined) m.abool = o.abool;
+    if (o.astring !== undefined) m.astring = o.astring;
+    if (o.abytes !== undefined) m.abytes = __pb__.Internal.bytesFromJSON(o.abytes);
+    if (o.aenum1 !== undefined) m.aenum1 = o.aenum1;
+    if (o.aenum2 !== undefined) m.aenum2 = o.aenum2;
+    if (o.aenum22 !== undefined) m.aenum22 = o.aenum22;
+    if (o.manystring !== undefined) m.manystring = o.manystring.slice();
+    if (o.manyint64 !== undefined) m.manyint64 = o.manyint64.map(v => __longFromString(v, false));
+    if (o.aexample2 !== undefined) m.aexample2 = example1.example2.fromObject(o.aexample2);
+    if (o.aexample22 !== undefined) m.aexample22 = example2.fromObject(o.aexample22);
+    if (o.aexample23 !== undefined) m.aexample23 = ___example2_pb.example2.fromObject(o.aexample23);
+    if (o.amap !== undefined) {
+      const obj = o.amap;
+      for (const k of Object.keys(obj)) {
+        m.amap.set(k, obj[k]);
+      }
+    }
+    if (o.amap2 !== undefined) {
+      const obj = o.amap2;
+      for (const k of Object.keys(obj)) {
+        m.amap2.set(k, ___example2_pb.example2.fromObject(obj[k]));
+      }
+    }
+    if (o.outoforder !== undefined) m.outoforder = __longFromString(o.outoforder, false);
+    if (o.oostring !== undefined) m.aoneof = new example1.aoneof.oostring(o.oostring);
+    if (o.ooint !== undefined) m.aoneof = new example1.aoneof.ooint(o.ooint);
+    if (o.longmap !== undefined) {
+      const obj = o.longmap;
+      for (const k of Object.keys(obj)) {
+        m.longmap.set(k, obj[k]);
+      }
+    }
+    if (o.anany !== undefined) m.anany = ___google_protobuf_any_pb.Any.fromObject(o.anany);
+    return m;
+  }
+
+  // equals reports whether other holds the same values as the message.
+  equals(other: example1): boolean {
+    if (this === other) return true;
+    if (!__pb__.Internal.floatEqual(this.adouble, other.adouble)) return false;
+    if (!__pb__.Internal.floatEqual(this.afloat, other.afloat)) return false;
+    if (this.aint32 !== other.aint32) return false;
+    if (!this.aint64.equals(other.aint64)) return false;
+    if (this.auint32 !== other.auint32) return false;
+    if (!this.auint64.equals(other.auint64)) return false;
+    if (this.asint32 !== other.asint32) return false;
+    if (!this.asint64.equals(other.asint64)) return false;
+    if (this.afixed32 !== other.afixed32) return false;
+    if (!this.afixed64.equals(other.afixed64)) return false;
+    if (this.asfixed32 !== other.asfixed32) return false;
+    if (!this.asfixed64.equals(other.asfixed64)) return false;
+    if (this.abool !== other.abool) return false;
+    if (this.astring !== other.astring) return false;
+    if (!__pb__.Internal.bytesEqual(this.abytes, other.abytes)) return false;
+    if (this.aenum1 !== other.aenum1) return false;
+    if (this.aenum2 !== other.aenum2) return false;
+    if (this.aenum22 !== other.aenum22) return false;
+    if (!__pb__.Internal.arrayEqual(this.manystring, other.manystring)) return false;
+    if (!__pb__.Internal.arrayEqual(this.manyint64, other.manyint64, (x, y) => x.equals(y))) return false;
+    if (!__pb__.Internal.optionalEqual(this.aexample2, other.aexample2, (x, y) => x.equals(y))) return false;
+    if (!__pb__.Internal.optionalEqual(this.aexample22, other.aexample22, (x, y) => x.equals(y))) return false;
+    if (!__pb__.Internal.optionalEqual(this.aexample23, other.aexample23, (x, y) => x.equals(y))) return false;
+    if (!__pb__.Internal.mapEqual(this.amap, other.amap)) return false;
+    if (!__pb__.Internal.mapEqual(this.amap2, other.amap2, (x, y) => x.equals(y))) return false;
+    if (!this.outoforder.equals(other.outoforder)) return false;
+    if (!__pb__.Internal.mapEqual(this.longmap, other.longmap)) return false;
+    if (!__pb__.Internal.optionalEqual(this.anany, other.anany, (x, y) => x.equals(y))) return false;
+    if (this.aoneof.kind !== other.aoneof.kind) return false;
+    if (this.aoneof instanceof example1.aoneof.oostring && other.aoneof instanceof example1.aoneof.oostring && this.aoneof.value !== other.aoneof.value) return false;
+    if (this.aoneof instanceof example1.aoneof.ooint && other.aoneof instanceof example1.aoneof.ooint && this.aoneof.value !== other.aoneof.value) return false;
+    if (!__pb__.Internal.unknownEqual(this.unknownFields, other.unknownFields)) return false;
+    return true;
+  }
+
+  // clone returns a deep copy of the message.
+  clone(): example1 {
+    const m = new example1();
+    m.adouble = this.adouble;
+    m.afloat = this.afloat;
+    m.aint32 = this.aint32;
+    m.aint64 = this.aint64;
+    m.auint32 = this.auint32;
+    m.auint64 = this.auint64;
+    m.asint32 = this.asint32;
+    m.asint64 = this.asint64;
+    m.afixed32 = this.afixed32;
+    m.afixed64 = this.afixed64;
+    m.asfixed32 = this.asfixed32;
+    m.asfixed64 = this.asfixed64;
+    m.abool = this.abool;
+    m.astring = this.astring;
+    m.abytes = this.abytes.slice();
+    m.aenum1 = this.aenum1;
+    m.aenum2 = this.aenum2;
+    m.aenum22 = this.aenum22;
+    m.manystring = this.manystring.slice();
+    m.manyint64 = this.manyint64.slice();
+    m.aexample2 = this.aexample2 == null ? null : this.aexample2.clone();
+    m.aexample22 = this.aexample22 == null ? null : this.aexample22.clone();
+    m.aexample23 = this.aexample23 == null ? null : this.aexample23.clone();
+    for (const [k, v] of this.amap) {
+      m.amap.set(k, v);
+    }
+    for (const [k, v] of this.amap2) {
+      m.amap2.set(k, v.clone());
+    }
+    m.outoforder = this.outoforder;
+    for (const [k, v] of this.longmap) {
+      m.longmap.set(k, v);
+    }
+    m.anany = this.anany == null ? null : this.anany.clone();
+    if (this.aoneof instanceof example1.aoneof.oostring) m.aoneof = new example1.aoneof.oostring(this.aoneof.value);
+    if (this.aoneof instanceof example1.aoneof.ooint) m.aoneof = new example1.aoneof.ooint(this.aoneof.value);
+    m.unknownFields = this.unknownFields.map(u => u.slice());
+    return m;
+  }
+
+  // hashCode returns a hash of the message's values, which is equal for
+  // equal messages and stable across runs.
+  hashCode(): number {
+    let h = 0;
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashNumber(this.adouble));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashNumber(this.afloat));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashNumber(this.aint32));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashLong(this.aint64));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashNumber(this.auint32));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashLong(this.auint64));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashNumber(this.asint32));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashLong(this.asint64));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashNumber(this.afixed32));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashLong(this.afixed64));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashNumber(this.asfixed32));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashLong(this.asfixed64));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashBool(this.abool));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashString(this.astring));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashBytes(this.abytes));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashNumber(this.aenum1));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashNumber(this.aenum2));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashNumber(this.aenum22));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashArray(this.manystring, __pb__.Internal.hashString));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashArray(this.manyint64, __pb__.Internal.hashLong));
+    h = __pb__.Internal.hashCombine(h, this.aexample2 == null ? 0 : this.aexample2.hashCode());
+    h = __pb__.Internal.hashCombine(h, this.aexample22 == null ? 0 : this.aexample22.hashCode());
+    h = __pb__.Internal.hashCombine(h, this.aexample23 == null ? 0 : this.aexample23.hashCode());
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashMap(this.amap, __pb__.Internal.hashString, __pb__.Internal.hashString));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashMap(this.amap2, __pb__.Internal.hashString, v => v.hashCode()));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashLong(this.outoforder));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashMap(this.longmap, __pb__.Internal.hashString, __pb__.Internal.hashString));
+    h = __pb__.Internal.hashCombine(h, this.anany == null ? 0 : this.anany.hashCode());
+    h = __pb__.Internal.hashCombine(h, this.aoneof.kind);
+    if (this.aoneof instanceof example1.aoneof.oostring) h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashString(this.aoneof.value));
+    if (this.aoneof instanceof example1.aoneof.ooint) h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashNumber(this.aoneof.value));
+    return h;
   }
 }
 
@@ -396,18 +1190,72 @@ export namespace example1.aoneof {
 }
 
 export namespace example1 {
+  /**
+   * Enums
+   */
   export const enum AEnum2 {
     C = 0,
     D = 10,
   }
+
+  export function AEnum2ToJSON(v: AEnum2): string | number {
+    switch (v as number) {
+      case 0:
+      return "C";
+      case 10:
+      return "D";
+    }
+    return v;
+  }
+
+  export function AEnum2FromJSON(v: __pb__.JsonValue, o: __pb__.JsonOptions = {}): AEnum2 | undefined {
+    switch (v) {
+      case "C":
+      return AEnum2.C;
+      case "D":
+      return AEnum2.D;
+    }
+    return __pb__.Internal.enumFromJSON(v, o);
+  }
+
+  export const AEnum2Info: __pb__.EnumInfo = {
+    typeName: "foo.bar.example1.AEnum2",
+    values: [
+      { name: "C", number: 0 },
+      { name: "D", number: 10 },
+    ],
+  };
 }
 
 export namespace example1 {
+  export interface example2Init {
+    astring?: string;
+  }
+
+  export interface Iexample2 {
+    astring?: string;
+  }
+
+  /**
+   * Nested Messages / namespace test.
+   */
   export class example2 implements __pb__.Message {
+    static readonly typeName = "foo.bar.example1.example2";
+
+    static readonly fields: __pb__.FieldInfo[] = [
+      { name: "astring", number: 1, type: __pb__.FieldType.STRING, label: __pb__.FieldLabel.OPTIONAL, jsonName: "astring", member: "astring" },
+    ];
+
     astring: string;
+    // The encoding of fields which were not recognized when decoding.
+    unknownFields: Uint8Array[];
 
-    constructor() {
+    constructor(init?: example2Init) {
       this.astring = "";
+      this.unknownFields = [];
+      if (init !== undefined) {
+        if (init.astring !== undefined) this.astring = init.astring;
+      }
     }
 
     MergeFrom(d: __pb__.Internal.Decoder): void {
@@ -415,10 +1263,10 @@ export namespace example1 {
         let [fn, wt] = d.readTag();
         switch(fn) {
           case 1:
-          this.astring = d.readString();
+          this.astring = d.readValidString();
           break;
           default:
-          d.skipWireType(wt)
+          this.unknownFields.push(d.readUnknown(wt, fn));
         }
       }
     }
@@ -428,18 +1276,106 @@ export namespace example1 {
         e.writeTag(1, 2);
         e.writeString(this.astring);
       }
+      e.writeUnknown(this.unknownFields);
+    }
+
+    MergeFromJSON(j: __pb__.JsonValue, o: __pb__.JsonOptions = {}): void {
+      const obj = __pb__.Internal.objectFromJSON(j);
+      for (const k in obj) {
+        const v = obj[k];
+        if (v === null) {
+          continue;
+        }
+        switch (k) {
+          case "astring":
+          this.astring = __pb__.Internal.stringFromJSON(v);
+          break;
+          default:
+          __pb__.Internal.unknownFieldFromJSON(k, o);
+        }
+      }
+    }
+
+    ToJSON(o: __pb__.JsonOptions = {}): __pb__.JsonValue {
+      const j: __pb__.JsonObject = {};
+      if (o.emitDefaults || this.astring != "") {
+        j["astring"] = this.astring;
+      }
+      return j;
+    }
+
+    // toObject returns the message as a plain object, holding no classes.
+    toObject(): Iexample2 {
+      const o: Iexample2 = {};
+      o.astring = this.astring;
+      return o;
+    }
+
+    // fromObject returns a message from its plain object form.
+    static fromObject(o: Iexample2): example2 {
+      const m = new example2();
+      if (o.astring !== undefined) m.astring = o.astring;
+      return m;
+    }
+
+    // equals reports whether other holds the same values as the message.
+    equals(other: example2): boolean {
+      if (this === other) return true;
+      if (this.astring !== other.astring) return false;
+      if (!__pb__.Internal.unknownEqual(this.unknownFields, other.unknownFields)) return false;
+      return true;
+    }
+
+    // clone returns a deep copy of the message.
+    clone(): example2 {
+      const m = new example2();
+      m.astring = this.astring;
+      m.unknownFields = this.unknownFields.map(u => u.slice());
+      return m;
+    }
+
+    // hashCode returns a hash of the message's values, which is equal for
+    // equal messages and stable across runs.
+    hashCode(): number {
+      let h = 0;
+      h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashString(this.astring));
+      return h;
     }
   }
 }
 
 export namespace example1 {
+  export interface AmapEntryInit {
+    key?: string;
+    value?: string;
+  }
+
+  export interface IAmapEntry {
+    key?: string;
+    value?: string;
+  }
+
   export class AmapEntry implements __pb__.Message {
+    static readonly typeName = "foo.bar.example1.AmapEntry";
+
+    static readonly fields: __pb__.FieldInfo[] = [
+      { name: "key", number: 1, type: __pb__.FieldType.STRING, label: __pb__.FieldLabel.OPTIONAL, jsonName: "key", member: "key" },
+      { name: "value", number: 2, type: __pb__.FieldType.STRING, label: __pb__.FieldLabel.OPTIONAL, jsonName: "value", member: "value" },
+    ];
+
     key: string;
     value: string;
+    // The encoding of fields which were not recognized when decoding.
+    unknownFields: Uint8Array[];
 
-    constructor() {
+    constructor(init?: AmapEntryInit) {
       this.key = "";
       this.value = "";
+      this.unknownFields = [];
+      if (init !== undefined) {
+        if (init.key !== undefined) this.key = init.key;
+        if (init.value !== undefined) this.value = init.value;
+      }
     }
 
     MergeFrom(d: __pb__.Internal.Decoder): void {
@@ -447,13 +1383,13 @@ export namespace example1 {
         let [fn, wt] = d.readTag();
         switch(fn) {
           case 1:
-          this.key = d.readString();
+          this.key = d.readValidString();
           break;
           case 2:
-          this.value = d.readString();
+          this.value = d.readValidString();
           break;
           default:
-          d.skipWireType(wt)
+          this.unknownFields.push(d.readUnknown(wt, fn));
         }
       }
     }
@@ -467,18 +1403,117 @@ export namespace example1 {
         e.writeTag(2, 2);
         e.writeString(this.value);
       }
+      e.writeUnknown(this.unknownFields);
+    }
+
+    MergeFromJSON(j: __pb__.JsonValue, o: __pb__.JsonOptions = {}): void {
+      const obj = __pb__.Internal.objectFromJSON(j);
+      for (const k in obj) {
+        const v = obj[k];
+        if (v === null) {
+          continue;
+        }
+        switch (k) {
+          case "key":
+          this.key = __pb__.Internal.stringFromJSON(v);
+          break;
+          case "value":
+          this.value = __pb__.Internal.stringFromJSON(v);
+          break;
+          default:
+          __pb__.Internal.unknownFieldFromJSON(k, o);
+        }
+      }
+    }
+
+    ToJSON(o: __pb__.JsonOptions = {}): __pb__.JsonValue {
+      const j: __pb__.JsonObject = {};
+      if (o.emitDefaults || this.key != "") {
+        j["key"] = this.key;
+      }
+      if (o.emitDefaults || this.value != "") {
+        j["value"] = this.value;
+      }
+      return j;
+    }
+
+    // toObject returns the message as a plain object, holding no classes.
+    toObject(): IAmapEntry {
+      const o: IAmapEntry = {};
+      o.key = this.key;
+      o.value = this.value;
+      return o;
+    }
+
+    // fromObject returns a message from its plain object form.
+    static fromObject(o: IAmapEntry): AmapEntry {
+      const m = new AmapEntry();
+      if (o.key !== undefined) m.key = o.key;
+      if (o.value !== undefined) m.value = o.value;
+      return m;
+    }
+
+    // equals reports whether other holds the same values as the message.
+    equals(other: AmapEntry): boolean {
+      if (this === other) return true;
+      if (this.key !== other.key) return false;
+      if (this.value !== other.value) return false;
+      if (!__pb__.Internal.unknownEqual(this.unknownFields, other.unknownFields)) return false;
+      return true;
+    }
+
+    // clone returns a deep copy of the message.
+    clone(): AmapEntry {
+      const m = new AmapEntry();
+      m.key = this.key;
+      m.value = this.value;
+      m.unknownFields = this.unknownFields.map(u => u.slice());
+      return m;
+    }
+
+    // hashCode returns a hash of the message's values, which is equal for
+    // equal messages and stable across runs.
+    hashCode(): number {
+      let h = 0;
+      h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashString(this.key));
+      h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashString(this.value));
+      return h;
     }
   }
 }
 
 export namespace example1 {
+  export interface Amap2EntryInit {
+    key?: string;
+    value?: ___example2_pb.example2 | ___example2_pb.example2Init;
+  }
+
+  export interface IAmap2Entry {
+    key?: string;
+    value?: ___example2_pb.Iexample2;
+  }
+
   export class Amap2Entry implements __pb__.Message {
+    static readonly typeName = "foo.bar.example1.Amap2Entry";
+
+    static readonly fields: __pb__.FieldInfo[] = [
+      { name: "key", number: 1, type: __pb__.FieldType.STRING, label: __pb__.FieldLabel.OPTIONAL, jsonName: "key", member: "key" },
+      { name: "value", number: 2, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.OPTIONAL, jsonName: "value", member: "value", messageType: () => ___example2_pb.example2 },
+    ];
+
     key: string;
     value: ___example2_pb.example2 | null;
+    // The encoding of fields which were not recognized when decoding.
+    unknownFields: Uint8Array[];
 
-    constructor() {
+    constructor(init?: Amap2EntryInit) {
       this.key = "";
       this.value = null;
+      this.unknownFields = [];
+      if (init !== undefined) {
+        if (init.key !== undefined) this.key = init.key;
+        if (init.value !== undefined) this.value = __pb__.Internal.fromInit(___example2_pb.example2, init.value);
+      }
     }
 
     MergeFrom(d: __pb__.Internal.Decoder): void {
@@ -486,14 +1521,14 @@ export namespace example1 {
         let [fn, wt] = d.readTag();
         switch(fn) {
           case 1:
-          this.key = d.readString();
+          this.key = d.readValidString();
           break;
           case 2:
           if (this.value == null) this.value = new ___example2_pb.example2();
           this.value.MergeFrom(d.readDecoder());
           break;
           default:
-          d.skipWireType(wt)
+          this.unknownFields.push(d.readUnknown(wt, fn));
         }
       }
     }
@@ -508,21 +1543,124 @@ export namespace example1 {
         if (msg != null) {
           let nested = new __pb__.Internal.Encoder();
           msg.WriteTo(nested);
-          e.writeEncoder(nested, 2)
+          e.writeEncoder(nested, 2);
+        }
+      }
+      e.writeUnknown(this.unknownFields);
+    }
+
+    MergeFromJSON(j: __pb__.JsonValue, o: __pb__.JsonOptions = {}): void {
+      const obj = __pb__.Internal.objectFromJSON(j);
+      for (const k in obj) {
+        const v = obj[k];
+        if (v === null) {
+          continue;
+        }
+        switch (k) {
+          case "key":
+          this.key = __pb__.Internal.stringFromJSON(v);
+          break;
+          case "value":
+          if (this.value == null) this.value = new ___example2_pb.example2();
+          this.value.MergeFromJSON(v, o);
+          break;
+          default:
+          __pb__.Internal.unknownFieldFromJSON(k, o);
         }
       }
     }
+
+    ToJSON(o: __pb__.JsonOptions = {}): __pb__.JsonValue {
+      const j: __pb__.JsonObject = {};
+      if (o.emitDefaults || this.key != "") {
+        j["key"] = this.key;
+      }
+      if (o.emitDefaults || this.value != null) {
+        const msg = this.value;
+        j["value"] = msg == null ? null : msg.ToJSON(o);
+      }
+      return j;
+    }
+
+    // toObject returns the message as a plain object, holding no classes.
+    toObject(): IAmap2Entry {
+      const o: IAmap2Entry = {};
+      o.key = this.key;
+      if (this.value != null) {
+        o.value = this.value.toObject();
+      }
+      return o;
+    }
+
+    // fromObject returns a message from its plain object form.
+    static fromObject(o: IAmap2Entry): Amap2Entry {
+      const m = new Amap2Entry();
+      if (o.key !== undefined) m.key = o.key;
+      if (o.value !== undefined) m.value = ___example2_pb.example2.fromObject(o.value);
+      return m;
+    }
+
+    // equals reports whether other holds the same values as the message.
+    equals(other: Amap2Entry): boolean {
+      if (this === other) return true;
+      if (this.key !== other.key) return false;
+      if (!__pb__.Internal.optionalEqual(this.value, other.value, (x, y) => x.equals(y))) return false;
+      if (!__pb__.Internal.unknownEqual(this.unknownFields, other.unknownFields)) return false;
+      return true;
+    }
+
+    // clone returns a deep copy of the message.
+    clone(): Amap2Entry {
+      const m = new Amap2Entry();
+      m.key = this.key;
+      m.value = this.value == null ? null : this.value.clone();
+      m.unknownFields = this.unknownFields.map(u => u.slice());
+      return m;
+    }
+
+    // hashCode returns a hash of the message's values, which is equal for
+    // equal messages and stable across runs.
+    hashCode(): number {
+      let h = 0;
+      h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashString(this.key));
+      h = __pb__.Internal.hashCombine(h, this.value == null ? 0 : this.value.hashCode());
+      return h;
+    }
   }
 }
 
 export namespace example1 {
+  export interface LongmapEntryInit {
+    key?: __long;
+    value?: string;
+  }
+
+  export interface ILongmapEntry {
+    key?: string;
+    value?: string;
+  }
+
   export class LongmapEntry implements __pb__.Message {
+    static readonly typeName = "foo.bar.example1.LongmapEntry";
+
+    static readonly fields: __pb__.FieldInfo[] = [
+      { name: "key", number: 1, type: __pb__.FieldType.INT64, label: __pb__.FieldLabel.OPTIONAL, jsonName: "key", member: "key" },
+      { name: "value", number: 2, type: __pb__.FieldType.STRING, label: __pb__.FieldLabel.OPTIONAL, jsonName: "value", member: "value" },
+    ];
+
     key: __long;
     value: string;
+    // The encoding of fields which were not recognized when decoding.
+    unknownFields: Uint8Array[];
 
-    constructor() {
+    constructor(init?: LongmapEntryInit) {
       this.key = __long.ZERO;
       this.value = "";
+      this.unknownFields = [];
+      if (init !== undefined) {
+        if (init.key !== undefined) this.key = init.key;
+        if (init.value !== undefined) this.value = init.value;
+      }
     }
 
     MergeFrom(d: __pb__.Internal.Decoder): void {
@@ -533,10 +1671,10 @@ export namespace example1 {
           this.key = d.readVarintSigned();
           break;
           case 2:
-          this.value = d.readString();
+          this.value = d.readValidString();
           break;
           default:
-          d.skipWireType(wt)
+          this.unknownFields.push(d.readUnknown(wt, fn));
         }
       }
     }
@@ -550,10 +1688,123 @@ export namespace example1 {
         e.writeTag(2, 2);
         e.writeString(this.value);
       }
+      e.writeUnknown(this.unknownFields);
+    }
+
+    MergeFromJSON(j: __pb__.JsonValue, o: __pb__.JsonOptions = {}): void {
+      const obj = __pb__.Internal.objectFromJSON(j);
+      for (const k in obj) {
+        const v = obj[k];
+        if (v === null) {
+          continue;
+        }
+        switch (k) {
+          case "key":
+          this.key = __pb__.Internal.int64FromJSON(v);
+          break;
+          case "value":
+          this.value = __pb__.Internal.stringFromJSON(v);
+          break;
+          default:
+          __pb__.Internal.unknownFieldFromJSON(k, o);
+        }
+      }
+    }
+
+    ToJSON(o: __pb__.JsonOptions = {}): __pb__.JsonValue {
+      const j: __pb__.JsonObject = {};
+      if (o.emitDefaults || !this.key.isZero()) {
+        j["key"] = this.key.toString();
+      }
+      if (o.emitDefaults || this.value != "") {
+        j["value"] = this.value;
+      }
+      return j;
+    }
+
+    // toObject returns the message as a plain object, holding no classes.
+    toObject(): ILongmapEntry {
+      const o: ILongmapEntry = {};
+      o.key = this.key.toString();
+      o.value = this.value;
+      return o;
+    }
+
+    // fromObject returns a message from its plain object form.
+    static fromObject(o: ILongmapEntry): LongmapEntry {
+      const m = new LongmapEntry();
+      if (o.key !== undefined) m.key = __longFromString(o.key, false);
+      if (o.value !== undefined) m.value = o.value;
+      return m;
+    }
+
+    // equals reports whether other holds the same values as the message.
+    equals(other: LongmapEntry): boolean {
+      if (this === other) return true;
+      if (!this.key.equals(other.key)) return false;
+      if (this.value !== other.value) return false;
+      if (!__pb__.Internal.unknownEqual(this.unknownFields, other.unknownFields)) return false;
+      return true;
+    }
+
+    // clone returns a deep copy of the message.
+    clone(): LongmapEntry {
+      const m = new LongmapEntry();
+      m.key = this.key;
+      m.value = this.value;
+      m.unknownFields = this.unknownFields.map(u => u.slice());
+      return m;
+    }
+
+    // hashCode returns a hash of the message's values, which is equal for
+    // equal messages and stable across runs.
+    hashCode(): number {
+      let h = 0;
+      h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashLong(this.key));
+      h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashString(this.value));
+      return h;
     }
   }
 }
 
+export const ExampleServiceDescriptor = {
+  typeName: "foo.bar.ExampleService",
+  methods: {
+    OneToTwo: new __pb__.Grpc.MethodDescriptor({
+      service: "foo.bar.ExampleService",
+      name: "OneToTwo",
+      input: example1,
+      output: example2,
+      kind: __pb__.Grpc.MethodKind.Unary,
+      idempotency: __pb__.Grpc.IdempotencyLevel.NoSideEffects,
+    }),
+    ServerStream: new __pb__.Grpc.MethodDescriptor({
+      service: "foo.bar.ExampleService",
+      name: "ServerStream",
+      input: example1,
+      output: example2,
+      kind: __pb__.Grpc.MethodKind.ServerStreaming,
+      idempotency: __pb__.Grpc.IdempotencyLevel.IdempotencyUnknown,
+    }),
+    ClientStream: new __pb__.Grpc.MethodDescriptor({
+      service: "foo.bar.ExampleService",
+      name: "ClientStream",
+      input: example1,
+      output: example2,
+      kind: __pb__.Grpc.MethodKind.ClientStreaming,
+      idempotency: __pb__.Grpc.IdempotencyLevel.IdempotencyUnknown,
+    }),
+    Bidi: new __pb__.Grpc.MethodDescriptor({
+      service: "foo.bar.ExampleService",
+      name: "Bidi",
+      input: example1,
+      output: example2,
+      kind: __pb__.Grpc.MethodKind.BidiStreaming,
+      idempotency: __pb__.Grpc.IdempotencyLevel.IdempotencyUnknown,
+    }),
+  },
+};
+
 export class ExampleServiceClient {
   private cc: __pb__.Grpc.ClientConn;
   constructor(cc: __pb__.Grpc.ClientConn) {
@@ -562,7 +1813,152 @@ export class ExampleServiceClient {
 
   async OneToTwo(min: example1, ...co: __pb__.Grpc.CallOption[]): Promise<example2> {
     let mout = new example2();
-    await this.cc.Invoke('/foo.bar.ExampleService/OneToTwo', min, mout, ...co);
+    await this.cc.Invoke('/foo.bar.ExampleService/OneToTwo', min, mout, ...co, ExampleServiceDescriptor.methods.OneToTwo);
+    return mout;
+  }
+
+  ServerStream(min: example1, ...co: __pb__.Grpc.CallOption[]): AsyncIterable<example2> {
+    if (this.cc.ServerStream === undefined) {
+      throw new __pb__.Grpc.GrpcError(__pb__.Grpc.Code.Unimplemented, "the ClientConn does not support server streaming");
+    }
+    return this.cc.ServerStream('/foo.bar.ExampleService/ServerStream', min, () => new example2(), ...co, ExampleServiceDescriptor.methods.ServerStream);
+  }
+
+  async ClientStream(mins: AsyncIterable<example1>, ...co: __pb__.Grpc.CallOption[]): Promise<example2> {
+    if (this.cc.ClientStream === undefined) {
+      throw new __pb__.Grpc.GrpcError(__pb__.Grpc.Code.Unimplemented, "the ClientConn does not support client streaming");
+    }
+    let mout = new example2();
+    await this.cc.ClientStream('/foo.bar.ExampleService/ClientStream', mins, mout, ...co, ExampleServiceDescriptor.methods.ClientStream);
+    return mout;
+  }
+
+  Bidi(...co: __pb__.Grpc.CallOption[]): __pb__.Grpc.BidiStream<example1, example2> {
+    if (this.cc.BidiStream === undefined) {
+      throw new __pb__.Grpc.GrpcError(__pb__.Grpc.Code.Unimplemented, "the ClientConn does not support bidirectional streaming");
+    }
+    return this.cc.BidiStream<example1, example2>('/foo.bar.ExampleService/Bidi', () => new example2(), ...co, ExampleServiceDescriptor.methods.Bidi);
+  }
+}
+
+export interface ExampleServiceServer {
+  OneToTwo(min: example1): Promise<example2>;
+  ServerStream(min: example1): AsyncIterable<example2>;
+  ClientStream(mins: AsyncIterable<example1>): Promise<example2>;
+  Bidi(mins: AsyncIterable<example1>): AsyncIterable<example2>;
+}
+
+export function ExampleServiceDispatcher(impl: ExampleServiceServer): __pb__.Grpc.Dispatcher {
+  return new __pb__.Grpc.Dispatcher([
+    __pb__.Grpc.unaryMethod(ExampleServiceDescriptor.methods.OneToTwo, min => impl.OneToTwo(min)),
+    __pb__.Grpc.serverStreamMethod(ExampleServiceDescriptor.methods.ServerStream, min => impl.ServerStream(min)),
+    __pb__.Grpc.clientStreamMethod(ExampleServiceDescriptor.methods.ClientStream, mins => impl.ClientStream(mins)),
+    __pb__.Grpc.bidiStreamMethod(ExampleServiceDescriptor.methods.Bidi, mins => impl.Bidi(mins)),
+  ]);
+}
+
+export class ExampleServiceConnectClient {
+  private cc: __pb__.Connect.Transport;
+  constructor(baseUrl: string, options?: __pb__.Connect.Options) {
+    this.cc = new __pb__.Connect.Transport(baseUrl, options);
+  }
+
+  async OneToTwo(min: example1, ...co: __pb__.Grpc.CallOption[]): Promise<example2> {
+    let mout = new example2();
+    await this.cc.Invoke('/foo.bar.ExampleService/OneToTwo', min, mout, ...co, ExampleServiceDescriptor.methods.OneToTwo);
     return mout;
   }
+
+  ServerStream(min: example1, ...co: __pb__.Grpc.CallOption[]): AsyncIterable<example2> {
+    return this.cc.ServerStream('/foo.bar.ExampleService/ServerStream', min, () => new example2(), ...co, ExampleServiceDescriptor.methods.ServerStream);
+  }
 }
+
+// fileDescriptor is the google.protobuf.FileDescriptorProto of example1.proto.
+export const fileDescriptor = new __pb__.FileDescriptor(
+  "example1.proto",
+  "Cg5leGFtcGxlMS5wcm90bxIHZm9vLmJhchoZZ29vZ2xlL3Byb3RvYnVmL2FueS5wcm90bxoOZXhh" +
+    "bXBsZTIucHJvdG8iIgoIZXhhbXBsZTISFgoGYWludDMyGAEgASgFUgZhaW50MzIinwoKCGV4YW1w" +
+    "bGUxEhgKB2Fkb3VibGUYASABKAFSB2Fkb3VibGUSFgoGYWZsb2F0GAIgASgCUgZhZmxvYXQSFgoG" +
+    "YWludDMyGAMgASgFUgZhaW50MzISFgoGYWludDY0GAQgASgDUgZhaW50NjQSGAoHYXVpbnQzMhgF" +
+    "IAEoDVIHYXVpbnQzMhIYCgdhdWludDY0GAYgASgEUgdhdWludDY0EhgKB2FzaW50MzIYByABKBFS" +
+    "B2FzaW50MzISGAoHYXNpbnQ2NBgIIAEoElIHYXNpbnQ2NBIaCghhZml4ZWQzMhgJIAEoB1IIYWZp" +
+    "eGVkMzISGgoIYWZpeGVkNjQYCiABKAZSCGFmaXhlZDY0EhwKCWFzZml4ZWQzMhgLIAEoD1IJYXNm" +
+    "aXhlZDMyEhwKCWFzZml4ZWQ2NBgMIAEoEFIJYXNmaXhlZDY0EhQKBWFib29sGA0gASgIUgVhYm9v" +
+    "bBIYCgdhc3RyaW5nGA4gASgJUgdhc3RyaW5nEhYKBmFieXRlcxgPIAEoDFIGYWJ5dGVzEicKBmFl" +
+    "bnVtMRgUIAEoDjIPLmZvby5iYXIuQUVudW0xUgZhZW51bTESMAoGYWVudW0yGBUgASgOMhguZm9v" +
+    "LmJhci5leGFtcGxlMS5BRW51bTJSBmFlbnVtMhIpCgdhZW51bTIyGBYgASgOMg8uZml6LmJhei5B" +
+    "RW51bTJSB2FlbnVtMjISHgoKbWFueXN0cmluZxgeIAMoCVIKbWFueXN0cmluZxIcCgltYW55aW50" +
+    "NjQYHyADKANSCW1hbnlpbnQ2NBI4CglhZXhhbXBsZTIYKCABKAsyGi5mb28uYmFyLmV4YW1wbGUx" +
+    "LmV4YW1wbGUyUglhZXhhbXBsZTISMQoKYWV4YW1wbGUyMhgpIAEoCzIRLmZvby5iYXIuZXhhbXBs" +
+    "ZTJSCmFleGFtcGxlMjISMQoKYWV4YW1wbGUyMxgqIAEoCzIRLmZpei5iYXouZXhhbXBsZTJSCmFl" +
+    "eGFtcGxlMjMSLwoEYW1hcBgzIAMoCzIbLmZvby5iYXIuZXhhbXBsZTEuQW1hcEVudHJ5UgRhbWFw" +
+    "EjIKBWFtYXAyGDQgAygLMhwuZm9vLmJhci5leGFtcGxlMS5BbWFwMkVudHJ5UgVhbWFwMhIeCgpv" +
+    "dXRvZm9yZGVyGDEgASgDUgpvdXRvZm9yZGVyEhwKCG9vc3RyaW5nGDwgASgJSABSCG9vc3RyaW5n" +
+    "EhYKBW9vaW50GD0gASgFSABSBW9vaW50EjgKB2xvbmdtYXAYPiADKAsyHi5mb28uYmFyLmV4YW1w" +
+    "bGUxLkxvbmdtYXBFbnRyeVIHbG9uZ21hcBIqCgVhbmFueRhQIAEoCzIULmdvb2dsZS5wcm90b2J1" +
+    "Zi5BbnlSBWFuYW55GiQKCGV4YW1wbGUyEhgKB2FzdHJpbmcYASABKAlSB2FzdHJpbmcaNwoJQW1h" +
+    "cEVudHJ5EhAKA2tleRgBIAEoCVIDa2V5EhQKBXZhbHVlGAIgASgJUgV2YWx1ZToCOAEaSwoKQW1h" +
+    "cDJFbnRyeRIQCgNrZXkYASABKAlSA2tleRInCgV2YWx1ZRgCIAEoCzIRLmZpei5iYXouZXhhbXBs" +
+    "ZTJSBXZhbHVlOgI4ARo6CgxMb25nbWFwRW50cnkSEAoDa2V5GAEgASgDUgNrZXkSFAoFdmFsdWUY" +
+    "AiABKAlSBXZhbHVlOgI4ASIWCgZBRW51bTISBQoBQxAAEgUKAUQQCkIICgZhb25lb2YqFgoGQUVu" +
+    "dW0xEgUKAUEQABIFCgFCEAIy7wEKDkV4YW1wbGVTZXJ2aWNlEjUKCE9uZVRvVHdvEhEuZm9vLmJh" +
+    "ci5leGFtcGxlMRoRLmZvby5iYXIuZXhhbXBsZTIiA5ACARI4CgxTZXJ2ZXJTdHJlYW0SES5mb28u" +
+    "YmFyLmV4YW1wbGUxGhEuZm9vLmJhci5leGFtcGxlMiIAMAESOAoMQ2xpZW50U3RyZWFtEhEuZm9v" +
+    "LmJhci5leGFtcGxlMRoRLmZvby5iYXIuZXhhbXBsZTIiACgBEjIKBEJpZGkSES5mb28uYmFyLmV4" +
+    "YW1wbGUxGhEuZm9vLmJhci5leGFtcGxlMiIAKAEwAUrHFAoGEgQAAE4BCggKAQwSAwAAEgoICgEC" +
+    "EgMCABAKCQoCAwASAwQAIwoJCgIDARIDBQAYCgoKAgUAEgQHAAoBCgoKAwUAARIDBwULCgsKBAUA" +
+    "AgASAwgCCAoMCgUFAAIAARIDCAIDCgwKBQUAAgACEgMIBgcKCwoEBQACARIDCQIICgwKBQUAAgEB" +
+    "EgMJAgMKDAoFBQACAQISAwkGBwo/CgIEABIEDQAPARozIEludGVudGlvbmFsbHksIHNhbWUgYXMg" +
+    "YmVsb3cgdG8gdGVzdCBuYW1lc3BhY2luZy4KCgoKAwQAARIDDQgQCgsKBAQAAgASAw4CEwoMCgUE" +
+    "AAIABRIDDgIHCgwKBQQAAgABEgMOCA4KDAoFBAACAAMSAw4REgoKCgIEARIEEQBFAQoKCgMEAQES" +
+    "AxEIEAoXCgQEAQIAEgMTAhUaCiBTY2FsYXJzLgoKDAoFBAECAAUSAxMCCAoMCgUEAQIAARIDEwkQ" +
+    "CgwKBQQBAgADEgMTExQKCwoEBAECARIDFAITCgwKBQQBAgEFEgMUAgcKDAoFBAECAQESAxQIDgoM" +
+    "CgUEAQIBAxIDFBESCgsKBAQBAgISAxUCEwoMCgUEAQICBRIDFQIHCgwKBQQBAgIBEgMVCA4KDAoF" +
+    "BAECAgMSAxUREgoLCgQEAQIDEgMWAhMKDAoFBAECAwUSAxYCBwoMCgUEAQIDARIDFggOCgwKBQQB" +
+    "AgMDEgMWERIKCwoEBAECBBIDFwIVCgwKBQQBAgQFEgMXAggKDAoFBAECBAESAxcJEAoMCgUEAQIE" +
+    "AxIDFxMUCgsKBAQBAgUSAxgCFQoMCgUEAQIFBRIDGAIICgwKBQQBAgUBEgMYCRAKDAoFBAECBQMS" +
+    "AxgTFAoLCgQEAQIGEgMZAhUKDAoFBAECBgUSAxkCCAoMCgUEAQIGARIDGQkQCgwKBQQBAgYDEgMZ" +
+    "ExQKCwoEBAECBxIDGgIVCgwKBQQBAgcFEgMaAggKDAoFBAECBwESAxoJEAoMCgUEAQIHAxIDGhMU" +
+    "CgsKBAQBAggSAxsCFwoMCgUEAQIIBRIDGwIJCgwKBQQBAggBEgMbChIKDAoFBAECCAMSAxsVFgoL" +
+    "CgQEAQIJEgMcAhgKDAoFBAECCQUSAxwCCQoMCgUEAQIJARIDHAoSCgwKBQQBAgkDEgMcFRcKCwoE" +
+    "BAECChIDHQIaCgwKBQQBAgoFEgMdAgoKDAoFBAECCgESAx0LFAoMCgUEAQIKAxIDHRcZCgsKBAQB" +
+    "AgsSAx4CGgoMCgUEAQILBRIDHgIKCgwKBQQBAgsBEgMeCxQKDAoFBAECCwMSAx4XGQoLCgQEAQIM" +
+    "EgMfAhIKDAoFBAECDAUSAx8CBgoMCgUEAQIMARIDHwcMCgwKBQQBAgwDEgMfDxEKCwoEBAECDRID" +
+    "IAIWCgwKBQQBAg0FEgMgAggKDAoFBAECDQESAyAJEAoMCgUEAQINAxIDIBMVCgsKBAQBAg4SAyEC" +
+    "FAoMCgUEAQIOBRIDIQIHCgwKBQQBAg4BEgMhCA4KDAoFBAECDgMSAyEREwoVCgQEAQQAEgQkAicD" +
+    "GgcgRW51bXMKCgwKBQQBBAABEgMkBw0KDQoGBAEEAAIAEgMlBAoKDgoHBAEEAAIAARIDJQQFCg4K" +
+    "BwQBBAACAAISAyUICQoNCgYEAQQAAgESAyYECwoOCgcEAQQAAgEBEgMmBAUKDgoHBAEEAAIBAhID" +
+    "JggKCgsKBAQBAg8SAygCFQoMCgUEAQIPBhIDKAIICgwKBQQBAg8BEgMoCQ8KDAoFBAECDwMSAygS" +
+    "FAoLCgQEAQIQEgMpAhUKDAoFBAECEAYSAykCCAoMCgUEAQIQARIDKQkPCgwKBQQBAhADEgMpEhQK" +
+    "CwoEBAECERIDKgIeCgwKBQQBAhEGEgMqAhAKDAoFBAECEQESAyoRGAoMCgUEAQIRAxIDKhsdChcK" +
+    "BAQBAhISAy0CIhoKIFJlcGVhdGVkCgoMCgUEAQISBBIDLQIKCgwKBQQBAhIFEgMtCxEKDAoFBAEC" +
+    "EgESAy0SHAoMCgUEAQISAxIDLR8hCgsKBAQBAhMSAy4CIAoMCgUEAQITBBIDLgIKCgwKBQQBAhMF" +
+    "EgMuCxAKDAoFBAECEwESAy4RGgoMCgUEAQITAxIDLh0fCjEKBAQBAwASBDECMwMaIyBOZXN0ZWQg" +
+    "TWVzc2FnZXMgLyBuYW1lc3BhY2UgdGVzdC4KCgwKBQQBAwABEgMxChIKDQoGBAEDAAIAEgMyBBcK" +
+    "DgoHBAEDAAIABRIDMgQKCg4KBwQBAwACAAESAzILEgoOCgcEAQMAAgADEgMyFRYKCwoEBAECFBID" +
+    "NAIaCgwKBQQBAhQGEgM0AgoKDAoFBAECFAESAzQLFAoMCgUEAQIUAxIDNBcZCgsKBAQBAhUSAzUC" +
+    "JAoMCgUEAQIVBhIDNQITCgwKBQQBAhUBEgM1FB4KDAoFBAECFQMSAzUhIwoLCgQEAQIWEgM2AiQK" +
+    "DAoFBAECFgYSAzYCEwoMCgUEAQIWARIDNhQeCgwKBQQBAhYDEgM2ISMKCwoEBAECFxIDOAIgCgwK" +
+    "BQQBAhcGEgM4AhUKDAoFBAECFwESAzgWGgoMCgUEAQIXAxIDOB0fCgsKBAQBAhgSAzkCKwoMCgUE" +
+    "AQIYBhIDOQIfCgwKBQQBAhgBEgM5ICUKDAoFBAECGAMSAzkoKgoLCgQEAQIZEgM7AhgKDAoFBAEC" +
+    "GQUSAzsCBwoMCgUEAQIZARIDOwgSCgwKBQQBAhkDEgM7FRcKDAoEBAEIABIEPQJAAwoMCgUEAQgA" +
+    "ARIDPQgOCgsKBAQBAhoSAz4EGQoMCgUEAQIaBRIDPgQKCgwKBQQBAhoBEgM+CxMKDAoFBAECGgMS" +
+    "Az4WGAoLCgQEAQIbEgM/BBUKDAoFBAECGwUSAz8ECQoMCgUEAQIbARIDPwoPCgwKBQQBAhsDEgM/" +
+    "EhQKCwoEBAECHBIDQgIiCgwKBQQBAhwGEgNCAhQKDAoFBAECHAESA0IVHAoMCgUEAQIcAxIDQh8h" +
+    "CgsKBAQBAh0SA0QCIQoMCgUEAQIdBhIDRAIVCgwKBQQBAh0BEgNEFhsKDAoFBAECHQMSA0QeIAoK" +
+    "CgIGABIERwBOAQoKCgMGAAESA0cIFgoMCgQGAAIAEgRIAkoDCgwKBQYAAgABEgNIBg4KDAoFBgAC" +
+    "AAISA0gPFwoMCgUGAAIAAxIDSCIqCgwKBQYAAgAEEgNJBC8KDQoGBgACAAQiEgNJBC8KCwoEBgAC" +
+    "ARIDSwI5CgwKBQYAAgEBEgNLBhIKDAoFBgACAQISA0sTGwoMCgUGAAIBBhIDSyYsCgwKBQYAAgED" +
+    "EgNLLTUKCwoEBgACAhIDTAI5CgwKBQYAAgIBEgNMBhIKDAoFBgACAgUSA0wTGQoMCgUGAAICAhID" +
+    "TBoiCgwKBQYAAgIDEgNMLTUKCwoEBgACAxIDTQI4CgwKBQYAAgMBEgNNBgoKDAoFBgACAwUSA00L" +
+    "EQoMCgUGAAIDAhIDTRIaCgwKBQYAAgMGEgNNJSsKDAoFBgACAwMSA00sNGIGcHJvdG8z",
+  [___google_protobuf_any_pb.fileDescriptor, ___example2_pb.fileDescriptor]
+);
+
+__pb__.globalRegistry.add(example2);
+__pb__.globalRegistry.add(example1);
+__pb__.globalRegistry.add(example1.example2);
+__pb__.globalRegistry.addEnum(AEnum1Info);
+__pb__.globalRegistry.addEnum(example1.AEnum2Info);
+__pb__.globalRegistry.addFile(fileDescriptor);
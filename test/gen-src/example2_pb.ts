@@ -9,11 +9,54 @@ export const enum AEnum2 {
   Z = 0,
 }
 
+export function AEnum2ToJSON(v: AEnum2): string | number {
+  switch (v as number) {
+    case 0:
+    return "Z";
+  }
+  return v;
+}
+
+export function AEnum2FromJSON(v: __pb__.JsonValue, o: __pb__.JsonOptions = {}): AEnum2 | undefined {
+  switch (v) {
+    case "Z":
+    return AEnum2.Z;
+  }
+  return __pb__.Internal.enumFromJSON(v, o);
+}
+
+export const AEnum2Info: __pb__.EnumInfo = {
+  typeName: "fiz.baz.AEnum2",
+  values: [
+    { name: "Z", number: 0 },
+  ],
+};
+
+export interface example2Init {
+  zomg?: number;
+}
+
+export interface Iexample2 {
+  zomg?: number;
+}
+
 export class example2 implements __pb__.Message {
+  static readonly typeName = "fiz.baz.example2";
+
+  static readonly fields: __pb__.FieldInfo[] = [
+    { name: "zomg", number: 1, type: __pb__.FieldType.INT32, label: __pb__.FieldLabel.OPTIONAL, jsonName: "zomg", member: "zomg" },
+  ];
+
   zomg: number;
+  // The encoding of fields which were not recognized when decoding.
+  unknownFields: Uint8Array[];
 
-  constructor() {
+  constructor(init?: example2Init) {
     this.zomg = 0;
+    this.unknownFields = [];
+    if (init !== undefined) {
+      if (init.zomg !== undefined) this.zomg = init.zomg;
+    }
   }
 
   MergeFrom(d: __pb__.Internal.Decoder): void {
@@ -24,7 +67,7 @@ export class example2 implements __pb__.Message {
         this.zomg = d.readVarInt32();
         break;
         default:
-        d.skipWireType(wt)
+        this.unknownFields.push(d.readUnknown(wt, fn));
       }
     }
   }
@@ -34,14 +77,98 @@ export class example2 implements __pb__.Message {
       e.writeTag(1, 0);
       e.writeNumberAsVarint(this.zomg);
     }
+    e.writeUnknown(this.unknownFields);
   }
+
+  MergeFromJSON(j: __pb__.JsonValue, o: __pb__.JsonOptions = {}): void {
+    const obj = __pb__.Internal.objectFromJSON(j);
+    for (const k in obj) {
+      const v = obj[k];
+      if (v === null) {
+        continue;
+      }
+      switch (k) {
+        case "zomg":
+        this.zomg = __pb__.Internal.int32FromJSON(v);
+        break;
+        default:
+        __pb__.Internal.unknownFieldFromJSON(k, o);
+      }
+    }
+  }
+
+  ToJSON(o: __pb__.JsonOptions = {}): __pb__.JsonValue {
+    const j: __pb__.JsonObject = {};
+    if (o.emitDefaults || this.zomg != 0) {
+      j["zomg"] = this.zomg;
+    }
+    return j;
+  }
+
+  // toObject returns the message as a plain object, holding no classes.
+  toObject(): Iexample2 {
+    const o: Iexample2 = {};
+    o.zomg = this.zomg;
+    return o;
+  }
+
+  // fromObject returns a message from its plain object form.
+  static fromObject(o: Iexample2): example2 {
+    const m = new example2();
+    if (o.zomg !== undefined) m.zomg = o.zomg;
+    return m;
+  }
+
+  // equals reports whether other holds the same values as the message.
+  equals(other: example2): boolean {
+    if (this === other) return true;
+    if (this.zomg !== other.zomg) return false;
+    if (!__pb__.Internal.unknownEqual(this.unknownFields, other.unknownFields)) return false;
+    return true;
+  }
+
+  // clone returns a deep copy of the message.
+  clone(): example2 {
+    const m = new example2();
+    m.zomg = this.zomg;
+    m.unknownFields = this.unknownFields.map(u => u.slice());
+    return m;
+  }
+
+  // hashCode returns a hash of the message's values, which is equal for
+  // equal messages and stable across runs.
+  hashCode(): number {
+    let h = 0;
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashNumber(this.zomg));
+    return h;
+  }
+}
+
+export interface refexample3Init {
+  funky?: ___example3_pb.Funky | ___example3_pb.FunkyInit;
+}
+
+export interface Irefexample3 {
+  funky?: ___example3_pb.IFunky;
 }
 
 export class refexample3 implements __pb__.Message {
+  static readonly typeName = "fiz.baz.refexample3";
+
+  static readonly fields: __pb__.FieldInfo[] = [
+    { name: "funky", number: 1, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.OPTIONAL, jsonName: "funky", member: "funky", messageType: () => ___example3_pb.Funky },
+  ];
+
   funky: ___example3_pb.Funky | null;
+  // The encoding of fields which were not recognized when decoding.
+  unknownFields: Uint8Array[];
 
-  constructor() {
+  constructor(init?: refexample3Init) {
     this.funky = null;
+    this.unknownFields = [];
+    if (init !== undefined) {
+      if (init.funky !== undefined) this.funky = __pb__.Internal.fromInit(___example3_pb.Funky, init.funky);
+    }
   }
 
   MergeFrom(d: __pb__.Internal.Decoder): void {
@@ -53,7 +180,7 @@ export class refexample3 implements __pb__.Message {
         this.funky.MergeFrom(d.readDecoder());
         break;
         default:
-        d.skipWireType(wt)
+        this.unknownFields.push(d.readUnknown(wt, fn));
       }
     }
   }
@@ -64,9 +191,95 @@ export class refexample3 implements __pb__.Message {
       if (msg != null) {
         let nested = new __pb__.Internal.Encoder();
         msg.WriteTo(nested);
-        e.writeEncoder(nested, 1)
+        e.writeEncoder(nested, 1);
+      }
+    }
+    e.writeUnknown(this.unknownFields);
+  }
+
+  MergeFromJSON(j: __pb__.JsonValue, o: __pb__.JsonOptions = {}): void {
+    const obj = __pb__.Internal.objectFromJSON(j);
+    for (const k in obj) {
+      const v = obj[k];
+      if (v === null) {
+        continue;
+      }
+      switch (k) {
+        case "funky":
+        if (this.funky == null) this.funky = new ___example3_pb.Funky();
+        this.funky.MergeFromJSON(v, o);
+        break;
+        default:
+        __pb__.Internal.unknownFieldFromJSON(k, o);
       }
     }
   }
+
+  ToJSON(o: __pb__.JsonOptions = {}): __pb__.JsonValue {
+    const j: __pb__.JsonObject = {};
+    if (o.emitDefaults || this.funky != null) {
+      const msg = this.funky;
+      j["funky"] = msg == null ? null : msg.ToJSON(o);
+    }
+    return j;
+  }
+
+  // toObject returns the message as a plain object, holding no classes.
+  toObject(): Irefexample3 {
+    const o: Irefexample3 = {};
+    if (this.funky != null) {
+      o.funky = this.funky.toObject();
+    }
+    return o;
+  }
+
+  // fromObject returns a message from its plain object form.
+  static fromObject(o: Irefexample3): refexample3 {
+    const m = new refexample3();
+    if (o.funky !== undefined) m.funky = ___example3_pb.Funky.fromObject(o.funky);
+    return m;
+  }
+
+  // equals reports whether other holds the same values as the message.
+  equals(other: refexample3): boolean {
+    if (this === other) return true;
+    if (!__pb__.Internal.optionalEqual(this.funky, other.funky, (x, y) => x.equals(y))) return false;
+    if (!__pb__.Internal.unknownEqual(this.unknownFields, other.unknownFields)) return false;
+    return true;
+  }
+
+  // clone returns a deep copy of the message.
+  clone(): refexample3 {
+    const m = new refexample3();
+    m.funky = this.funky == null ? null : this.funky.clone();
+    m.unknownFields = this.unknownFields.map(u => u.slice());
+    return m;
+  }
+
+  // hashCode returns a hash of the message's values, which is equal for
+  // equal messages and stable across runs.
+  hashCode(): number {
+    let h = 0;
+    h = __pb__.Internal.hashCombine(h, this.funky == null ? 0 : this.funky.hashCode());
+    return h;
+  }
 }
 
+// fileDescriptor is the google.protobuf.FileDescriptorProto of example2.proto.
+export const fileDescriptor = new __pb__.FileDescriptor(
+  "example2.proto",
+  "Cg5leGFtcGxlMi5wcm90bxIHZml6LmJhehoOZXhhbXBsZTMucHJvdG8iHgoIZXhhbXBsZTISEgoE" +
+    "em9tZxgBIAEoBVIEem9tZyIrCgtyZWZleGFtcGxlMxIcCgVmdW5reRgBIAEoCzIGLkZ1bmt5UgVm" +
+    "dW5reSoPCgZBRW51bTISBQoBWhAASoYCCgYSBAAAEAEKCAoBDBIDAAASCgkKAgMAEgMCABgKCAoB" +
+    "AhIDBAAQCgoKAgQAEgQGAAgBCgoKAwQAARIDBggQCgsKBAQAAgASAwcCEQoMCgUEAAIABRIDBwIH" +
+    "CgwKBQQAAgABEgMHCAwKDAoFBAACAAMSAwcPEAoKCgIFABIECgAMAQoKCgMFAAESAwoFCwoLCgQF" +
+    "AAIAEgMLAggKDAoFBQACAAESAwsCAwoMCgUFAAIAAhIDCwYHCgoKAgQBEgQOABABCgoKAwQBARID" +
+    "DggTCgsKBAQBAgASAw8CEgoMCgUEAQIABhIDDwIHCgwKBQQBAgABEgMPCA0KDAoFBAECAAMSAw8Q" +
+    "EWIGcHJvdG8z",
+  [___example3_pb.fileDescriptor]
+);
+
+__pb__.globalRegistry.add(example2);
+__pb__.globalRegistry.add(refexample3);
+__pb__.globalRegistry.addEnum(AEnum2Info);
+__pb__.globalRegistry.addFile(fileDescriptor);
@@ -4,11 +4,31 @@
 import * as __pb__ from '../../lib/protobuf'
 
 
+export interface DonkeyInit {
+  hi?: string;
+}
+
+export interface IDonkey {
+  hi?: string;
+}
+
 export class Donkey implements __pb__.Message {
+  static readonly typeName = "Donkey";
+
+  static readonly fields: __pb__.FieldInfo[] = [
+    { name: "hi", number: 1, type: __pb__.FieldType.STRING, label: __pb__.FieldLabel.OPTIONAL, jsonName: "hi", member: "hi" },
+  ];
+
   hi: string;
+  // The encoding of fields which were not recognized when decoding.
+  unknownFields: Uint8Array[];
 
-  constructor() {
+  constructor(init?: DonkeyInit) {
     this.hi = "";
+    this.unknownFields = [];
+    if (init !== undefined) {
+      if (init.hi !== undefined) this.hi = init.hi;
+    }
   }
 
   MergeFrom(d: __pb__.Internal.Decoder): void {
@@ -16,10 +36,10 @@ export class Donkey implements __pb__.Message {
       let [fn, wt] = d.readTag();
       switch(fn) {
         case 1:
-        this.hi = d.readString();
+        this.hi = d.readValidString();
         break;
         default:
-        d.skipWireType(wt)
+        this.unknownFields.push(d.readUnknown(wt, fn));
       }
     }
   }
@@ -29,16 +49,104 @@ export class Donkey implements __pb__.Message {
       e.writeTag(1, 2);
       e.writeString(this.hi);
     }
+    e.writeUnknown(this.unknownFields);
+  }
+
+  MergeFromJSON(j: __pb__.JsonValue, o: __pb__.JsonOptions = {}): void {
+    const obj = __pb__.Internal.objectFromJSON(j);
+    for (const k in obj) {
+      const v = obj[k];
+      if (v === null) {
+        continue;
+      }
+      switch (k) {
+        case "hi":
+        this.hi = __pb__.Internal.stringFromJSON(v);
+        break;
+        default:
+        __pb__.Internal.unknownFieldFromJSON(k, o);
+      }
+    }
+  }
+
+  ToJSON(o: __pb__.JsonOptions = {}): __pb__.JsonValue {
+    const j: __pb__.JsonObject = {};
+    if (o.emitDefaults || this.hi != "") {
+      j["hi"] = this.hi;
+    }
+    return j;
+  }
+
+  // toObject returns the message as a plain object, holding no classes.
+  toObject(): IDonkey {
+    const o: IDonkey = {};
+    o.hi = this.hi;
+    return o;
+  }
+
+  // fromObject returns a message from its plain object form.
+  static fromObject(o: IDonkey): Donkey {
+    const m = new Donkey();
+    if (o.hi !== undefined) m.hi = o.hi;
+    return m;
+  }
+
+  // equals reports whether other holds the same values as the message.
+  equals(other: Donkey): boolean {
+    if (this === other) return true;
+    if (this.hi !== other.hi) return false;
+    if (!__pb__.Internal.unknownEqual(this.unknownFields, other.unknownFields)) return false;
+    return true;
   }
+
+  // clone returns a deep copy of the message.
+  clone(): Donkey {
+    const m = new Donkey();
+    m.hi = this.hi;
+    m.unknownFields = this.unknownFields.map(u => u.slice());
+    return m;
+  }
+
+  // hashCode returns a hash of the message's values, which is equal for
+  // equal messages and stable across runs.
+  hashCode(): number {
+    let h = 0;
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashString(this.hi));
+    return h;
+  }
+}
+
+export interface FunkyInit {
+  monkey?: Funky.Monkey | Funky.MonkeyInit;
+  dokey?: Donkey | DonkeyInit;
+}
+
+export interface IFunky {
+  monkey?: Funky.IMonkey;
+  dokey?: IDonkey;
 }
 
 export class Funky implements __pb__.Message {
+  static readonly typeName = "Funky";
+
+  static readonly fields: __pb__.FieldInfo[] = [
+    { name: "monkey", number: 1, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.OPTIONAL, jsonName: "monkey", member: "monkey", messageType: () => Funky.Monkey },
+    { name: "dokey", number: 2, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.OPTIONAL, jsonName: "dokey", member: "dokey", messageType: () => Donkey },
+  ];
+
   monkey: Funky.Monkey | null;
   dokey: Donkey | null;
+  // The encoding of fields which were not recognized when decoding.
+  unknownFields: Uint8Array[];
 
-  constructor() {
+  constructor(init?: FunkyInit) {
     this.monkey = null;
     this.dokey = null;
+    this.unknownFields = [];
+    if (init !== undefined) {
+      if (init.monkey !== undefined) this.monkey = __pb__.Internal.fromInit(Funky.Monkey, init.monkey);
+      if (init.dokey !== undefined) this.dokey = __pb__.Internal.fromInit(Donkey, init.dokey);
+    }
   }
 
   MergeFrom(d: __pb__.Internal.Decoder): void {
@@ -54,7 +162,7 @@ export class Funky implements __pb__.Message {
         this.dokey.MergeFrom(d.readDecoder());
         break;
         default:
-        d.skipWireType(wt)
+        this.unknownFields.push(d.readUnknown(wt, fn));
       }
     }
   }
@@ -65,7 +173,7 @@ export class Funky implements __pb__.Message {
       if (msg != null) {
         let nested = new __pb__.Internal.Encoder();
         msg.WriteTo(nested);
-        e.writeEncoder(nested, 1)
+        e.writeEncoder(nested, 1);
       }
     }
     {
@@ -73,18 +181,121 @@ export class Funky implements __pb__.Message {
       if (msg != null) {
         let nested = new __pb__.Internal.Encoder();
         msg.WriteTo(nested);
-        e.writeEncoder(nested, 2)
+        e.writeEncoder(nested, 2);
       }
     }
+    e.writeUnknown(this.unknownFields);
+  }
+
+  MergeFromJSON(j: __pb__.JsonValue, o: __pb__.JsonOptions = {}): void {
+    const obj = __pb__.Internal.objectFromJSON(j);
+    for (const k in obj) {
+      const v = obj[k];
+      if (v === null) {
+        continue;
+      }
+      switch (k) {
+        case "monkey":
+        if (this.monkey == null) this.monkey = new Funky.Monkey();
+        this.monkey.MergeFromJSON(v, o);
+        break;
+        case "dokey":
+        if (this.dokey == null) this.dokey = new Donkey();
+        this.dokey.MergeFromJSON(v, o);
+        break;
+        default:
+        __pb__.Internal.unknownFieldFromJSON(k, o);
+      }
+    }
+  }
+
+  ToJSON(o: __pb__.JsonOptions = {}): __pb__.JsonValue {
+    const j: __pb__.JsonObject = {};
+    if (o.emitDefaults || this.monkey != null) {
+      const msg = this.monkey;
+      j["monkey"] = msg == null ? null : msg.ToJSON(o);
+    }
+    if (o.emitDefaults || this.dokey != null) {
+      const msg = this.dokey;
+      j["dokey"] = msg == null ? null : msg.ToJSON(o);
+    }
+    return j;
+  }
+
+  // toObject returns the message as a plain object, holding no classes.
+  toObject(): IFunky {
+    const o: IFunky = {};
+    if (this.monkey != null) {
+      o.monkey = this.monkey.toObject();
+    }
+    if (this.dokey != null) {
+      o.dokey = this.dokey.toObject();
+    }
+    return o;
+  }
+
+  // fromObject returns a message from its plain object form.
+  static fromObject(o: IFunky): Funky {
+    const m = new Funky();
+    if (o.monkey !== undefined) m.monkey = Funky.Monkey.fromObject(o.monkey);
+    if (o.dokey !== undefined) m.dokey = Donkey.fromObject(o.dokey);
+    return m;
+  }
+
+  // equals reports whether other holds the same values as the message.
+  equals(other: Funky): boolean {
+    if (this === other) return true;
+    if (!__pb__.Internal.optionalEqual(this.monkey, other.monkey, (x, y) => x.equals(y))) return false;
+    if (!__pb__.Internal.optionalEqual(this.dokey, other.dokey, (x, y) => x.equals(y))) return false;
+    if (!__pb__.Internal.unknownEqual(this.unknownFields, other.unknownFields)) return false;
+    return true;
+  }
+
+  // clone returns a deep copy of the message.
+  clone(): Funky {
+    const m = new Funky();
+    m.monkey = this.monkey == null ? null : this.monkey.clone();
+    m.dokey = this.dokey == null ? null : this.dokey.clone();
+    m.unknownFields = this.unknownFields.map(u => u.slice());
+    return m;
+  }
+
+  // hashCode returns a hash of the message's values, which is equal for
+  // equal messages and stable across runs.
+  hashCode(): number {
+    let h = 0;
+    h = __pb__.Internal.hashCombine(h, this.monkey == null ? 0 : this.monkey.hashCode());
+    h = __pb__.Internal.hashCombine(h, this.dokey == null ? 0 : this.dokey.hashCode());
+    return h;
   }
 }
 
 export namespace Funky {
+  export interface MonkeyInit {
+    hi?: string;
+  }
+
+  export interface IMonkey {
+    hi?: string;
+  }
+
   export class Monkey implements __pb__.Message {
+    static readonly typeName = "Funky.Monkey";
+
+    static readonly fields: __pb__.FieldInfo[] = [
+      { name: "hi", number: 1, type: __pb__.FieldType.STRING, label: __pb__.FieldLabel.OPTIONAL, jsonName: "hi", member: "hi" },
+    ];
+
     hi: string;
+    // The encoding of fields which were not recognized when decoding.
+    unknownFields: Uint8Array[];
 
-    constructor() {
+    constructor(init?: MonkeyInit) {
       this.hi = "";
+      this.unknownFields = [];
+      if (init !== undefined) {
+        if (init.hi !== undefined) this.hi = init.hi;
+      }
     }
 
     MergeFrom(d: __pb__.Internal.Decoder): void {
@@ -92,10 +303,10 @@ export namespace Funky {
         let [fn, wt] = d.readTag();
         switch(fn) {
           case 1:
-          this.hi = d.readString();
+          this.hi = d.readValidString();
           break;
           default:
-          d.skipWireType(wt)
+          this.unknownFields.push(d.readUnknown(wt, fn));
         }
       }
     }
@@ -105,7 +316,90 @@ export namespace Funky {
         e.writeTag(1, 2);
         e.writeString(this.hi);
       }
+      e.writeUnknown(this.unknownFields);
+    }
+
+    MergeFromJSON(j: __pb__.JsonValue, o: __pb__.JsonOptions = {}): void {
+      const obj = __pb__.Internal.objectFromJSON(j);
+      for (const k in obj) {
+        const v = obj[k];
+        if (v === null) {
+          continue;
+        }
+        switch (k) {
+          case "hi":
+          this.hi = __pb__.Internal.stringFromJSON(v);
+          break;
+          default:
+          __pb__.Internal.unknownFieldFromJSON(k, o);
+        }
+      }
+    }
+
+    ToJSON(o: __pb__.JsonOptions = {}): __pb__.JsonValue {
+      const j: __pb__.JsonObject = {};
+      if (o.emitDefaults || this.hi != "") {
+        j["hi"] = this.hi;
+      }
+      return j;
+    }
+
+    // toObject returns the message as a plain object, holding no classes.
+    toObject(): IMonkey {
+      const o: IMonkey = {};
+      o.hi = this.hi;
+      return o;
+    }
+
+    // fromObject returns a message from its plain object form.
+    static fromObject(o: IMonkey): Monkey {
+      const m = new Monkey();
+      if (o.hi !== undefined) m.hi = o.hi;
+      return m;
+    }
+
+    // equals reports whether other holds the same values as the message.
+    equals(other: Monkey): boolean {
+      if (this === other) return true;
+      if (this.hi !== other.hi) return false;
+      if (!__pb__.Internal.unknownEqual(this.unknownFields, other.unknownFields)) return false;
+      return true;
+    }
+
+    // clone returns a deep copy of the message.
+    clone(): Monkey {
+      const m = new Monkey();
+      m.hi = this.hi;
+      m.unknownFields = this.unknownFields.map(u => u.slice());
+      return m;
+    }
+
+    // hashCode returns a hash of the message's values, which is equal for
+    // equal messages and stable across runs.
+    hashCode(): number {
+      let h = 0;
+      h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashString(this.hi));
+      return h;
     }
   }
 }
 
+// fileDescriptor is the google.protobuf.FileDescriptorProto of example3.proto.
+export const fileDescriptor = new __pb__.FileDescriptor(
+  "example3.proto",
+  "Cg5leGFtcGxlMy5wcm90byIYCgZEb25rZXkSDgoCaGkYASABKAlSAmhpImcKBUZ1bmt5EiUKBm1v" +
+    "bmtleRgBIAEoCzINLkZ1bmt5Lk1vbmtleVIGbW9ua2V5Eh0KBWRva2V5GAIgASgLMgcuRG9ua2V5" +
+    "UgVkb2tleRoYCgZNb25rZXkSDgoCaGkYASABKAlSAmhpSsICCgYSBAAADAEKCAoBDBIDAAASCgoK" +
+    "AgQAEgQCAAQBCgoKAwQAARIDAggOCgsKBAQAAgASAwMCEAoMCgUEAAIABRIDAwIICgwKBQQAAgAB" +
+    "EgMDCQsKDAoFBAACAAMSAwMODwoKCgIEARIEBgAMAQoKCgMEAQESAwYIDQoMCgQEAQMAEgQHAgkD" +
+    "CgwKBQQBAwABEgMHChAKDQoGBAEDAAIAEgMIBBIKDgoHBAEDAAIABRIDCAQKCg4KBwQBAwACAAES" +
+    "AwgLDQoOCgcEAQMAAgADEgMIEBEKCwoEBAECABIDCgIUCgwKBQQBAgAGEgMKAggKDAoFBAECAAES" +
+    "AwoJDwoMCgUEAQIAAxIDChITCgsKBAQBAgESAwsCEwoMCgUEAQIBBhIDCwIICgwKBQQBAgEBEgML" +
+    "CQ4KDAoFBAECAQMSAwsREmIGcHJvdG8z",
+  []
+);
+
+__pb__.globalRegistry.add(Donkey);
+__pb__.globalRegistry.add(Funky);
+__pb__.globalRegistry.add(Funky.Monkey);
+__pb__.globalRegistry.addFile(fileDescriptor);
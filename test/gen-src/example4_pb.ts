@@ -0,0 +1,1011 @@
+// Generated by the protocol buffer compiler.  DO NOT EDIT!
+// Source: example4.proto
+
+import * as __pb__ from '../../lib/protobuf'
+import * as __long from 'long'
+import {fromString as __longFromString } from 'long'
+
+
+export const enum Color {
+  RED = 1,
+  GREEN = 2,
+  BLUE = 3,
+}
+
+export function ColorToJSON(v: Color): string | number {
+  switch (v as number) {
+    case 1:
+    return "RED";
+    case 2:
+    return "GREEN";
+    case 3:
+    return "BLUE";
+  }
+  return v;
+}
+
+export function ColorFromJSON(v: __pb__.JsonValue, o: __pb__.JsonOptions = {}): Color | undefined {
+  switch (v) {
+    case "RED":
+    return Color.RED;
+    case "GREEN":
+    return Color.GREEN;
+    case "BLUE":
+    return Color.BLUE;
+  }
+  return __pb__.Internal.enumFromJSON(v, o);
+}
+
+export const ColorInfo: __pb__.EnumInfo = {
+  typeName: "foo.proto2.Color",
+  values: [
+    { name: "RED", number: 1 },
+    { name: "GREEN", number: 2 },
+    { name: "BLUE", number: 3 },
+  ],
+};
+
+export interface example4Init {
+  arequired?: number;
+  /**
+   * Presence and explicit defaults.
+   */
+  aint32?: number;
+  aint64?: __long;
+  auint64?: __long;
+  adouble?: number;
+  abool?: boolean;
+  astring?: string;
+  abytes?: Uint8Array;
+  acolor?: Color;
+  acolor2?: Color;
+  nodefault?: number;
+  /**
+   * Packing is opt-in.
+   */
+  unpacked?: number[];
+  packed?: number[];
+  colors?: Color[];
+  agroup?: example4.AGroup | example4.AGroupInit;
+  nested?: example4 | example4Init;
+}
+
+export interface Iexample4 {
+  arequired?: number;
+  /**
+   * Presence and explicit defaults.
+   */
+  aint32?: number;
+  aint64?: string;
+  auint64?: string;
+  adouble?: number;
+  abool?: boolean;
+  astring?: string;
+  abytes?: string;
+  acolor?: Color;
+  acolor2?: Color;
+  nodefault?: number;
+  /**
+   * Packing is opt-in.
+   */
+  unpacked?: number[];
+  packed?: number[];
+  colors?: Color[];
+  agroup?: example4.IAGroup;
+  nested?: Iexample4;
+}
+
+export class example4 implements __pb__.Message {
+  static readonly typeName = "foo.proto2.example4";
+
+  static readonly fields: __pb__.FieldInfo[] = [
+    { name: "arequired", number: 1, type: __pb__.FieldType.INT32, label: __pb__.FieldLabel.REQUIRED, jsonName: "arequired", member: "arequired" },
+    { name: "aint32", number: 2, type: __pb__.FieldType.INT32, label: __pb__.FieldLabel.OPTIONAL, jsonName: "aint32", member: "aint32" },
+    { name: "aint64", number: 3, type: __pb__.FieldType.INT64, label: __pb__.FieldLabel.OPTIONAL, jsonName: "aint64", member: "aint64" },
+    { name: "auint64", number: 4, type: __pb__.FieldType.UINT64, label: __pb__.FieldLabel.OPTIONAL, jsonName: "auint64", member: "auint64" },
+    { name: "adouble", number: 5, type: __pb__.FieldType.DOUBLE, label: __pb__.FieldLabel.OPTIONAL, jsonName: "adouble", member: "adouble" },
+    { name: "abool", number: 6, type: __pb__.FieldType.BOOL, label: __pb__.FieldLabel.OPTIONAL, jsonName: "abool", member: "abool" },
+    { name: "astring", number: 7, type: __pb__.FieldType.STRING, label: __pb__.FieldLabel.OPTIONAL, jsonName: "astring", member: "astring" },
+    { name: "abytes", number: 8, type: __pb__.FieldType.BYTES, label: __pb__.FieldLabel.OPTIONAL, jsonName: "abytes", member: "abytes" },
+    { name: "acolor", number: 9, type: __pb__.FieldType.ENUM, label: __pb__.FieldLabel.OPTIONAL, jsonName: "acolor", member: "acolor", enumType: () => ColorInfo },
+    { name: "acolor2", number: 10, type: __pb__.FieldType.ENUM, label: __pb__.FieldLabel.OPTIONAL, jsonName: "acolor2", member: "acolor2", enumType: () => ColorInfo },
+    { name: "nodefault", number: 11, type: __pb__.FieldType.INT32, label: __pb__.FieldLabel.OPTIONAL, jsonName: "nodefault", member: "nodefault" },
+    { name: "unpacked", number: 20, type: __pb__.FieldType.INT32, label: __pb__.FieldLabel.REPEATED, jsonName: "unpacked", member: "unpacked" },
+    { name: "packed", number: 21, type: __pb__.FieldType.INT32, label: __pb__.FieldLabel.REPEATED, jsonName: "packed", member: "packed" },
+    { name: "colors", number: 22, type: __pb__.FieldType.ENUM, label: __pb__.FieldLabel.REPEATED, jsonName: "colors", member: "colors", enumType: () => ColorInfo },
+    { name: "agroup", number: 30, type: __pb__.FieldType.GROUP, label: __pb__.FieldLabel.OPTIONAL, jsonName: "agroup", member: "agroup", messageType: () => example4.AGroup },
+    { name: "nested", number: 40, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.OPTIONAL, jsonName: "nested", member: "nested", messageType: () => example4 },
+  ];
+
+  private __arequired: number | undefined;
+  private __aint32: number | undefined;
+  private __aint64: __long | undefined;
+  private __auint64: __long | undefined;
+  private __adouble: number | undefined;
+  private __abool: boolean | undefined;
+  private __astring: string | undefined;
+  private __abytes: Uint8Array | undefined;
+  private __acolor: Color | undefined;
+  private __acolor2: Color | undefined;
+  private __nodefault: number | undefined;
+  /**
+   * Packing is opt-in.
+   */
+  unpacked: number[];
+  packed: number[];
+  colors: Color[];
+  agroup: example4.AGroup | null;
+  nested: example4 | null;
+  // The encoding of fields which were not recognized when decoding.
+  unknownFields: Uint8Array[];
+
+  constructor(init?: example4Init) {
+    this.__arequired = undefined;
+    this.__aint32 = undefined;
+    this.__aint64 = undefined;
+    this.__auint64 = undefined;
+    this.__adouble = undefined;
+    this.__abool = undefined;
+    this.__astring = undefined;
+    this.__abytes = undefined;
+    this.__acolor = undefined;
+    this.__acolor2 = undefined;
+    this.__nodefault = undefined;
+    this.unpacked = [];
+    this.packed = [];
+    this.colors = [];
+    this.agroup = null;
+    this.nested = null;
+    this.unknownFields = [];
+    if (init !== undefined) {
+      if (init.arequired !== undefined) this.arequired = init.arequired;
+      if (init.aint32 !== undefined) this.aint32 = init.aint32;
+      if (init.aint64 !== undefined) this.aint64 = init.aint64;
+      if (init.auint64 !== undefined) this.auint64 = init.auint64;
+      if (init.adouble !== undefined) this.adouble = init.adouble;
+      if (init.abool !== undefined) this.abool = init.abool;
+      if (init.astring !== undefined) this.astring = init.astring;
+      if (init.abytes !== undefined) this.abytes = init.abytes;
+      if (init.acolor !== undefined) this.acolor = init.acolor;
+      if (init.acolor2 !== undefined) this.acolor2 = init.acolor2;
+      if (init.nodefault !== undefined) this.nodefault = init.nodefault;
+      if (init.unpacked !== undefined) this.unpacked = init.unpacked.slice();
+      if (init.packed !== undefined) this.packed = init.packed.slice();
+      if (init.colors !== undefined) this.colors = init.colors.slice();
+      if (init.agroup !== undefined) this.agroup = __pb__.Internal.fromInit(example4.AGroup, init.agroup);
+      if (init.nested !== undefined) this.nested = __pb__.Internal.fromInit(example4, init.nested);
+    }
+  }
+
+  get arequired(): number {
+    return this.__arequired === undefined ? 0 : this.__arequired;
+  }
+
+  set arequired(v: number) {
+    this.__arequired = v;
+  }
+
+  has_arequired(): boolean {
+    return this.__arequired !== undefined;
+  }
+
+  clear_arequired(): void {
+    this.__arequired = undefined;
+  }
+
+  /**
+   * Presence and explicit defaults.
+   */
+  get aint32(): number {
+    return this.__aint32 === undefined ? -7 : this.__aint32;
+  }
+
+  set aint32(v: number) {
+    this.__aint32 = v;
+  }
+
+  has_aint32(): boolean {
+    return this.__aint32 !== undefined;
+  }
+
+  clear_aint32(): void {
+    this.__aint32 = undefined;
+  }
+
+  get aint64(): __long {
+    return this.__aint64 === undefined ? __longFromString("1234567890123", false) : this.__aint64;
+  }
+
+  set aint64(v: __long) {
+    this.__aint64 = v;
+  }
+
+  has_aint64(): boolean {
+    return this.__aint64 !== undefined;
+  }
+
+  clear_aint64(): void {
+    this.__aint64 = undefined;
+  }
+
+  get auint64(): __long {
+    return this.__auint64 === undefined ? __longFromString("42", true) : this.__auint64;
+  }
+
+  set auint64(v: __long) {
+    this.__auint64 = v;
+  }
+
+  has_auint64(): boolean {
+    return this.__auint64 !== undefined;
+  }
+
+  clear_auint64(): void {
+    this.__auint64 = undefined;
+  }
+
+  get adouble(): number {
+    return this.__adouble === undefined ? Infinity : this.__adouble;
+  }
+
+  set adouble(v: number) {
+    this.__adouble = v;
+  }
+
+  has_adouble(): boolean {
+    return this.__adouble !== undefined;
+  }
+
+  clear_adouble(): void {
+    this.__adouble = undefined;
+  }
+
+  get abool(): boolean {
+    return this.__abool === undefined ? true : this.__abool;
+  }
+
+  set abool(v: boolean) {
+    this.__abool = v;
+  }
+
+  has_abool(): boolean {
+    return this.__abool !== undefined;
+  }
+
+  clear_abool(): void {
+    this.__abool = undefined;
+  }
+
+  get astring(): string {
+    return this.__astring === undefined ? "hi \"there\"" : this.__astring;
+  }
+
+  set astring(v: string) {
+    this.__astring = v;
+  }
+
+  has_astring(): boolean {
+    return this.__astring !== undefined;
+  }
+
+  clear_astring(): void {
+    this.__astring = undefined;
+  }
+
+  get abytes(): Uint8Array {
+    return this.__abytes === undefined ? new Uint8Array([97, 1, 98]) : this.__abytes;
+  }
+
+  set abytes(v: Uint8Array) {
+    this.__abytes = v;
+  }
+
+  has_abytes(): boolean {
+    return this.__abytes !== undefined;
+  }
+
+  clear_abytes(): void {
+    this.__abytes = undefined;
+  }
+
+  get acolor(): Color {
+    return this.__acolor === undefined ? 2 : this.__acolor;
+  }
+
+  set acolor(v: Color) {
+    this.__acolor = v;
+  }
+
+  has_acolor(): boolean {
+    return this.__acolor !== undefined;
+  }
+
+  clear_acolor(): void {
+    this.__acolor = undefined;
+  }
+
+  get acolor2(): Color {
+    return this.__acolor2 === undefined ? 1 : this.__acolor2;
+  }
+
+  set acolor2(v: Color) {
+    this.__acolor2 = v;
+  }
+
+  has_acolor2(): boolean {
+    return this.__acolor2 !== undefined;
+  }
+
+  clear_acolor2(): void {
+    this.__acolor2 = undefined;
+  }
+
+  get nodefault(): number {
+    return this.__nodefault === undefined ? 0 : this.__nodefault;
+  }
+
+  set nodefault(v: number) {
+    this.__nodefault = v;
+  }
+
+  has_nodefault(): boolean {
+    return this.__nodefault !== undefined;
+  }
+
+  clear_nodefault(): void {
+    this.__nodefault = undefined;
+  }
+
+  MergeFrom(d: __pb__.Internal.Decoder): void {
+    while (!d.isEOF()) {
+      let [fn, wt] = d.readTag();
+      switch(fn) {
+        case 1:
+        this.arequired = d.readVarInt32();
+        break;
+        case 2:
+        this.aint32 = d.readVarInt32();
+        break;
+        case 3:
+        this.aint64 = d.readVarintSigned();
+        break;
+        case 4:
+        this.auint64 = d.readVarint();
+        break;
+        case 5:
+        this.adouble = d.readDouble();
+        break;
+        case 6:
+        this.abool = d.readBool();
+        break;
+        case 7:
+        this.astring = d.readString();
+        break;
+        case 8:
+        this.abytes = d.readBytes();
+        break;
+        case 9:
+        {
+          let v = d.readVarintSignedAsNumber();
+          if (v == 1 || v == 2 || v == 3) {
+            this.acolor = v;
+          } else {
+            this.unknownFields.push(__pb__.Internal.unknownVarint(9, v));
+          }
+        }
+        break;
+        case 10:
+        {
+          let v = d.readVarintSignedAsNumber();
+          if (v == 1 || v == 2 || v == 3) {
+            this.acolor2 = v;
+          } else {
+            this.unknownFields.push(__pb__.Internal.unknownVarint(10, v));
+          }
+        }
+        break;
+        case 11:
+        this.nodefault = d.readVarInt32();
+        break;
+        case 20:
+        if (wt == 2) {
+          let packed = d.readDecoder();
+          while (!packed.isEOF()) {
+            this.unpacked.push(packed.readVarInt32())
+          }
+        } else {
+          this.unpacked.push(d.readVarInt32())
+        }
+        break;
+        case 21:
+        if (wt == 2) {
+          let packed = d.readDecoder();
+          while (!packed.isEOF()) {
+            this.packed.push(packed.readVarInt32())
+          }
+        } else {
+          this.packed.push(d.readVarInt32())
+        }
+        break;
+        case 22:
+        if (wt == 2) {
+          let packed = d.readDecoder();
+          while (!packed.isEOF()) {
+            {
+              let v = packed.readVarintSignedAsNumber();
+              if (v == 1 || v == 2 || v == 3) {
+                this.colors.push(v)
+              } else {
+                this.unknownFields.push(__pb__.Internal.unknownVarint(22, v));
+              }
+            }
+          }
+        } else {
+          {
+            let v = d.readVarintSignedAsNumber();
+            if (v == 1 || v == 2 || v == 3) {
+              this.colors.push(v)
+            } else {
+              this.unknownFields.push(__pb__.Internal.unknownVarint(22, v));
+            }
+          }
+        }
+        break;
+        case 30:
+        if (this.agroup == null) this.agroup = new example4.AGroup();
+        this.agroup.MergeFrom(d.readGroup(30));
+        break;
+        case 40:
+        if (this.nested == null) this.nested = new example4();
+        this.nested.MergeFrom(d.readDecoder());
+        break;
+        default:
+        this.unknownFields.push(d.readUnknown(wt, fn));
+      }
+    }
+  }
+
+  WriteTo(e: __pb__.Internal.Encoder): void {
+    if (this.has_arequired()) {
+      e.writeTag(1, 0);
+      e.writeNumberAsVarint(this.arequired);
+    }
+    if (this.has_aint32()) {
+      e.writeTag(2, 0);
+      e.writeNumberAsVarint(this.aint32);
+    }
+    if (this.has_aint64()) {
+      e.writeTag(3, 0);
+      e.writeVarint(this.aint64);
+    }
+    if (this.has_auint64()) {
+      e.writeTag(4, 0);
+      e.writeVarint(this.auint64);
+    }
+    if (this.has_adouble()) {
+      e.writeTag(5, 1);
+      e.writeDouble(this.adouble);
+    }
+    if (this.has_abool()) {
+      e.writeTag(6, 0);
+      e.writeBool(this.abool);
+    }
+    if (this.has_astring()) {
+      e.writeTag(7, 2);
+      e.writeString(this.astring);
+    }
+    if (this.has_abytes()) {
+      e.writeTag(8, 2);
+      e.writeBytes(this.abytes);
+    }
+    if (this.has_acolor()) {
+      e.writeTag(9, 0);
+      e.writeNumberAsVarint(this.acolor);
+    }
+    if (this.has_acolor2()) {
+      e.writeTag(10, 0);
+      e.writeNumberAsVarint(this.acolor2);
+    }
+    if (this.has_nodefault()) {
+      e.writeTag(11, 0);
+      e.writeNumberAsVarint(this.nodefault);
+    }
+    for (let elem of this.unpacked) {
+      e.writeTag(20, 0);
+      e.writeNumberAsVarint(elem);
+    }
+    if (this.packed.length > 0) {
+      const packed = new __pb__.Internal.Encoder();
+      for (let elem of this.packed) {
+        packed.writeNumberAsVarint(elem);
+      }
+      e.writeEncoder(packed, 21);
+    }
+    for (let elem of this.colors) {
+      e.writeTag(22, 0);
+      e.writeNumberAsVarint(elem);
+    }
+    {
+      const msg = this.agroup;
+      if (msg != null) {
+        let nested = new __pb__.Internal.Encoder();
+        msg.WriteTo(nested);
+        e.writeGroup(nested, 30);
+      }
+    }
+    {
+      const msg = this.nested;
+      if (msg != null) {
+        let nested = new __pb__.Internal.Encoder();
+        msg.WriteTo(nested);
+        e.writeEncoder(nested, 40);
+      }
+    }
+    e.writeUnknown(this.unknownFields);
+  }
+
+  MergeFromJSON(j: __pb__.JsonValue, o: __pb__.JsonOptions = {}): void {
+    const obj = __pb__.Internal.objectFromJSON(j);
+    for (const k in obj) {
+      const v = obj[k];
+      if (v === null) {
+        continue;
+      }
+      switch (k) {
+        case "arequired":
+        this.arequired = __pb__.Internal.int32FromJSON(v);
+        break;
+        case "aint32":
+        this.aint32 = __pb__.Internal.int32FromJSON(v);
+        break;
+        case "aint64":
+        this.aint64 = __pb__.Internal.int64FromJSON(v);
+        break;
+        case "auint64":
+        this.auint64 = __pb__.Internal.uint64FromJSON(v);
+        break;
+        case "adouble":
+        this.adouble = __pb__.Internal.doubleFromJSON(v);
+        break;
+        case "abool":
+        this.abool = __pb__.Internal.boolFromJSON(v);
+        break;
+        case "astring":
+        this.astring = __pb__.Internal.stringFromJSON(v);
+        break;
+        case "abytes":
+        this.abytes = __pb__.Internal.bytesFromJSON(v);
+        break;
+        case "acolor":
+        {
+          let e = ColorFromJSON(v, o);
+          if (e !== undefined) {
+            this.acolor = e;
+          }
+        }
+        break;
+        case "acolor2":
+        {
+          let e = ColorFromJSON(v, o);
+          if (e !== undefined) {
+            this.acolor2 = e;
+          }
+        }
+        break;
+        case "nodefault":
+        this.nodefault = __pb__.Internal.int32FromJSON(v);
+        break;
+        case "unpacked":
+        for (const elem of __pb__.Internal.arrayFromJSON(v)) {
+          this.unpacked.push(__pb__.Internal.int32FromJSON(elem));
+        }
+        break;
+        case "packed":
+        for (const elem of __pb__.Internal.arrayFromJSON(v)) {
+          this.packed.push(__pb__.Internal.int32FromJSON(elem));
+        }
+        break;
+        case "colors":
+        for (const elem of __pb__.Internal.arrayFromJSON(v)) {
+          {
+            let e = ColorFromJSON(elem, o);
+            if (e !== undefined) {
+              this.colors.push(e);
+            }
+          }
+        }
+        break;
+        case "agroup":
+        if (this.agroup == null) this.agroup = new example4.AGroup();
+        this.agroup.MergeFromJSON(v, o);
+        break;
+        case "nested":
+        if (this.nested == null) this.nested = new example4();
+        this.nested.MergeFromJSON(v, o);
+        break;
+        default:
+        __pb__.Internal.unknownFieldFromJSON(k, o);
+      }
+    }
+  }
+
+  ToJSON(o: __pb__.JsonOptions = {}): __pb__.JsonValue {
+    const j: __pb__.JsonObject = {};
+    if (o.emitDefaults || this.has_arequired()) {
+      j["arequired"] = this.arequired;
+    }
+    if (o.emitDefaults || this.has_aint32()) {
+      j["aint32"] = this.aint32;
+    }
+    if (o.emitDefaults || this.has_aint64()) {
+      j["aint64"] = this.aint64.toString();
+    }
+    if (o.emitDefaults || this.has_auint64()) {
+      j["auint64"] = this.auint64.toString();
+    }
+    if (o.emitDefaults || this.has_adouble()) {
+      j["adouble"] = __pb__.Internal.floatToJSON(this.adouble);
+    }
+    if (o.emitDefaults || this.has_abool()) {
+      j["abool"] = this.abool;
+    }
+    if (o.emitDefaults || this.has_astring()) {
+      j["astring"] = this.astring;
+    }
+    if (o.emitDefaults || this.has_abytes()) {
+      j["abytes"] = __pb__.Internal.bytesToJSON(this.abytes);
+    }
+    if (o.emitDefaults || this.has_acolor()) {
+      j["acolor"] = ColorToJSON(this.acolor);
+    }
+    if (o.emitDefaults || this.has_acolor2()) {
+      j["acolor2"] = ColorToJSON(this.acolor2);
+    }
+    if (o.emitDefaults || this.has_nodefault()) {
+      j["nodefault"] = this.nodefault;
+    }
+    if (o.emitDefaults || this.unpacked.length > 0) {
+      j["unpacked"] = this.unpacked.slice();
+    }
+    if (o.emitDefaults || this.packed.length > 0) {
+      j["packed"] = this.packed.slice();
+    }
+    if (o.emitDefaults || this.colors.length > 0) {
+      j["colors"] = this.colors.map(elem => ColorToJSON(elem));
+    }
+    if (o.emitDefaults || this.agroup != null) {
+      const msg = this.agroup;
+      j["agroup"] = msg == null ? null : msg.ToJSON(o);
+    }
+    if (o.emitDefaults || this.nested != null) {
+      const msg = this.nested;
+      j["nested"] = msg == null ? null : msg.ToJSON(o);
+    }
+    return j;
+  }
+
+  // toObject returns the message as a plain object, holding no classes.
+  toObject(): Iexample4 {
+    const o: Iexample4 = {};
+    if (this.has_arequired()) {
+      o.arequired = this.arequired;
+    }
+    if (this.has_aint32()) {
+      o.aint32 = this.aint32;
+    }
+    if (this.has_aint64()) {
+      o.aint64 = this.aint64.toString();
+    }
+    if (this.has_auint64()) {
+      o.auint64 = this.auint64.toString();
+    }
+    if (this.has_adouble()) {
+      o.adouble = this.adouble;
+    }
+    if (this.has_abool()) {
+      o.abool = this.abool;
+    }
+    if (this.has_astring()) {
+      o.astring = this.astring;
+    }
+    if (this.has_abytes()) {
+      o.abytes = __pb__.Internal.bytesToJSON(this.abytes);
+    }
+    if (this.has_acolor()) {
+      o.acolor = this.acolor;
+    }
+    if (this.has_acolor2()) {
+      o.acolor2 = this.acolor2;
+    }
+    if (this.has_nodefault()) {
+      o.nodefault = this.nodefault;
+    }
+    o.unpacked = this.unpacked.slice();
+    o.packed = this.packed.slice();
+    o.colors = this.colors.slice();
+    if (this.agroup != null) {
+      o.agroup = this.agroup.toObject();
+    }
+    if (this.nested != null) {
+      o.nested = this.nested.toObject();
+    }
+    return o;
+  }
+
+  // fromObject returns a message from its plain object form.
+  static fromObject(o: Iexample4): example4 {
+    const m = new example4();
+    if (o.arequired !== undefined) m.arequired = o.arequired;
+    if (o.aint32 !== undefined) m.aint32 = o.aint32;
+    if (o.aint64 !== undefined) m.aint64 = __longFromString(o.aint64, false);
+    if (o.auint64 !== undefined) m.auint64 = __longFromString(o.auint64, true);
+    if (o.adouble !== undefined) m.adouble = o.adouble;
+    if (o.abool !== undefined) m.abool = o.abool;
+    if (o.astring !== undefined) m.astring = o.astring;
+    if (o.abytes !== undefined) m.abytes = __pb__.Internal.bytesFromJSON(o.abytes);
+    if (o.acolor !== undefined) m.acolor = o.acolor;
+    if (o.acolor2 !== undefined) m.acolor2 = o.acolor2;
+    if (o.nodefault !== undefined) m.nodefault = o.nodefault;
+    if (o.unpacked !== undefined) m.unpacked = o.unpacked.slice();
+    if (o.packed !== undefined) m.packed = o.packed.slice();
+    if (o.colors !== undefined) m.colors = o.colors.slice();
+    if (o.agroup !== undefined) m.agroup = example4.AGroup.fromObject(o.agroup);
+    if (o.nested !== undefined) m.nested = example4.fromObject(o.nested);
+    return m;
+  }
+
+  // equals reports whether other holds the same values as the message.
+  equals(other: example4): boolean {
+    if (this === other) return true;
+    if (!(this.has_arequired() === other.has_arequired() && this.arequired === other.arequired)) return false;
+    if (!(this.has_aint32() === other.has_aint32() && this.aint32 === other.aint32)) return false;
+    if (!(this.has_aint64() === other.has_aint64() && this.aint64.equals(other.aint64))) return false;
+    if (!(this.has_auint64() === other.has_auint64() && this.auint64.equals(other.auint64))) return false;
+    if (!(this.has_adouble() === other.has_adouble() && __pb__.Internal.floatEqual(this.adouble, other.adouble))) return false;
+    if (!(this.has_abool() === other.has_abool() && this.abool === other.abool)) return false;
+    if (!(this.has_astring() === other.has_astring() && this.astring === other.astring)) return false;
+    if (!(this.has_abytes() === other.has_abytes() && __pb__.Internal.bytesEqual(this.abytes, other.abytes))) return false;
+    if (!(this.has_acolor() === other.has_acolor() && this.acolor === other.acolor)) return false;
+    if (!(this.has_acolor2() === other.has_acolor2() && this.acolor2 === other.acolor2)) return false;
+    if (!(this.has_nodefault() === other.has_nodefault() && this.nodefault === other.nodefault)) return false;
+    if (!__pb__.Internal.arrayEqual(this.unpacked, other.unpacked)) return false;
+    if (!__pb__.Internal.arrayEqual(this.packed, other.packed)) return false;
+    if (!__pb__.Internal.arrayEqual(this.colors, other.colors)) return false;
+    if (!__pb__.Internal.optionalEqual(this.agroup, other.agroup, (x, y) => x.equals(y))) return false;
+    if (!__pb__.Internal.optionalEqual(this.nested, other.nested, (x, y) => x.equals(y))) return false;
+    if (!__pb__.Internal.unknownEqual(this.unknownFields, other.unknownFields)) return false;
+    return true;
+  }
+
+  // clone returns a deep copy of the message.
+  clone(): example4 {
+    const m = new example4();
+    if (this.has_arequired()) m.arequired = this.arequired;
+    if (this.has_aint32()) m.aint32 = this.aint32;
+    if (this.has_aint64()) m.aint64 = this.aint64;
+    if (this.has_auint64()) m.auint64 = this.auint64;
+    if (this.has_adouble()) m.adouble = this.adouble;
+    if (this.has_abool()) m.abool = this.abool;
+    if (this.has_astring()) m.astring = this.astring;
+    if (this.has_abytes()) m.abytes = this.abytes.slice();
+    if (this.has_acolor()) m.acolor = this.acolor;
+    if (this.has_acolor2()) m.acolor2 = this.acolor2;
+    if (this.has_nodefault()) m.nodefault = this.nodefault;
+    m.unpacked = this.unpacked.slice();
+    m.packed = this.packed.slice();
+    m.colors = this.colors.slice();
+    m.agroup = this.agroup == null ? null : this.agroup.clone();
+    m.nested = this.nested == null ? null : this.nested.clone();
+    m.unknownFields = this.unknownFields.map(u => u.slice());
+    return m;
+  }
+
+  // hashCode returns a hash of the message's values, which is equal for
+  // equal messages and stable across runs.
+  hashCode(): number {
+    let h = 0;
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashNumber(this.arequired));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashNumber(this.aint32));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashLong(this.aint64));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashLong(this.auint64));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashNumber(this.adouble));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashBool(this.abool));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashString(this.astring));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashBytes(this.abytes));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashNumber(this.acolor));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashNumber(this.acolor2));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashNumber(this.nodefault));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashArray(this.unpacked, __pb__.Internal.hashNumber));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashArray(this.packed, __pb__.Internal.hashNumber));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashArray(this.colors, __pb__.Internal.hashNumber));
+    h = __pb__.Internal.hashCombine(h, this.agroup == null ? 0 : this.agroup.hashCode());
+    h = __pb__.Internal.hashCombine(h, this.nested == null ? 0 : this.nested.hashCode());
+    return h;
+  }
+}
+
+export namespace example4 {
+  export interface AGroupInit {
+    astring?: string;
+  }
+
+  export interface IAGroup {
+    astring?: string;
+  }
+
+  export class AGroup implements __pb__.Message {
+    static readonly typeName = "foo.proto2.example4.AGroup";
+
+    static readonly fields: __pb__.FieldInfo[] = [
+      { name: "astring", number: 31, type: __pb__.FieldType.STRING, label: __pb__.FieldLabel.OPTIONAL, jsonName: "astring", member: "astring" },
+    ];
+
+    private __astring: string | undefined;
+    // The encoding of fields which were not recognized when decoding.
+    unknownFields: Uint8Array[];
+
+    constructor(init?: AGroupInit) {
+      this.__astring = undefined;
+      this.unknownFields = [];
+      if (init !== undefined) {
+        if (init.astring !== undefined) this.astring = init.astring;
+      }
+    }
+
+    get astring(): string {
+      return this.__astring === undefined ? "" : this.__astring;
+    }
+
+    set astring(v: string) {
+      this.__astring = v;
+    }
+
+    has_astring(): boolean {
+      return this.__astring !== undefined;
+    }
+
+    clear_astring(): void {
+      this.__astring = undefined;
+    }
+
+    MergeFrom(d: __pb__.Internal.Decoder): void {
+      while (!d.isEOF()) {
+        let [fn, wt] = d.readTag();
+        switch(fn) {
+          case 31:
+          this.astring = d.readString();
+          break;
+          default:
+          this.unknownFields.push(d.readUnknown(wt, fn));
+        }
+      }
+    }
+
+    WriteTo(e: __pb__.Internal.Encoder): void {
+      if (this.has_astring()) {
+        e.writeTag(31, 2);
+        e.writeString(this.astring);
+      }
+      e.writeUnknown(this.unknownFields);
+    }
+
+    MergeFromJSON(j: __pb__.JsonValue, o: __pb__.JsonOptions = {}): void {
+      const obj = __pb__.Internal.objectFromJSON(j);
+      for (const k in obj) {
+        const v = obj[k];
+        if (v === null) {
+          continue;
+        }
+        switch (k) {
+          case "astring":
+          this.astring = __pb__.Internal.stringFromJSON(v);
+          break;
+          default:
+          __pb__.Internal.unknownFieldFromJSON(k, o);
+        }
+      }
+    }
+
+    ToJSON(o: __pb__.JsonOptions = {}): __pb__.JsonValue {
+      const j: __pb__.JsonObject = {};
+      if (o.emitDefaults || this.has_astring()) {
+        j["astring"] = this.astring;
+      }
+      return j;
+    }
+
+    // toObject returns the message as a plain object, holding no classes.
+    toObject(): IAGroup {
+      const o: IAGroup = {};
+      if (this.has_astring()) {
+        o.astring = this.astring;
+      }
+      return o;
+    }
+
+    // fromObject returns a message from its plain object form.
+    static fromObject(o: IAGroup): AGroup {
+      const m = new AGroup();
+      if (o.astring !== undefined) m.astring = o.astring;
+      return m;
+    }
+
+    // equals reports whether other holds the same values as the message.
+    equals(other: AGroup): boolean {
+      if (this === other) return true;
+      if (!(this.has_astring() === other.has_astring() && this.astring === other.astring)) return false;
+      if (!__pb__.Internal.unknownEqual(this.unknownFields, other.unknownFields)) return false;
+      return true;
+    }
+
+    // clone returns a deep copy of the message.
+    clone(): AGroup {
+      const m = new AGroup();
+      if (this.has_astring()) m.astring = this.astring;
+      m.unknownFields = this.unknownFields.map(u => u.slice());
+      return m;
+    }
+
+    // hashCode returns a hash of the message's values, which is equal for
+    // equal messages and stable across runs.
+    hashCode(): number {
+      let h = 0;
+      h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashString(this.astring));
+      return h;
+    }
+  }
+}
+
+// fileDescriptor is the google.protobuf.FileDescriptorProto of example4.proto.
+export const fileDescriptor = new __pb__.FileDescriptor(
+  "example4.proto",
+  "Cg5leGFtcGxlNC5wcm90bxIKZm9vLnByb3RvMiLxBAoIZXhhbXBsZTQSHAoJYXJlcXVpcmVkGAEg" +
+    "AigFUglhcmVxdWlyZWQSGgoGYWludDMyGAIgASgFOgItN1IGYWludDMyEiUKBmFpbnQ2NBgDIAEo" +
+    "AzoNMTIzNDU2Nzg5MDEyM1IGYWludDY0EhwKB2F1aW50NjQYBCABKAQ6AjQyUgdhdWludDY0Eh0K" +
+    "B2Fkb3VibGUYBSABKAE6A2luZlIHYWRvdWJsZRIaCgVhYm9vbBgGIAEoCDoEdHJ1ZVIFYWJvb2wS" +
+    "JAoHYXN0cmluZxgHIAEoCToKaGkgInRoZXJlIlIHYXN0cmluZxIeCgZhYnl0ZXMYCCABKAw6BmFc" +
+    "MDAxYlIGYWJ5dGVzEjAKBmFjb2xvchgJIAEoDjIRLmZvby5wcm90bzIuQ29sb3I6BUdSRUVOUgZh" +
+    "Y29sb3ISKwoHYWNvbG9yMhgKIAEoDjIRLmZvby5wcm90bzIuQ29sb3JSB2Fjb2xvcjISHAoJbm9k" +
+    "ZWZhdWx0GAsgASgFUglub2RlZmF1bHQSGgoIdW5wYWNrZWQYFCADKAVSCHVucGFja2VkEhoKBnBh" +
+    "Y2tlZBgVIAMoBUICEAFSBnBhY2tlZBIpCgZjb2xvcnMYFiADKA4yES5mb28ucHJvdG8yLkNvbG9y" +
+    "UgZjb2xvcnMSMwoGYWdyb3VwGB4gASgKMhsuZm9vLnByb3RvMi5leGFtcGxlNC5BR3JvdXBSBmFn" +
+    "cm91cBIsCgZuZXN0ZWQYKCABKAsyFC5mb28ucHJvdG8yLmV4YW1wbGU0UgZuZXN0ZWQaIgoGQUdy" +
+    "b3VwEhgKB2FzdHJpbmcYHyABKAlSB2FzdHJpbmcqJQoFQ29sb3ISBwoDUkVEEAESCQoFR1JFRU4Q" +
+    "AhIICgRCTFVFEANKxw0KBhIEAAAjAQoICgEMEgMAABIKCAoBAhIDAgATCgoKAgUAEgQEAAgBCgoK" +
+    "AwUAARIDBAUKCgsKBAUAAgASAwUCCgoMCgUFAAIAARIDBQIFCgwKBQUAAgACEgMFCAkKCwoEBQAC" +
+    "ARIDBgIMCgwKBQUAAgEBEgMGAgcKDAoFBQACAQISAwYKCwoLCgQFAAICEgMHAgsKDAoFBQACAgES" +
+    "AwcCBgoMCgUFAAICAhIDBwkKCgoKAgQAEgQKACMBCgoKAwQAARIDCggQCgsKBAQAAgASAwsCHwoM" +
+    "CgUEAAIABBIDCwIKCgwKBQQAAgAFEgMLCxAKDAoFBAACAAESAwsRGgoMCgUEAAIAAxIDCx0eCi4K" +
+    "BAQAAgESAw4CKxohIFByZXNlbmNlIGFuZCBleHBsaWNpdCBkZWZhdWx0cy4KCgwKBQQAAgEEEgMO" +
+    "AgoKDAoFBAACAQUSAw4LEAoMCgUEAAIBARIDDhEXCgwKBQQAAgEDEgMOGhsKDAoFBAACAQgSAw4c" +
+    "KgoMCgUEAAIBBxIDDh0pCgsKBAQAAgISAw8CNgoMCgUEAAICBBIDDwIKCgwKBQQAAgIFEgMPCxAK" +
+    "DAoFBAACAgESAw8RFwoMCgUEAAICAxIDDxobCgwKBQQAAgIIEgMPHDUKDAoFBAACAgcSAw8dNAoL" +
+    "CgQEAAIDEgMQAi0KDAoFBAACAwQSAxACCgoMCgUEAAIDBRIDEAsRCgwKBQQAAgMBEgMQEhkKDAoF" +
+    "BAACAwMSAxAcHQoMCgUEAAIDCBIDEB4sCgwKBQQAAgMHEgMQHysKCwoEBAACBBIDEQIuCgwKBQQA" +
+    "AgQEEgMRAgoKDAoFBAACBAUSAxELEQoMCgUEAAIEARIDERIZCgwKBQQAAgQDEgMRHB0KDAoFBAAC" +
+    "BAgSAxEeLQoMCgUEAAIEBxIDER8sCgsKBAQAAgUSAxICKwoMCgUEAAIFBBIDEgIKCgwKBQQAAgUF" +
+    "EgMSCw8KDAoFBAACBQESAxIQFQoMCgUEAAIFAxIDEhgZCgwKBQQAAgUIEgMSGioKDAoFBAACBQcS" +
+    "AxIbKQoLCgQEAAIGEgMTAjkKDAoFBAACBgQSAxMCCgoMCgUEAAIGBRIDEwsRCgwKBQQAAgYBEgMT" +
+    "EhkKDAoFBAACBgMSAxMcHQoMCgUEAAIGCBIDEx44CgwKBQQAAgYHEgMTHzcKCwoEBAACBxIDFAIx" +
+    "CgwKBQQAAgcEEgMUAgoKDAoFBAACBwUSAxQLEAoMCgUEAAIHARIDFBEXCgwKBQQAAgcDEgMUGhsK" +
+    "DAoFBAACBwgSAxQcMAoMCgUEAAIHBxIDFB0vCgsKBAQAAggSAxUCLgoMCgUEAAIIBBIDFQIKCgwK" +
+    "BQQAAggGEgMVCxAKDAoFBAACCAESAxURFwoMCgUEAAIIAxIDFRobCgwKBQQAAggIEgMVHC0KDAoF" +
+    "BAACCAcSAxUdLAoLCgQEAAIJEgMWAh4KDAoFBAACCQQSAxYCCgoMCgUEAAIJBhIDFgsQCgwKBQQA" +
+    "AgkBEgMWERgKDAoFBAACCQMSAxYbHQoLCgQEAAIKEgMXAiAKDAoFBAACCgQSAxcCCgoMCgUEAAIK" +
+    "BRIDFwsQCgwKBQQAAgoBEgMXERoKDAoFBAACCgMSAxcdHwohCgQEAAILEgMaAh8aFCBQYWNraW5n" +
+    "IGlzIG9wdC1pbi4KCgwKBQQAAgsEEgMaAgoKDAoFBAACCwUSAxoLEAoMCgUEAAILARIDGhEZCgwK" +
+    "BQQAAgsDEgMaHB4KCwoEBAACDBIDGwItCgwKBQQAAgwEEgMbAgoKDAoFBAACDAUSAxsLEAoMCgUE" +
+    "AAIMARIDGxEXCgwKBQQAAgwDEgMbGhwKDAoFBAACDAgSAxsdLAoNCgYEAAIMCAISAxseKwoLCgQE" +
+    "AAINEgMcAh0KDAoFBAACDQQSAxwCCgoMCgUEAAINBhIDHAsQCgwKBQQAAg0BEgMcERcKDAoFBAAC" +
+    "DQMSAxwaHAoMCgQEAAIOEgQeAiADCgwKBQQAAg4EEgMeAgoKDAoFBAACDgUSAx4LEAoMCgUEAAIO" +
+    "ARIDHhEXCgwKBQQAAg4DEgMeGhwKDAoEBAADABIEHgIgAwoMCgUEAAMAARIDHhEXCgwKBQQAAg4G" +
+    "EgMeERcKDQoGBAADAAIAEgMfBCEKDgoHBAADAAIABBIDHwQMCg4KBwQAAwACAAUSAx8NEwoOCgcE" +
+    "AAMAAgABEgMfFBsKDgoHBAADAAIAAxIDHx4gCgsKBAQAAg8SAyICIAoMCgUEAAIPBBIDIgIKCgwK" +
+    "BQQAAg8GEgMiCxMKDAoFBAACDwESAyIUGgoMCgUEAAIPAxIDIh0f",
+  []
+);
+
+__pb__.globalRegistry.add(example4);
+__pb__.globalRegistry.add(example4.AGroup);
+__pb__.globalRegistry.addEnum(ColorInfo);
+__pb__.globalRegistry.addFile(fileDescriptor);
@@ -0,0 +1,423 @@
+// Generated by the protocol buffer compiler.  DO NOT EDIT!
+// Source: example5.proto
+
+import * as __pb__ from '../../lib/protobuf'
+
+
+/**
+ * A kind.
+ */
+export const enum Kind {
+  KIND_UNSPECIFIED = 0,
+  /**
+   * The first kind.
+   */
+  KIND_A = 1,
+}
+
+export function KindToJSON(v: Kind): string | number {
+  switch (v as number) {
+    case 0:
+    return "KIND_UNSPECIFIED";
+    case 1:
+    return "KIND_A";
+  }
+  return v;
+}
+
+export function KindFromJSON(v: __pb__.JsonValue, o: __pb__.JsonOptions = {}): Kind | undefined {
+  switch (v) {
+    case "KIND_UNSPECIFIED":
+    return Kind.KIND_UNSPECIFIED;
+    case "KIND_A":
+    return Kind.KIND_A;
+  }
+  return __pb__.Internal.enumFromJSON(v, o);
+}
+
+export const KindInfo: __pb__.EnumInfo = {
+  typeName: "foo.optional.Kind",
+  values: [
+    { name: "KIND_UNSPECIFIED", number: 0 },
+    { name: "KIND_A", number: 1 },
+  ],
+};
+
+export interface example5Init {
+  /**
+   * Tracks presence.
+   */
+  aint32?: number;
+  astring?: string;
+  akind?: Kind;
+  nested?: example5 | example5Init;
+  implicit?: number;
+  /**
+   * Either the string or nothing.
+   */
+  aoneof?: example5.aoneof.oneof_type;
+  oostring?: string;
+}
+
+export interface Iexample5 {
+  /**
+   * Tracks presence.
+   */
+  aint32?: number;
+  astring?: string;
+  akind?: Kind;
+  nested?: Iexample5;
+  implicit?: number;
+  oostring?: string;
+}
+
+/**
+ * Comments are generated as TSDoc.
+ *
+ * Presence of proto3 optional fields.
+ * Comment markers *\/ and tags \@like {\@link this} are escaped.
+ */
+export class example5 implements __pb__.Message {
+  static readonly typeName = "foo.optional.example5";
+
+  static readonly fields: __pb__.FieldInfo[] = [
+    { name: "aint32", number: 1, type: __pb__.FieldType.INT32, label: __pb__.FieldLabel.OPTIONAL, jsonName: "aint32", member: "aint32" },
+    { name: "astring", number: 2, type: __pb__.FieldType.STRING, label: __pb__.FieldLabel.OPTIONAL, jsonName: "astring", member: "astring" },
+    { name: "akind", number: 3, type: __pb__.FieldType.ENUM, label: __pb__.FieldLabel.OPTIONAL, jsonName: "akind", member: "akind", enumType: () => KindInfo },
+    { name: "nested", number: 4, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.OPTIONAL, jsonName: "nested", member: "nested", messageType: () => example5 },
+    { name: "implicit", number: 5, type: __pb__.FieldType.INT32, label: __pb__.FieldLabel.OPTIONAL, jsonName: "implicit", member: "implicit" },
+    { name: "oostring", number: 10, type: __pb__.FieldType.STRING, label: __pb__.FieldLabel.OPTIONAL, jsonName: "oostring", member: "aoneof", oneof: "aoneof", oneofCase: () => example5.aoneof.oostring },
+  ];
+
+  private __aint32: number | undefined;
+  private __astring: string | undefined;
+  private __akind: Kind | undefined;
+  nested: example5 | null;
+  implicit: number;
+  /**
+   * Either the string or nothing.
+   */
+  aoneof: example5.aoneof.oneof_type;
+  // The encoding of fields which were not recognized when decoding.
+  unknownFields: Uint8Array[];
+
+  constructor(init?: example5Init) {
+    this.__aint32 = undefined;
+    this.__astring = undefined;
+    this.__akind = undefined;
+    this.nested = null;
+    this.implicit = 0;
+    this.aoneof = __pb__.OneofNotSet.singleton;
+    this.unknownFields = [];
+    if (init !== undefined) {
+      if (init.aint32 !== undefined) this.aint32 = init.aint32;
+      if (init.astring !== undefined) this.astring = init.astring;
+      if (init.akind !== undefined) this.akind = init.akind;
+      if (init.nested !== undefined) this.nested = __pb__.Internal.fromInit(example5, init.nested);
+      if (init.implicit !== undefined) this.implicit = init.implicit;
+      if (init.aoneof !== undefined) this.aoneof = init.aoneof;
+      if (init.oostring !== undefined) this.aoneof = new example5.aoneof.oostring(init.oostring);
+    }
+  }
+
+  /**
+   * Tracks presence.
+   */
+  get aint32(): number {
+    return this.__aint32 === undefined ? 0 : this.__aint32;
+  }
+
+  set aint32(v: number) {
+    this.__aint32 = v;
+  }
+
+  has_aint32(): boolean {
+    return this.__aint32 !== undefined;
+  }
+
+  clear_aint32(): void {
+    this.__aint32 = undefined;
+  }
+
+  get astring(): string {
+    return this.__astring === undefined ? "" : this.__astring;
+  }
+
+  set astring(v: string) {
+    this.__astring = v;
+  }
+
+  has_astring(): boolean {
+    return this.__astring !== undefined;
+  }
+
+  clear_astring(): void {
+    this.__astring = undefined;
+  }
+
+  get akind(): Kind {
+    return this.__akind === undefined ? 0 : this.__akind;
+  }
+
+  set akind(v: Kind) {
+    this.__akind = v;
+  }
+
+  has_akind(): boolean {
+    return this.__akind !== undefined;
+  }
+
+  clear_akind(): void {
+    this.__akind = undefined;
+  }
+
+  MergeFrom(d: __pb__.Internal.Decoder): void {
+    while (!d.isEOF()) {
+      let [fn, wt] = d.readTag();
+      switch(fn) {
+        case 1:
+        this.aint32 = d.readVarInt32();
+        break;
+        case 2:
+        this.astring = d.readValidString();
+        break;
+        case 3:
+        this.akind = d.readVarintSignedAsNumber();
+        break;
+        case 4:
+        if (this.nested == null) this.nested = new example5();
+        this.nested.MergeFrom(d.readDecoder());
+        break;
+        case 5:
+        this.implicit = d.readVarInt32();
+        break;
+        case 10:
+        this.aoneof = new example5.aoneof.oostring(d.readValidString());
+        break;
+        default:
+        this.unknownFields.push(d.readUnknown(wt, fn));
+      }
+    }
+  }
+
+  WriteTo(e: __pb__.Internal.Encoder): void {
+    if (this.has_aint32()) {
+      e.writeTag(1, 0);
+      e.writeNumberAsVarint(this.aint32);
+    }
+    if (this.has_astring()) {
+      e.writeTag(2, 2);
+      e.writeString(this.astring);
+    }
+    if (this.has_akind()) {
+      e.writeTag(3, 0);
+      e.writeNumberAsVarint(this.akind);
+    }
+    {
+      const msg = this.nested;
+      if (msg != null) {
+        let nested = new __pb__.Internal.Encoder();
+        msg.WriteTo(nested);
+        e.writeEncoder(nested, 4);
+      }
+    }
+    if (this.implicit != 0) {
+      e.writeTag(5, 0);
+      e.writeNumberAsVarint(this.implicit);
+    }
+    example5.aoneof.WriteTo(this.aoneof, e);
+    e.writeUnknown(this.unknownFields);
+  }
+
+  MergeFromJSON(j: __pb__.JsonValue, o: __pb__.JsonOptions = {}): void {
+    const obj = __pb__.Internal.objectFromJSON(j);
+    for (const k in obj) {
+      const v = obj[k];
+      if (v === null) {
+        continue;
+      }
+      switch (k) {
+        case "aint32":
+        this.aint32 = __pb__.Internal.int32FromJSON(v);
+        break;
+        case "astring":
+        this.astring = __pb__.Internal.stringFromJSON(v);
+        break;
+        case "akind":
+        {
+          let e = KindFromJSON(v, o);
+          if (e !== undefined) {
+            this.akind = e;
+          }
+        }
+        break;
+        case "nested":
+        if (this.nested == null) this.nested = new example5();
+        this.nested.MergeFromJSON(v, o);
+        break;
+        case "implicit":
+        this.implicit = __pb__.Internal.int32FromJSON(v);
+        break;
+        case "oostring":
+        this.aoneof = new example5.aoneof.oostring(__pb__.Internal.stringFromJSON(v));
+        break;
+        default:
+        __pb__.Internal.unknownFieldFromJSON(k, o);
+      }
+    }
+  }
+
+  ToJSON(o: __pb__.JsonOptions = {}): __pb__.JsonValue {
+    const j: __pb__.JsonObject = {};
+    if (o.emitDefaults || this.has_aint32()) {
+      j["aint32"] = this.aint32;
+    }
+    if (o.emitDefaults || this.has_astring()) {
+      j["astring"] = this.astring;
+    }
+    if (o.emitDefaults || this.has_akind()) {
+      j["akind"] = KindToJSON(this.akind);
+    }
+    if (o.emitDefaults || this.nested != null) {
+      const msg = this.nested;
+      j["nested"] = msg == null ? null : msg.ToJSON(o);
+    }
+    if (o.emitDefaults || this.implicit != 0) {
+      j["implicit"] = this.implicit;
+    }
+    switch (this.aoneof.kind) {
+      case 10:
+      j["oostring"] = (this.aoneof as example5.aoneof.oostring).value;
+      break;
+    }
+    return j;
+  }
+
+  // toObject returns the message as a plain object, holding no classes.
+  toObject(): Iexample5 {
+    const o: Iexample5 = {};
+    if (this.has_aint32()) {
+      o.aint32 = this.aint32;
+    }
+    if (this.has_astring()) {
+      o.astring = this.astring;
+    }
+    if (this.has_akind()) {
+      o.akind = this.akind;
+    }
+    if (this.nested != null) {
+      o.nested = this.nested.toObject();
+    }
+    o.implicit = this.implicit;
+    if (this.aoneof instanceof example5.aoneof.oostring) {
+      o.oostring = this.aoneof.value;
+    }
+    return o;
+  }
+
+  // fromObject returns a message from its plain object form.
+  static fromObject(o: Iexample5): example5 {
+    const m = new example5();
+    if (o.aint32 !== undefined) m.aint32 = o.aint32;
+    if (o.astring !== undefined) m.astring = o.astring;
+    if (o.akind !== undefined) m.akind = o.akind;
+    if (o.nested !== undefined) m.nested = example5.fromObject(o.nested);
+    if (o.implicit !== undefined) m.implicit = o.implicit;
+    if (o.oostring !== undefined) m.aoneof = new example5.aoneof.oostring(o.oostring);
+    return m;
+  }
+
+  // equals reports whether other holds the same values as the message.
+  equals(other: example5): boolean {
+    if (this === other) return true;
+    if (!(this.has_aint32() === other.has_aint32() && this.aint32 === other.aint32)) return false;
+    if (!(this.has_astring() === other.has_astring() && this.astring === other.astring)) return false;
+    if (!(this.has_akind() === other.has_akind() && this.akind === other.akind)) return false;
+    if (!__pb__.Internal.optionalEqual(this.nested, other.nested, (x, y) => x.equals(y))) return false;
+    if (this.implicit !== other.implicit) return false;
+    if (this.aoneof.kind !== other.aoneof.kind) return false;
+    if (this.aoneof instanceof example5.aoneof.oostring && other.aoneof instanceof example5.aoneof.oostring && this.aoneof.value !== other.aoneof.value) return false;
+    if (!__pb__.Internal.unknownEqual(this.unknownFields, other.unknownFields)) return false;
+    return true;
+  }
+
+  // clone returns a deep copy of the message.
+  clone(): example5 {
+    const m = new example5();
+    if (this.has_aint32()) m.aint32 = this.aint32;
+    if (this.has_astring()) m.astring = this.astring;
+    if (this.has_akind()) m.akind = this.akind;
+    m.nested = this.nested == null ? null : this.nested.clone();
+    m.implicit = this.implicit;
+    if (this.aoneof instanceof example5.aoneof.oostring) m.aoneof = new example5.aoneof.oostring(this.aoneof.value);
+    m.unknownFields = this.unknownFields.map(u => u.slice());
+    return m;
+  }
+
+  // hashCode returns a hash of the message's values, which is equal for
+  // equal messages and stable across runs.
+  hashCode(): number {
+    let h = 0;
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashNumber(this.aint32));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashString(this.astring));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashNumber(this.akind));
+    h = __pb__.Internal.hashCombine(h, this.nested == null ? 0 : this.nested.hashCode());
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashNumber(this.implicit));
+    h = __pb__.Internal.hashCombine(h, this.aoneof.kind);
+    if (this.aoneof instanceof example5.aoneof.oostring) h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashString(this.aoneof.value));
+    return h;
+  }
+}
+
+export namespace example5.aoneof {
+  export class oostring {
+    static readonly kind = 10;
+    readonly kind = 10;
+    value: string;
+    constructor(v: string) {
+      this.value = v;
+    }
+  }
+
+  export type oneof_type = __pb__.OneofNotSet | oostring;
+
+  export function WriteTo(oo: oneof_type, e: __pb__.Internal.Encoder):void {
+    switch (oo.kind) {
+      case 10:
+      e.writeTag(10, 2);
+      e.writeString((oo as oostring).value);
+      return;
+    }
+  }
+}
+
+// fileDescriptor is the google.protobuf.FileDescriptorProto of example5.proto.
+export const fileDescriptor = new __pb__.FileDescriptor(
+  "example5.proto",
+  "Cg5leGFtcGxlNS5wcm90bxIMZm9vLm9wdGlvbmFsIpoCCghleGFtcGxlNRIbCgZhaW50MzIYASAB" +
+    "KAVIAVIGYWludDMyiAEBEh0KB2FzdHJpbmcYAiABKAlIAlIHYXN0cmluZ4gBARItCgVha2luZBgD" +
+    "IAEoDjISLmZvby5vcHRpb25hbC5LaW5kSANSBWFraW5kiAEBEjMKBm5lc3RlZBgEIAEoCzIWLmZv" +
+    "by5vcHRpb25hbC5leGFtcGxlNUgEUgZuZXN0ZWSIAQESGgoIaW1wbGljaXQYBSABKAVSCGltcGxp" +
+    "Y2l0EhwKCG9vc3RyaW5nGAogASgJSABSCG9vc3RyaW5nQggKBmFvbmVvZkIJCgdfYWludDMyQgoK" +
+    "CF9hc3RyaW5nQggKBl9ha2luZEIJCgdfbmVzdGVkKigKBEtpbmQSFAoQS0lORF9VTlNQRUNJRklF" +
+    "RBAAEgoKBktJTkRfQRABSpgGCgYSBAAAGQEKCAoBDBIDAAASCggKAQISAwIAFQoVCgIFABIEBQAI" +
+    "ARoJIEEga2luZC4KCgoKAwUAARIDBQUJCgsKBAUAAgASAwYCFwoMCgUFAAIAARIDBgISCgwKBQUA" +
+    "AgACEgMGFRYKHgoEBQACARIDBwINIhEgVGhlIGZpcnN0IGtpbmQuCgoMCgUFAAIBARIDBwIICgwK" +
+    "BQUAAgECEgMHCwwKkgEKAgQAEgQOABkBGmIgUHJlc2VuY2Ugb2YgcHJvdG8zIG9wdGlvbmFsIGZp" +
+    "ZWxkcy4KIENvbW1lbnQgbWFya2VycyAqLyBhbmQgdGFncyBAbGlrZSB7QGxpbmsgdGhpc30gYXJl" +
+    "IGVzY2FwZWQuCjIiIENvbW1lbnRzIGFyZSBnZW5lcmF0ZWQgYXMgVFNEb2MuCgoKCgMEAAESAw4I" +
+    "EAofCgQEAAIAEgMPAhwiEiBUcmFja3MgcHJlc2VuY2UuCgoMCgUEAAIABBIDDwIKCgwKBQQAAgAF" +
+    "EgMPCxAKDAoFBAACAAESAw8RFwoMCgUEAAIAAxIDDxobCgsKBAQAAgESAxACHgoMCgUEAAIBBBID" +
+    "EAIKCgwKBQQAAgEFEgMQCxEKDAoFBAACAQESAxASGQoMCgUEAAIBAxIDEBwdCgsKBAQAAgISAxEC" +
+    "GgoMCgUEAAICBBIDEQIKCgwKBQQAAgIGEgMRCw8KDAoFBAACAgESAxEQFQoMCgUEAAICAxIDERgZ" +
+    "CgsKBAQAAgMSAxICHwoMCgUEAAIDBBIDEgIKCgwKBQQAAgMGEgMSCxMKDAoFBAACAwESAxIUGgoM" +
+    "CgUEAAIDAxIDEh0eCgsKBAQAAgQSAxMCFQoMCgUEAAIEBRIDEwIHCgwKBQQAAgQBEgMTCBAKDAoF" +
+    "BAACBAMSAxMTFAotCgQEAAgAEgQWAhgDGh8gRWl0aGVyIHRoZSBzdHJpbmcgb3Igbm90aGluZy4K" +
+    "CgwKBQQACAABEgMWCA4KCwoEBAACBRIDFwQZCgwKBQQAAgUFEgMXBAoKDAoFBAACBQESAxcLEwoM" +
+    "CgUEAAIFAxIDFxYYYgZwcm90bzM=",
+  []
+);
+
+__pb__.globalRegistry.add(example5);
+__pb__.globalRegistry.addEnum(KindInfo);
+__pb__.globalRegistry.addFile(fileDescriptor);
@@ -0,0 +1,997 @@
+// Generated by the protocol buffer compiler.  DO NOT EDIT!
+// Source: example6.proto
+
+import * as __pb__ from '../../lib/protobuf'
+
+
+export const enum Closed {
+  CLOSED_ZERO = 0,
+  CLOSED_ONE = 1,
+}
+
+export function ClosedToJSON(v: Closed): string | number {
+  switch (v as number) {
+    case 0:
+    return "CLOSED_ZERO";
+    case 1:
+    return "CLOSED_ONE";
+  }
+  return v;
+}
+
+export function ClosedFromJSON(v: __pb__.JsonValue, o: __pb__.JsonOptions = {}): Closed | undefined {
+  switch (v) {
+    case "CLOSED_ZERO":
+    return Closed.CLOSED_ZERO;
+    case "CLOSED_ONE":
+    return Closed.CLOSED_ONE;
+  }
+  return __pb__.Internal.enumFromJSON(v, o);
+}
+
+export const ClosedInfo: __pb__.EnumInfo = {
+  typeName: "foo.editions.Closed",
+  values: [
+    { name: "CLOSED_ZERO", number: 0 },
+    { name: "CLOSED_ONE", number: 1 },
+  ],
+};
+
+export const enum Open {
+  OPEN_ZERO = 0,
+}
+
+export function OpenToJSON(v: Open): string | number {
+  switch (v as number) {
+    case 0:
+    return "OPEN_ZERO";
+  }
+  return v;
+}
+
+export function OpenFromJSON(v: __pb__.JsonValue, o: __pb__.JsonOptions = {}): Open | undefined {
+  switch (v) {
+    case "OPEN_ZERO":
+    return Open.OPEN_ZERO;
+  }
+  return __pb__.Internal.enumFromJSON(v, o);
+}
+
+export const OpenInfo: __pb__.EnumInfo = {
+  typeName: "foo.editions.Open",
+  values: [
+    { name: "OPEN_ZERO", number: 0 },
+  ],
+};
+
+export interface example6Init {
+  explicit?: number;
+  implicit?: number;
+  required?: number;
+  packed?: number[];
+  expanded?: number[];
+  aclosed?: Closed;
+  aopen?: Open;
+  verified?: string;
+  unverified?: string;
+  delimited?: example6.Inner | example6.InnerInit;
+  prefixed?: example6.Inner | example6.InnerInit;
+  /**
+   * Map values are length prefixed regardless of the file's features.
+   */
+  inners?: Map<string, example6.Inner | example6.InnerInit>;
+}
+
+export interface Iexample6 {
+  explicit?: number;
+  implicit?: number;
+  required?: number;
+  packed?: number[];
+  expanded?: number[];
+  aclosed?: Closed;
+  aopen?: Open;
+  verified?: string;
+  unverified?: string;
+  delimited?: example6.IInner;
+  prefixed?: example6.IInner;
+  /**
+   * Map values are length prefixed regardless of the file's features.
+   */
+  inners?: { [k: string]: example6.IInner };
+}
+
+export class example6 implements __pb__.Message {
+  static readonly typeName = "foo.editions.example6";
+
+  static readonly fields: __pb__.FieldInfo[] = [
+    { name: "explicit", number: 1, type: __pb__.FieldType.INT32, label: __pb__.FieldLabel.OPTIONAL, jsonName: "explicit", member: "explicit" },
+    { name: "implicit", number: 2, type: __pb__.FieldType.INT32, label: __pb__.FieldLabel.OPTIONAL, jsonName: "implicit", member: "implicit" },
+    { name: "required", number: 3, type: __pb__.FieldType.INT32, label: __pb__.FieldLabel.OPTIONAL, jsonName: "required", member: "required" },
+    { name: "packed", number: 4, type: __pb__.FieldType.INT32, label: __pb__.FieldLabel.REPEATED, jsonName: "packed", member: "packed" },
+    { name: "expanded", number: 5, type: __pb__.FieldType.INT32, label: __pb__.FieldLabel.REPEATED, jsonName: "expanded", member: "expanded" },
+    { name: "aclosed", number: 6, type: __pb__.FieldType.ENUM, label: __pb__.FieldLabel.OPTIONAL, jsonName: "aclosed", member: "aclosed", enumType: () => ClosedInfo },
+    { name: "aopen", number: 7, type: __pb__.FieldType.ENUM, label: __pb__.FieldLabel.OPTIONAL, jsonName: "aopen", member: "aopen", enumType: () => OpenInfo },
+    { name: "verified", number: 8, type: __pb__.FieldType.STRING, label: __pb__.FieldLabel.OPTIONAL, jsonName: "verified", member: "verified" },
+    { name: "unverified", number: 9, type: __pb__.FieldType.STRING, label: __pb__.FieldLabel.OPTIONAL, jsonName: "unverified", member: "unverified" },
+    { name: "delimited", number: 10, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.OPTIONAL, jsonName: "delimited", member: "delimited", messageType: () => example6.Inner },
+    { name: "prefixed", number: 11, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.OPTIONAL, jsonName: "prefixed", member: "prefixed", messageType: () => example6.Inner },
+    { name: "inners", number: 12, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.REPEATED, jsonName: "inners", member: "inners", map: { key: __pb__.FieldType.STRING, value: __pb__.FieldType.MESSAGE }, messageType: () => example6.Inner },
+  ];
+
+  private __explicit: number | undefined;
+  implicit: number;
+  private __required: number | undefined;
+  packed: number[];
+  expanded: number[];
+  private __aclosed: Closed | undefined;
+  private __aopen: Open | undefined;
+  private __verified: string | undefined;
+  private __unverified: string | undefined;
+  delimited: example6.Inner | null;
+  prefixed: example6.Inner | null;
+  /**
+   * Map values are length prefixed regardless of the file's features.
+   */
+  inners: Map<string, example6.Inner>;
+  // The encoding of fields which were not recognized when decoding.
+  unknownFields: Uint8Array[];
+
+  constructor(init?: example6Init) {
+    this.__explicit = undefined;
+    this.implicit = 0;
+    this.__required = undefined;
+    this.packed = [];
+    this.expanded = [];
+    this.__aclosed = undefined;
+    this.__aopen = undefined;
+    this.__verified = undefined;
+    this.__unverified = undefined;
+    this.delimited = null;
+    this.prefixed = null;
+    this.inners = new Map<string, example6.Inner>();
+    this.unknownFields = [];
+    if (init !== undefined) {
+      if (init.explicit !== undefined) this.explicit = init.explicit;
+      if (init.implicit !== undefined) this.implicit = init.implicit;
+      if (init.required !== undefined) this.required = init.required;
+      if (init.packed !== undefined) this.packed = init.packed.slice();
+      if (init.expanded !== undefined) this.expanded = init.expanded.slice();
+      if (init.aclosed !== undefined) this.aclosed = init.aclosed;
+      if (init.aopen !== undefined) this.aopen = init.aopen;
+      if (init.verified !== undefined) this.verified = init.verified;
+      if (init.unverified !== undefined) this.unverified = init.unverified;
+      if (init.delimited !== undefined) this.delimited = __pb__.Internal.fromInit(example6.Inner, init.delimited);
+      if (init.prefixed !== undefined) this.prefixed = __pb__.Internal.fromInit(example6.Inner, init.prefixed);
+      if (init.inners !== undefined) {
+        for (const [k, v] of init.inners) {
+          this.inners.set(k, __pb__.Internal.fromInit(example6.Inner, v));
+        }
+      }
+    }
+  }
+
+  get explicit(): number {
+    return this.__explicit === undefined ? 0 : this.__explicit;
+  }
+
+  set explicit(v: number) {
+    this.__explicit = v;
+  }
+
+  has_explicit(): boolean {
+    return this.__explicit !== undefined;
+  }
+
+  clear_explicit(): void {
+    this.__explicit = undefined;
+  }
+
+  get required(): number {
+    return this.__required === undefined ? 0 : this.__required;
+  }
+
+  set required(v: number) {
+    this.__required = v;
+  }
+
+  has_required(): boolean {
+    return this.__required !== undefined;
+  }
+
+  clear_required(): void {
+    this.__required = undefined;
+  }
+
+  get aclosed(): Closed {
+    return this.__aclosed === undefined ? 0 : this.__aclosed;
+  }
+
+  set aclosed(v: Closed) {
+    this.__aclosed = v;
+  }
+
+  has_aclosed(): boolean {
+    return this.__aclosed !== undefined;
+  }
+
+  clear_aclosed(): void {
+    this.__aclosed = undefined;
+  }
+
+  get aopen(): Open {
+    return this.__aopen === undefined ? 0 : this.__aopen;
+  }
+
+  set aopen(v: Open) {
+    this.__aopen = v;
+  }
+
+  has_aopen(): boolean {
+    return this.__aopen !== undefined;
+  }
+
+  clear_aopen(): void {
+    this.__aopen = undefined;
+  }
+
+  get verified(): string {
+    return this.__verified === undefined ? "" : this.__verified;
+  }
+
+  set verified(v: string) {
+    this.__verified = v;
+  }
+
+  has_verified(): boolean {
+    return this.__verified !== undefined;
+  }
+
+  clear_verified(): void {
+    this.__verified = undefined;
+  }
+
+  get unverified(): string {
+    return this.__unverified === undefined ? "" : this.__unverified;
+  }
+
+  set unverified(v: string) {
+    this.__unverified = v;
+  }
+
+  has_unverified(): boolean {
+    return this.__unverified !== undefined;
+  }
+
+  clear_unverified(): void {
+    this.__unverified = undefined;
+  }
+
+  MergeFrom(d: __pb__.Internal.Decoder): void {
+    while (!d.isEOF()) {
+      let [fn, wt] = d.readTag();
+      switch(fn) {
+        case 1:
+        this.explicit = d.readVarInt32();
+        break;
+        case 2:
+        this.implicit = d.readVarInt32();
+        break;
+        case 3:
+        this.required = d.readVarInt32();
+        break;
+        case 4:
+        if (wt == 2) {
+          let packed = d.readDecoder();
+          while (!packed.isEOF()) {
+            this.packed.push(packed.readVarInt32())
+          }
+        } else {
+          this.packed.push(d.readVarInt32())
+        }
+        break;
+        case 5:
+        if (wt == 2) {
+          let packed = d.readDecoder();
+          while (!packed.isEOF()) {
+            this.expanded.push(packed.readVarInt32())
+          }
+        } else {
+          this.expanded.push(d.readVarInt32())
+        }
+        break;
+        case 6:
+        {
+          let v = d.readVarintSignedAsNumber();
+          if (v == 0 || v == 1) {
+            this.aclosed = v;
+          } else {
+            this.unknownFields.push(__pb__.Internal.unknownVarint(6, v));
+          }
+        }
+        break;
+        case 7:
+        this.aopen = d.readVarintSignedAsNumber();
+        break;
+        case 8:
+        this.verified = d.readValidString();
+        break;
+        case 9:
+        this.unverified = d.readString();
+        break;
+        case 10:
+        if (this.delimited == null) this.delimited = new example6.Inner();
+        this.delimited.MergeFrom(d.readGroup(10));
+        break;
+        case 11:
+        if (this.prefixed == null) this.prefixed = new example6.Inner();
+        this.prefixed.MergeFrom(d.readDecoder());
+        break;
+        case 12:
+        {
+          let obj = new example6.InnersEntry();
+          obj.MergeFrom(d.readDecoder());
+          this.inners.set(obj.key, obj.value == null ? new example6.Inner() : obj.value);
+        }
+        break;
+        default:
+        this.unknownFields.push(d.readUnknown(wt, fn));
+      }
+    }
+  }
+
+  WriteTo(e: __pb__.Internal.Encoder): void {
+    if (this.has_explicit()) {
+      e.writeTag(1, 0);
+      e.writeNumberAsVarint(this.explicit);
+    }
+    if (this.implicit != 0) {
+      e.writeTag(2, 0);
+      e.writeNumberAsVarint(this.implicit);
+    }
+    if (this.has_required()) {
+      e.writeTag(3, 0);
+      e.writeNumberAsVarint(this.required);
+    }
+    if (this.packed.length > 0) {
+      const packed = new __pb__.Internal.Encoder();
+      for (let elem of this.packed) {
+        packed.writeNumberAsVarint(elem);
+      }
+      e.writeEncoder(packed, 4);
+    }
+    for (let elem of this.expanded) {
+      e.writeTag(5, 0);
+      e.writeNumberAsVarint(elem);
+    }
+    if (this.has_aclosed()) {
+      e.writeTag(6, 0);
+      e.writeNumberAsVarint(this.aclosed);
+    }
+    if (this.has_aopen()) {
+      e.writeTag(7, 0);
+      e.writeNumberAsVarint(this.aopen);
+    }
+    if (this.has_verified()) {
+      e.writeTag(8, 2);
+      e.writeString(this.verified);
+    }
+    if (this.has_unverified()) {
+      e.writeTag(9, 2);
+      e.writeString(this.unverified);
+    }
+    {
+      const msg = this.delimited;
+      if (msg != null) {
+        let nested = new __pb__.Internal.Encoder();
+        msg.WriteTo(nested);
+        e.writeGroup(nested, 10);
+      }
+    }
+    {
+      const msg = this.prefixed;
+      if (msg != null) {
+        let nested = new __pb__.Internal.Encoder();
+        msg.WriteTo(nested);
+        e.writeEncoder(nested, 11);
+      }
+    }
+    for (const [k, v] of this.inners) {
+      let obj = new example6.InnersEntry();
+      obj.key = k;
+      obj.value = v;
+      let nested = new __pb__.Internal.Encoder();
+      obj.WriteTo(nested);
+      e.writeEncoder(nested, 12);
+    }
+    e.writeUnknown(this.unknownFields);
+  }
+
+  MergeFromJSON(j: __pb__.JsonValue, o: __pb__.JsonOptions = {}): void {
+    const obj = __pb__.Internal.objectFromJSON(j);
+    for (const k in obj) {
+      const v = obj[k];
+      if (v === null) {
+        continue;
+      }
+      switch (k) {
+        case "explicit":
+        this.explicit = __pb__.Internal.int32FromJSON(v);
+        break;
+        case "implicit":
+        this.implicit = __pb__.Internal.int32FromJSON(v);
+        break;
+        case "required":
+        this.required = __pb__.Internal.int32FromJSON(v);
+        break;
+        case "packed":
+        for (const elem of __pb__.Internal.arrayFromJSON(v)) {
+          this.packed.push(__pb__.Internal.int32FromJSON(elem));
+        }
+        break;
+        case "expanded":
+        for (const elem of __pb__.Internal.arrayFromJSON(v)) {
+          this.expanded.push(__pb__.Internal.int32FromJSON(elem));
+        }
+        break;
+        case "aclosed":
+        {
+          let e = ClosedFromJSON(v, o);
+          if (e !== undefined) {
+            this.aclosed = e;
+          }
+        }
+        break;
+        case "aopen":
+        {
+          let e = OpenFromJSON(v, o);
+          if (e !== undefined) {
+            this.aopen = e;
+          }
+        }
+        break;
+        case "verified":
+        this.verified = __pb__.Internal.stringFromJSON(v);
+        break;
+        case "unverified":
+        this.unverified = __pb__.Internal.stringFromJSON(v);
+        break;
+        case "delimited":
+        if (this.delimited == null) this.delimited = new example6.Inner();
+        this.delimited.MergeFromJSON(v, o);
+        break;
+        case "prefixed":
+        if (this.prefixed == null) this.prefixed = new example6.Inner();
+        this.prefixed.MergeFromJSON(v, o);
+        break;
+        case "inners":
+        {
+          const m = __pb__.Internal.objectFromJSON(v);
+          for (const mk in m) {
+            {
+              let msg = new example6.Inner();
+              msg.MergeFromJSON(m[mk], o);
+              this.inners.set(mk, msg);
+            }
+          }
+        }
+        break;
+        default:
+        __pb__.Internal.unknownFieldFromJSON(k, o);
+      }
+    }
+  }
+
+  ToJSON(o: __pb__.JsonOptions = {}): __pb__.JsonValue {
+    const j: __pb__.JsonObject = {};
+    if (o.emitDefaults || this.has_explicit()) {
+      j["explicit"] = this.explicit;
+    }
+    if (o.emitDefaults || this.implicit != 0) {
+      j["implicit"] = this.implicit;
+    }
+    if (o.emitDefaults || this.has_required()) {
+      j["required"] = this.required;
+    }
+    if (o.emitDefaults || this.packed.length > 0) {
+      j["packed"] = this.packed.slice();
+    }
+    if (o.emitDefaults || this.expanded.length > 0) {
+      j["expanded"] = this.expanded.slice();
+    }
+    if (o.emitDefaults || this.has_aclosed()) {
+      j["aclosed"] = ClosedToJSON(this.aclosed);
+    }
+    if (o.emitDefaults || this.has_aopen()) {
+      j["aopen"] = OpenToJSON(this.aopen);
+    }
+    if (o.emitDefaults || this.has_verified()) {
+      j["verified"] = this.verified;
+    }
+    if (o.emitDefaults || this.has_unverified()) {
+      j["unverified"] = this.unverified;
+    }
+    if (o.emitDefaults || this.delimited != null) {
+      const msg = this.delimited;
+      j["delimited"] = msg == null ? null : msg.ToJSON(o);
+    }
+    if (o.emitDefaults || this.prefixed != null) {
+      const msg = this.prefixed;
+      j["prefixed"] = msg == null ? null : msg.ToJSON(o);
+    }
+    if (o.emitDefaults || this.inners.size > 0) {
+      const m: __pb__.JsonObject = {};
+      for (const [k, v] of this.inners) {
+        m[String(k)] = v.ToJSON(o);
+      }
+      j["inners"] = m;
+    }
+    return j;
+  }
+
+  // toObject returns the message as a plain object, holding no classes.
+  toObject(): Iexample6 {
+    const o: Iexample6 = {};
+    if (this.has_explicit()) {
+      o.explicit = this.explicit;
+    }
+    o.implicit = this.implicit;
+    if (this.has_required()) {
+      o.required = this.required;
+    }
+    o.packed = this.packed.slice();
+    o.expanded = this.expanded.slice();
+    if (this.has_aclosed()) {
+      o.aclosed = this.aclosed;
+    }
+    if (this.has_aopen()) {
+      o.aopen = this.aopen;
+    }
+    if (this.has_verified()) {
+      o.verified = this.verified;
+    }
+    if (this.has_unverified()) {
+      o.unverified = this.unverified;
+    }
+    if (this.delimited != null) {
+      o.delimited = this.delimited.toObject();
+    }
+    if (this.prefixed != null) {
+      o.prefixed = this.prefixed.toObject();
+    }
+    {
+      const m: { [k: string]: example6.IInner } = {};
+      for (const [k, v] of this.inners) {
+        m[k] = v.toObject();
+      }
+      o.inners = m;
+    }
+    return o;
+  }
+
+  // fromObject returns a message from its plain object form.
+  static fromObject(o: Iexample6): example6 {
+    const m = new example6();
+    if (o.explicit !== undefined) m.explicit = o.explicit;
+    if (o.implicit !== undefined) m.implicit = o.implicit;
+    if (o.required !== undefined) m.required = o.required;
+    if (o.packed !== undefined) m.packed = o.packed.slice();
+    if (o.expanded !== undefined) m.expanded = o.expanded.slice();
+    if (o.aclosed !== undefined) m.aclosed = o.aclosed;
+    if (o.aopen !== undefined) m.aopen = o.aopen;
+    if (o.verified !== undefined) m.verified = o.verified;
+    if (o.unverified !== undefined) m.unverified = o.unverified;
+    if (o.delimited !== undefined) m.delimited = example6.Inner.fromObject(o.delimited);
+    if (o.prefixed !== undefined) m.prefixed = example6.Inner.fromObject(o.prefixed);
+    if (o.inners !== undefined) {
+      const obj = o.inners;
+      for (const k of Object.keys(obj)) {
+        m.inners.set(k, example6.Inner.fromObject(obj[k]));
+      }
+    }
+    return m;
+  }
+
+  // equals reports whether other holds the same values as the message.
+  equals(other: example6): boolean {
+    if (this === other) return true;
+    if (!(this.has_explicit() === other.has_explicit() && this.explicit === other.explicit)) return false;
+    if (this.implicit !== other.implicit) return false;
+    if (!(this.has_required() === other.has_required() && this.required === other.required)) return false;
+    if (!__pb__.Internal.arrayEqual(this.packed, other.packed)) return false;
+    if (!__pb__.Internal.arrayEqual(this.expanded, other.expanded)) return false;
+    if (!(this.has_aclosed() === other.has_aclosed() && this.aclosed === other.aclosed)) return false;
+    if (!(this.has_aopen() === other.has_aopen() && this.aopen === other.aopen)) return false;
+    if (!(this.has_verified() === other.has_verified() && this.verified === other.verified)) return false;
+    if (!(this.has_unverified() === other.has_unverified() && this.unverified === other.unverified)) return false;
+    if (!__pb__.Internal.optionalEqual(this.delimited, other.delimited, (x, y) => x.equals(y))) return false;
+    if (!__pb__.Internal.optionalEqual(this.prefixed, other.prefixed, (x, y) => x.equals(y))) return false;
+    if (!__pb__.Internal.mapEqual(this.inners, other.inners, (x, y) => x.equals(y))) return false;
+    if (!__pb__.Internal.unknownEqual(this.unknownFields, other.unknownFields)) return false;
+    return true;
+  }
+
+  // clone returns a deep copy of the message.
+  clone(): example6 {
+    const m = new example6();
+    if (this.has_explicit()) m.explicit = this.explicit;
+    m.implicit = this.implicit;
+    if (this.has_required()) m.required = this.required;
+    m.packed = this.packed.slice();
+    m.expanded = this.expanded.slice();
+    if (this.has_aclosed()) m.aclosed = this.aclosed;
+    if (this.has_aopen()) m.aopen = this.aopen;
+    if (this.has_verified()) m.verified = this.verified;
+    if (this.has_unverified()) m.unverified = this.unverified;
+    m.delimited = this.delimited == null ? null : this.delimited.clone();
+    m.prefixed = this.prefixed == null ? null : this.prefixed.clone();
+    for (const [k, v] of this.inners) {
+      m.inners.set(k, v.clone());
+    }
+    m.unknownFields = this.unknownFields.map(u => u.slice());
+    return m;
+  }
+
+  // hashCode returns a hash of the message's values, which is equal for
+  // equal messages and stable across runs.
+  hashCode(): number {
+    let h = 0;
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashNumber(this.explicit));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashNumber(this.implicit));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashNumber(this.required));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashArray(this.packed, __pb__.Internal.hashNumber));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashArray(this.expanded, __pb__.Internal.hashNumber));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashNumber(this.aclosed));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashNumber(this.aopen));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashString(this.verified));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashString(this.unverified));
+    h = __pb__.Internal.hashCombine(h, this.delimited == null ? 0 : this.delimited.hashCode());
+    h = __pb__.Internal.hashCombine(h, this.prefixed == null ? 0 : this.prefixed.hashCode());
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashMap(this.inners, __pb__.Internal.hashString, v => v.hashCode()));
+    return h;
+  }
+}
+
+export namespace example6 {
+  export interface InnerInit {
+    aint32?: number;
+  }
+
+  export interface IInner {
+    aint32?: number;
+  }
+
+  export class Inner implements __pb__.Message {
+    static readonly typeName = "foo.editions.example6.Inner";
+
+    static readonly fields: __pb__.FieldInfo[] = [
+      { name: "aint32", number: 1, type: __pb__.FieldType.INT32, label: __pb__.FieldLabel.OPTIONAL, jsonName: "aint32", member: "aint32" },
+    ];
+
+    private __aint32: number | undefined;
+    // The encoding of fields which were not recognized when decoding.
+    unknownFields: Uint8Array[];
+
+    constructor(init?: InnerInit) {
+      this.__aint32 = undefined;
+      this.unknownFields = [];
+      if (init !== undefined) {
+        if (init.aint32 !== undefined) this.aint32 = init.aint32;
+      }
+    }
+
+    get aint32(): number {
+      return this.__aint32 === undefined ? 0 : this.__aint32;
+    }
+
+    set aint32(v: number) {
+      this.__aint32 = v;
+    }
+
+    has_aint32(): boolean {
+      return this.__aint32 !== undefined;
+    }
+
+    clear_aint32(): void {
+      this.__aint32 = undefined;
+    }
+
+    MergeFrom(d: __pb__.Internal.Decoder): void {
+      while (!d.isEOF()) {
+        let [fn, wt] = d.readTag();
+        switch(fn) {
+          case 1:
+          this.aint32 = d.readVarInt32();
+          break;
+          default:
+          this.unknownFields.push(d.readUnknown(wt, fn));
+        }
+      }
+    }
+
+    WriteTo(e: __pb__.Internal.Encoder): void {
+      if (this.has_aint32()) {
+        e.writeTag(1, 0);
+        e.writeNumberAsVarint(this.aint32);
+      }
+      e.writeUnknown(this.unknownFields);
+    }
+
+    MergeFromJSON(j: __pb__.JsonValue, o: __pb__.JsonOptions = {}): void {
+      const obj = __pb__.Internal.objectFromJSON(j);
+      for (const k in obj) {
+        const v = obj[k];
+        if (v === null) {
+          continue;
+        }
+        switch (k) {
+          case "aint32":
+          this.aint32 = __pb__.Internal.int32FromJSON(v);
+          break;
+          default:
+          __pb__.Internal.unknownFieldFromJSON(k, o);
+        }
+      }
+    }
+
+    ToJSON(o: __pb__.JsonOptions = {}): __pb__.JsonValue {
+      const j: __pb__.JsonObject = {};
+      if (o.emitDefaults || this.has_aint32()) {
+        j["aint32"] = this.aint32;
+      }
+      return j;
+    }
+
+    // toObject returns the message as a plain object, holding no classes.
+    toObject(): IInner {
+      const o: IInner = {};
+      if (this.has_aint32()) {
+        o.aint32 = this.aint32;
+      }
+      return o;
+    }
+
+    // fromObject returns a message from its plain object form.
+    static fromObject(o: IInner): Inner {
+      const m = new Inner();
+      if (o.aint32 !== undefined) m.aint32 = o.aint32;
+      return m;
+    }
+
+    // equals reports whether other holds the same values as the message.
+    equals(other: Inner): boolean {
+      if (this === other) return true;
+      if (!(this.has_aint32() === other.has_aint32() && this.aint32 === other.aint32)) return false;
+      if (!__pb__.Internal.unknownEqual(this.unknownFields, other.unknownFields)) return false;
+      return true;
+    }
+
+    // clone returns a deep copy of the message.
+    clone(): Inner {
+      const m = new Inner();
+      if (this.has_aint32()) m.aint32 = this.aint32;
+      m.unknownFields = this.unknownFields.map(u => u.slice());
+      return m;
+    }
+
+    // hashCode returns a hash of the message's values, which is equal for
+    // equal messages and stable across runs.
+    hashCode(): number {
+      let h = 0;
+      h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashNumber(this.aint32));
+      return h;
+    }
+  }
+}
+
+export namespace example6 {
+  export interface InnersEntryInit {
+    key?: string;
+    value?: example6.Inner | example6.InnerInit;
+  }
+
+  export interface IInnersEntry {
+    key?: string;
+    value?: example6.IInner;
+  }
+
+  export class InnersEntry implements __pb__.Message {
+    static readonly typeName = "foo.editions.example6.InnersEntry";
+
+    static readonly fields: __pb__.FieldInfo[] = [
+      { name: "key", number: 1, type: __pb__.FieldType.STRING, label: __pb__.FieldLabel.OPTIONAL, jsonName: "key", member: "key" },
+      { name: "value", number: 2, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.OPTIONAL, jsonName: "value", member: "value", messageType: () => example6.Inner },
+    ];
+
+    private __key: string | undefined;
+    value: example6.Inner | null;
+    // The encoding of fields which were not recognized when decoding.
+    unknownFields: Uint8Array[];
+
+    constructor(init?: InnersEntryInit) {
+      this.__key = undefined;
+      this.value = null;
+      this.unknownFields = [];
+      if (init !== undefined) {
+        if (init.key !== undefined) this.key = init.key;
+        if (init.value !== undefined) this.value = __pb__.Internal.fromInit(example6.Inner, init.value);
+      }
+    }
+
+    get key(): string {
+      return this.__key === undefined ? "" : this.__key;
+    }
+
+    set key(v: string) {
+      this.__key = v;
+    }
+
+    has_key(): boolean {
+      return this.__key !== undefined;
+    }
+
+    clear_key(): void {
+      this.__key = undefined;
+    }
+
+    MergeFrom(d: __pb__.Internal.Decoder): void {
+      while (!d.isEOF()) {
+        let [fn, wt] = d.readTag();
+        switch(fn) {
+          case 1:
+          this.key = d.readValidString();
+          break;
+          case 2:
+          if (this.value == null) this.value = new example6.Inner();
+          this.value.MergeFrom(d.readDecoder());
+          break;
+          default:
+          this.unknownFields.push(d.readUnknown(wt, fn));
+        }
+      }
+    }
+
+    WriteTo(e: __pb__.Internal.Encoder): void {
+      if (this.has_key()) {
+        e.writeTag(1, 2);
+        e.writeString(this.key);
+      }
+      {
+        const msg = this.value;
+        if (msg != null) {
+          let nested = new __pb__.Internal.Encoder();
+          msg.WriteTo(nested);
+          e.writeEncoder(nested, 2);
+        }
+      }
+      e.writeUnknown(this.unknownFields);
+    }
+
+    MergeFromJSON(j: __pb__.JsonValue, o: __pb__.JsonOptions = {}): void {
+      const obj = __pb__.Internal.objectFromJSON(j);
+      for (const k in obj) {
+        const v = obj[k];
+        if (v === null) {
+          continue;
+        }
+        switch (k) {
+          case "key":
+          this.key = __pb__.Internal.stringFromJSON(v);
+          break;
+          case "value":
+          if (this.value == null) this.value = new example6.Inner();
+          this.value.MergeFromJSON(v, o);
+          break;
+          default:
+          __pb__.Internal.unknownFieldFromJSON(k, o);
+        }
+      }
+    }
+
+    ToJSON(o: __pb__.JsonOptions = {}): __pb__.JsonValue {
+      const j: __pb__.JsonObject = {};
+      if (o.emitDefaults || this.has_key()) {
+        j["key"] = this.key;
+      }
+      if (o.emitDefaults || this.value != null) {
+        const msg = this.value;
+        j["value"] = msg == null ? null : msg.ToJSON(o);
+      }
+      return j;
+    }
+
+    // toObject returns the message as a plain object, holding no classes.
+    toObject(): IInnersEntry {
+      const o: IInnersEntry = {};
+      if (this.has_key()) {
+        o.key = this.key;
+      }
+      if (this.value != null) {
+        o.value = this.value.toObject();
+      }
+      return o;
+    }
+
+    // fromObject returns a message from its plain object form.
+    static fromObject(o: IInnersEntry): InnersEntry {
+      const m = new InnersEntry();
+      if (o.key !== undefined) m.key = o.key;
+      if (o.value !== undefined) m.value = example6.Inner.fromObject(o.value);
+      return m;
+    }
+
+    // equals reports whether other holds the same values as the message.
+    equals(other: InnersEntry): boolean {
+      if (this === other) return true;
+      if (!(this.has_key() === other.has_key() && this.key === other.key)) return false;
+      if (!__pb__.Internal.optionalEqual(this.value, other.value, (x, y) => x.equals(y))) return false;
+      if (!__pb__.Internal.unknownEqual(this.unknownFields, other.unknownFields)) return false;
+      return true;
+    }
+
+    // clone returns a deep copy of the message.
+    clone(): InnersEntry {
+      const m = new InnersEntry();
+      if (this.has_key()) m.key = this.key;
+      m.value = this.value == null ? null : this.value.clone();
+      m.unknownFields = this.unknownFields.map(u => u.slice());
+      return m;
+    }
+
+    // hashCode returns a hash of the message's values, which is equal for
+    // equal messages and stable across runs.
+    hashCode(): number {
+      let h = 0;
+      h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashString(this.key));
+      h = __pb__.Internal.hashCombine(h, this.value == null ? 0 : this.value.hashCode());
+      return h;
+    }
+  }
+}
+
+// fileDescriptor is the google.protobuf.FileDescriptorProto of example6.proto.
+export const fileDescriptor = new __pb__.FileDescriptor(
+  "example6.proto",
+  "Cg5leGFtcGxlNi5wcm90bxIMZm9vLmVkaXRpb25zIvcECghleGFtcGxlNhIaCghleHBsaWNpdBgB" +
+    "IAEoBVIIZXhwbGljaXQSIQoIaW1wbGljaXQYAiABKAVCBaoBAggCUghpbXBsaWNpdBIhCghyZXF1" +
+    "aXJlZBgDIAEoBUIFqgECCANSCHJlcXVpcmVkEhYKBnBhY2tlZBgEIAMoBVIGcGFja2VkEiEKCGV4" +
+    "cGFuZGVkGAUgAygFQgWqAQIYAlIIZXhwYW5kZWQSLgoHYWNsb3NlZBgGIAEoDjIULmZvby5lZGl0" +
+    "aW9ucy5DbG9zZWRSB2FjbG9zZWQSKAoFYW9wZW4YByABKA4yEi5mb28uZWRpdGlvbnMuT3BlblIF" +
+    "YW9wZW4SGgoIdmVyaWZpZWQYCCABKAlSCHZlcmlmaWVkEiUKCnVudmVyaWZpZWQYCSABKAlCBaoB" +
+    "AiADUgp1bnZlcmlmaWVkEjoKCWRlbGltaXRlZBgKIAEoCzIcLmZvby5lZGl0aW9ucy5leGFtcGxl" +
+    "Ni5Jbm5lclIJZGVsaW1pdGVkEj8KCHByZWZpeGVkGAsgASgLMhwuZm9vLmVkaXRpb25zLmV4YW1w" +
+    "bGU2LklubmVyQgWqAQIoAVIIcHJlZml4ZWQSOgoGaW5uZXJzGAwgAygLMiIuZm9vLmVkaXRpb25z" +
+    "LmV4YW1wbGU2LklubmVyc0VudHJ5UgZpbm5lcnMaHwoFSW5uZXISFgoGYWludDMyGAEgASgFUgZh" +
+    "aW50MzIaVwoLSW5uZXJzRW50cnkSEAoDa2V5GAEgASgJUgNrZXkSMgoFdmFsdWUYAiABKAsyHC5m" +
+    "b28uZWRpdGlvbnMuZXhhbXBsZTYuSW5uZXJSBXZhbHVlOgI4ASopCgZDbG9zZWQSDwoLQ0xPU0VE" +
+    "X1pFUk8QABIOCgpDTE9TRURfT05FEAEqGwoET3BlbhINCglPUEVOX1pFUk8QABoEOgIQAUIHkgME" +
+    "EAIoAkqLCgoGEgQAACMBCggKAQ4SAwAAEQoICgECEgMCABUKCAoBCBIDBAAjCgoKAwgyAhIDBAAj" +
+    "CggKAQgSAwUALQoKCgMIMgUSAwUALQoKCgIFABIEBwAKAQoKCgMFAAESAwcFCwoLCgQFAAIAEgMI" +
+    "AhIKDAoFBQACAAESAwgCDQoMCgUFAAIAAhIDCBARCgsKBAUAAgESAwkCEQoMCgUFAAIBARIDCQIM" +
+    "CgwKBQUAAgECEgMJDxAKCgoCBQESBAwADwEKCgoDBQEBEgMMBQkKCgoDBQEDEgMNAiMKDAoFBQED" +
+    "BwISAw0CIwoLCgQFAQIAEgMOAhAKDAoFBQECAAESAw4CCwoMCgUFAQIAAhIDDg4PCgoKAgQAEgQR" +
+    "ACMBCgoKAwQAARIDEQgQCgsKBAQAAgASAxICFQoMCgUEAAIABRIDEgIHCgwKBQQAAgABEgMSCBAK" +
+    "DAoFBAACAAMSAxITFAoLCgQEAAIBEgMTAjoKDAoFBAACAQUSAxMCBwoMCgUEAAIBARIDEwgQCgwK" +
+    "BQQAAgEDEgMTExQKDAoFBAACAQgSAxMVOQoOCgcEAAIBCBUBEgMTFjgKCwoEBAACAhIDFAJBCgwK" +
+    "BQQAAgIFEgMUAgcKDAoFBAACAgESAxQIEAoMCgUEAAICAxIDFBMUCgwKBQQAAgIIEgMUFUAKDgoH" +
+    "BAACAggVARIDFBY/CgsKBAQAAgMSAxUCHAoMCgUEAAIDBBIDFQIKCgwKBQQAAgMFEgMVCxAKDAoF" +
+    "BAACAwESAxURFwoMCgUEAAIDAxIDFRobCgsKBAQAAgQSAxYCTAoMCgUEAAIEBBIDFgIKCgwKBQQA" +
+    "AgQFEgMWCxAKDAoFBAACBAESAxYRGQoMCgUEAAIEAxIDFhwdCgwKBQQAAgQIEgMWHksKDgoHBAAC" +
+    "BAgVAxIDFh9KCgsKBAQAAgUSAxcCFQoMCgUEAAIFBhIDFwIICgwKBQQAAgUBEgMXCRAKDAoFBAAC" +
+    "BQMSAxcTFAoLCgQEAAIGEgMYAhEKDAoFBAACBgYSAxgCBgoMCgUEAAIGARIDGAcMCgwKBQQAAgYD" +
+    "EgMYDxAKCwoEBAACBxIDGQIWCgwKBQQAAgcFEgMZAggKDAoFBAACBwESAxkJEQoMCgUEAAIHAxID" +
+    "GRQVCgsKBAQAAggSAxoCOgoMCgUEAAIIBRIDGgIICgwKBQQAAggBEgMaCRMKDAoFBAACCAMSAxoW" +
+    "FwoMCgUEAAIICBIDGhg5Cg4KBwQAAggIFQQSAxoZOAoMCgQEAAMAEgQcAh4DCgwKBQQAAwABEgMc" +
+    "Cg8KDQoGBAADAAIAEgMdBBUKDgoHBAADAAIABRIDHQQJCg4KBwQAAwACAAESAx0KEAoOCgcEAAMA" +
+    "AgADEgMdExQKCwoEBAACCRIDHwIXCgwKBQQAAgkGEgMfAgcKDAoFBAACCQESAx8IEQoMCgUEAAIJ" +
+    "AxIDHxQWCgsKBAQAAgoSAyACRAoMCgUEAAIKBhIDIAIHCgwKBQQAAgoBEgMgCBAKDAoFBAACCgMS" +
+    "AyATFQoMCgUEAAIKCBIDIBZDCg4KBwQAAgoIFQUSAyAXQgpQCgQEAAILEgMiAiEaQyBNYXAgdmFs" +
+    "dWVzIGFyZSBsZW5ndGggcHJlZml4ZWQgcmVnYXJkbGVzcyBvZiB0aGUgZmlsZSdzIGZlYXR1cmVz" +
+    "LgoKDAoFBAACCwYSAyICFAoMCgUEAAILARIDIhUbCgwKBQQAAgsDEgMiHiBiCGVkaXRpb25zcOgH",
+  []
+);
+
+__pb__.globalRegistry.add(example6);
+__pb__.globalRegistry.add(example6.Inner);
+__pb__.globalRegistry.addEnum(ClosedInfo);
+__pb__.globalRegistry.addEnum(OpenInfo);
+__pb__.globalRegistry.addFile(fileDescriptor);
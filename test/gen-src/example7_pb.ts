@@ -0,0 +1,1117 @@
+// Generated by the protocol buffer compiler.  DO NOT EDIT!
+// Source: example7.proto
+
+import * as __pb__ from '../../lib/protobuf'
+import * as __long from 'long'
+import {fromString as __longFromString } from 'long'
+
+
+export const enum Color {
+  COLOR_UNSPECIFIED = 0,
+  COLOR_RED = 1,
+  COLOR_BLUE = 2,
+}
+
+export function ColorToJSON(v: Color): string | number {
+  switch (v as number) {
+    case 0:
+    return "COLOR_UNSPECIFIED";
+    case 1:
+    return "COLOR_RED";
+    case 2:
+    return "COLOR_BLUE";
+  }
+  return v;
+}
+
+export function ColorFromJSON(v: __pb__.JsonValue, o: __pb__.JsonOptions = {}): Color | undefined {
+  switch (v) {
+    case "COLOR_UNSPECIFIED":
+    return Color.COLOR_UNSPECIFIED;
+    case "COLOR_RED":
+    return Color.COLOR_RED;
+    case "COLOR_BLUE":
+    return Color.COLOR_BLUE;
+  }
+  return __pb__.Internal.enumFromJSON(v, o);
+}
+
+export const ColorInfo: __pb__.EnumInfo = {
+  typeName: "foo.json.Color",
+  values: [
+    { name: "COLOR_UNSPECIFIED", number: 0 },
+    { name: "COLOR_RED", number: 1 },
+    { name: "COLOR_BLUE", number: 2 },
+  ],
+};
+
+export interface example7Init {
+  snake_case?: number;
+  renamed?: string;
+  big_number?: __long;
+  some_bytes?: Uint8Array;
+  a_double?: number;
+  a_color?: Color;
+  many_colors?: Color[];
+  maybe?: number;
+  an_inner?: example7.Inner | example7.InnerInit;
+  many_inners?: (example7.Inner | example7.InnerInit)[];
+  int_map?: Map<number, example7.Inner | example7.InnerInit>;
+  bool_map?: Map<boolean, string>;
+  choice?: example7.choice.oneof_type;
+  choice_string?: string;
+  choice_inner?: example7.Inner | example7.InnerInit;
+}
+
+export interface Iexample7 {
+  snake_case?: number;
+  renamed?: string;
+  big_number?: string;
+  some_bytes?: string;
+  a_double?: number;
+  a_color?: Color;
+  many_colors?: Color[];
+  maybe?: number;
+  an_inner?: example7.IInner;
+  many_inners?: example7.IInner[];
+  int_map?: { [k: string]: example7.IInner };
+  bool_map?: { [k: string]: string };
+  choice_string?: string;
+  choice_inner?: example7.IInner;
+}
+
+export class example7 implements __pb__.Message {
+  static readonly typeName = "foo.json.example7";
+
+  static readonly fields: __pb__.FieldInfo[] = [
+    { name: "snake_case", number: 1, type: __pb__.FieldType.INT32, label: __pb__.FieldLabel.OPTIONAL, jsonName: "snakeCase", member: "snake_case" },
+    { name: "renamed", number: 2, type: __pb__.FieldType.STRING, label: __pb__.FieldLabel.OPTIONAL, jsonName: "otherName", member: "renamed" },
+    { name: "big_number", number: 3, type: __pb__.FieldType.UINT64, label: __pb__.FieldLabel.OPTIONAL, jsonName: "bigNumber", member: "big_number" },
+    { name: "some_bytes", number: 4, type: __pb__.FieldType.BYTES, label: __pb__.FieldLabel.OPTIONAL, jsonName: "someBytes", member: "some_bytes" },
+    { name: "a_double", number: 5, type: __pb__.FieldType.DOUBLE, label: __pb__.FieldLabel.OPTIONAL, jsonName: "aDouble", member: "a_double" },
+    { name: "a_color", number: 6, type: __pb__.FieldType.ENUM, label: __pb__.FieldLabel.OPTIONAL, jsonName: "aColor", member: "a_color", enumType: () => ColorInfo },
+    { name: "many_colors", number: 7, type: __pb__.FieldType.ENUM, label: __pb__.FieldLabel.REPEATED, jsonName: "manyColors", member: "many_colors", enumType: () => ColorInfo },
+    { name: "maybe", number: 8, type: __pb__.FieldType.INT32, label: __pb__.FieldLabel.OPTIONAL, jsonName: "maybe", member: "maybe" },
+    { name: "an_inner", number: 10, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.OPTIONAL, jsonName: "anInner", member: "an_inner", messageType: () => example7.Inner },
+    { name: "many_inners", number: 11, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.REPEATED, jsonName: "manyInners", member: "many_inners", messageType: () => example7.Inner },
+    { name: "int_map", number: 12, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.REPEATED, jsonName: "intMap", member: "int_map", map: { key: __pb__.FieldType.INT32, value: __pb__.FieldType.MESSAGE }, messageType: () => example7.Inner },
+    { name: "bool_map", number: 13, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.REPEATED, jsonName: "boolMap", member: "bool_map", map: { key: __pb__.FieldType.BOOL, value: __pb__.FieldType.STRING } },
+    { name: "choice_string", number: 20, type: __pb__.FieldType.STRING, label: __pb__.FieldLabel.OPTIONAL, jsonName: "choiceString", member: "choice", oneof: "choice", oneofCase: () => example7.choice.choice_string },
+    { name: "choice_inner", number: 21, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.OPTIONAL, jsonName: "choiceInner", member: "choice", oneof: "choice", oneofCase: () => example7.choice.choice_inner, messageType: () => example7.Inner },
+  ];
+
+  snake_case: number;
+  renamed: string;
+  big_number: __long;
+  some_bytes: Uint8Array;
+  a_double: number;
+  a_color: Color;
+  many_colors: Color[];
+  private __maybe: number | undefined;
+  an_inner: example7.Inner | null;
+  many_inners: example7.Inner[];
+  int_map: Map<number, example7.Inner>;
+  bool_map: Map<boolean, string>;
+  choice: example7.choice.oneof_type;
+  // The encoding of fields which were not recognized when decoding.
+  unknownFields: Uint8Array[];
+
+  constructor(init?: example7Init) {
+    this.snake_case = 0;
+    this.renamed = "";
+    this.big_number = __long.UZERO;
+    this.some_bytes = new Uint8Array(0);
+    this.a_double = 0.0;
+    this.a_color = 0;
+    this.many_colors = [];
+    this.__maybe = undefined;
+    this.an_inner = null;
+    this.many_inners = [];
+    this.int_map = new Map<number, example7.Inner>();
+    this.bool_map = new Map<boolean, string>();
+    this.choice = __pb__.OneofNotSet.singleton;
+    this.unknownFields = [];
+    if (init !== undefined) {
+      if (init.snake_case !== undefined) this.snake_case = init.snake_case;
+      if (init.renamed !== undefined) this.renamed = init.renamed;
+      if (init.big_number !== undefined) this.big_number = init.big_number;
+      if (init.some_bytes !== undefined) this.some_bytes = init.some_bytes;
+      if (init.a_double !== undefined) this.a_double = init.a_double;
+      if (init.a_color !== undefined) this.a_color = init.a_color;
+      if (init.many_colors !== undefined) this.many_colors = init.many_colors.slice();
+      if (init.maybe !== undefined) this.maybe = init.maybe;
+      if (init.an_inner !== undefined) this.an_inner = __pb__.Internal.fromInit(example7.Inner, init.an_inner);
+      if (init.many_inners !== undefined) this.many_inners = init.many_inners.map(v => __pb__.Internal.fromInit(example7.Inner, v));
+      if (init.int_map !== undefined) {
+        for (const [k, v] of init.int_map) {
+          this.int_map.set(k, __pb__.Internal.fromInit(example7.Inner, v));
+        }
+      }
+      if (init.bool_map !== undefined) {
+        for (const [k, v] of init.bool_map) {
+          this.bool_map.set(k, v);
+        }
+      }
+      if (init.choice !== undefined) this.choice = init.choice;
+      if (init.choice_string !== undefined) this.choice = new example7.choice.choice_string(init.choice_string);
+      if (init.choice_inner !== undefined) this.choice = new example7.choice.choice_inner(__pb__.Internal.fromInit(example7.Inner, init.choice_inner));
+    }
+  }
+
+  get maybe(): number {
+    return this.__maybe === undefined ? 0 : this.__maybe;
+  }
+
+  set maybe(v: number) {
+    this.__maybe = v;
+  }
+
+  has_maybe(): boolean {
+    return this.__maybe !== undefined;
+  }
+
+  clear_maybe(): void {
+    this.__maybe = undefined;
+  }
+
+  MergeFrom(d: __pb__.Internal.Decoder): void {
+    while (!d.isEOF()) {
+      let [fn, wt] = d.readTag();
+      switch(fn) {
+        case 1:
+        this.snake_case = d.readVarInt32();
+        break;
+        case 2:
+        this.renamed = d.readValidString();
+        break;
+        case 3:
+        this.big_number = d.readVarint();
+        break;
+        case 4:
+        this.some_bytes = d.readBytes();
+        break;
+        case 5:
+        this.a_double = d.readDouble();
+        break;
+        case 6:
+        this.a_color = d.readVarintSignedAsNumber();
+        break;
+        case 7:
+        if (wt == 2) {
+          let packed = d.readDecoder();
+          while (!packed.isEOF()) {
+            this.many_colors.push(packed.readVarintSignedAsNumber())
+          }
+        } else {
+          this.many_colors.push(d.readVarintSignedAsNumber())
+        }
+        break;
+        case 8:
+        this.maybe = d.readVarInt32();
+        break;
+        case 10:
+        if (this.an_inner == null) this.an_inner = new example7.Inner();
+        this.an_inner.MergeFrom(d.readDecoder());
+        break;
+        case 11:
+        {
+          let obj = new example7.Inner();
+          obj.MergeFrom(d.readDecoder());
+          this.many_inners.push(obj)
+        }
+        break;
+        case 12:
+        {
+          let obj = new example7.IntMapEntry();
+          obj.MergeFrom(d.readDecoder());
+          this.int_map.set(obj.key, obj.value == null ? new example7.Inner() : obj.value);
+        }
+        break;
+        case 13:
+        {
+          let obj = new example7.BoolMapEntry();
+          obj.MergeFrom(d.readDecoder());
+          this.bool_map.set(obj.key, obj.value);
+        }
+        break;
+        case 20:
+        this.choice = new example7.choice.choice_string(d.readValidString());
+        break;
+        case 21:
+        {
+          let msg = new example7.Inner();
+          msg.MergeFrom(d.readDecoder());
+          this.choice = new example7.choice.choice_inner(msg);
+        }
+        break;
+        default:
+        this.unknownFields.push(d.readUnknown(wt, fn));
+      }
+    }
+  }
+
+  WriteTo(e: __pb__.Internal.Encoder): void {
+    if (this.snake_case != 0) {
+      e.writeTag(1, 0);
+      e.writeNumberAsVarint(this.snake_case);
+    }
+    if (this.renamed != "") {
+      e.writeTag(2, 2);
+      e.writeString(this.renamed);
+    }
+    if (this.big_number != __long.UZERO) {
+      e.writeTag(3, 0);
+      e.writeVarint(this.big_number);
+    }
+    if (this.some_bytes.length != 0) {
+      e.writeTag(4, 2);
+      e.writeBytes(this.some_bytes);
+    }
+    if (this.a_double != 0.0) {
+      e.writeTag(5, 1);
+      e.writeDouble(this.a_double);
+    }
+    if (this.a_color != 0) {
+      e.writeTag(6, 0);
+      e.writeNumberAsVarint(this.a_color);
+    }
+    if (this.many_colors.length > 0) {
+      const packed = new __pb__.Internal.Encoder();
+      for (let elem of this.many_colors) {
+        packed.writeNumberAsVarint(elem);
+      }
+      e.writeEncoder(packed, 7);
+    }
+    if (this.has_maybe()) {
+      e.writeTag(8, 0);
+      e.writeNumberAsVarint(this.maybe);
+    }
+    {
+      const msg = this.an_inner;
+      if (msg != null) {
+        let nested = new __pb__.Internal.Encoder();
+        msg.WriteTo(nested);
+        e.writeEncoder(nested, 10);
+      }
+    }
+    {
+      for (const msg of this.many_inners) {
+        let nested = new __pb__.Internal.Encoder();
+        msg.WriteTo(nested);
+        e.writeEncoder(nested, 11);
+      }
+    }
+    for (const [k, v] of this.int_map) {
+      let obj = new example7.IntMapEntry();
+      obj.key = k;
+      obj.value = v;
+      let nested = new __pb__.Internal.Encoder();
+      obj.WriteTo(nested);
+      e.writeEncoder(nested, 12);
+    }
+    for (const [k, v] of this.bool_map) {
+      let obj = new example7.BoolMapEntry();
+      obj.key = k;
+      obj.value = v;
+      let nested = new __pb__.Internal.Encoder();
+      obj.WriteTo(nested);
+      e.writeEncoder(nested, 13);
+    }
+    example7.choice.WriteTo(this.choice, e);
+    e.writeUnknown(this.unknownFields);
+  }
+
+  MergeFromJSON(j: __pb__.JsonValue, o: __pb__.JsonOptions = {}): void {
+    const obj = __pb__.Internal.objectFromJSON(j);
+    for (const k in obj) {
+      const v = obj[k];
+      if (v === null) {
+        continue;
+      }
+      switch (k) {
+        case "snakeCase":
+        case "snake_case":
+        this.snake_case = __pb__.Internal.int32FromJSON(v);
+        break;
+        case "otherName":
+        case "renamed":
+        this.renamed = __pb__.Internal.stringFromJSON(v);
+        break;
+        case "bigNumber":
+        case "big_number":
+        this.big_number = __pb__.Internal.uint64FromJSON(v);
+        break;
+        case "someBytes":
+        case "some_bytes":
+        this.some_bytes = __pb__.Internal.bytesFromJSON(v);
+        break;
+        case "aDouble":
+        case "a_double":
+        this.a_double = __pb__.Internal.doubleFromJSON(v);
+        break;
+        case "aColor":
+        case "a_color":
+        {
+          let e = ColorFromJSON(v, o);
+          if (e !== undefined) {
+            this.a_color = e;
+          }
+        }
+        break;
+        case "manyColors":
+        case "many_colors":
+        for (const elem of __pb__.Internal.arrayFromJSON(v)) {
+          {
+            let e = ColorFromJSON(elem, o);
+            if (e !== undefined) {
+              this.many_colors.push(e);
+            }
+          }
+        }
+        break;
+        case "maybe":
+        this.maybe = __pb__.Internal.int32FromJSON(v);
+        break;
+        case "anInner":
+        case "an_inner":
+        if (this.an_inner == null) this.an_inner = new example7.Inner();
+        this.an_inner.MergeFromJSON(v, o);
+        break;
+        case "manyInners":
+        case "many_inners":
+        for (const elem of __pb__.Internal.arrayFromJSON(v)) {
+          {
+            let msg = new example7.Inner();
+            msg.MergeFromJSON(elem, o);
+            this.many_inners.push(msg);
+          }
+        }
+        break;
+        case "intMap":
+        case "int_map":
+        {
+          const m = __pb__.Internal.objectFromJSON(v);
+          for (const mk in m) {
+            {
+              let msg = new example7.Inner();
+              msg.MergeFromJSON(m[mk], o);
+              this.int_map.set(__pb__.Internal.int32FromJSON(mk), msg);
+            }
+          }
+        }
+        break;
+        case "boolMap":
+        case "bool_map":
+        {
+          const m = __pb__.Internal.objectFromJSON(v);
+          for (const mk in m) {
+            this.bool_map.set(__pb__.Internal.boolKeyFromJSON(mk), __pb__.Internal.stringFromJSON(m[mk]));
+          }
+        }
+        break;
+        case "choiceString":
+        case "choice_string":
+        this.choice = new example7.choice.choice_string(__pb__.Internal.stringFromJSON(v));
+        break;
+        case "choiceInner":
+        case "choice_inner":
+        {
+          let msg = new example7.Inner();
+          msg.MergeFromJSON(v, o);
+          this.choice = new example7.choice.choice_inner(msg);
+        }
+        break;
+        default:
+        __pb__.Internal.unknownFieldFromJSON(k, o);
+      }
+    }
+  }
+
+  ToJSON(o: __pb__.JsonOptions = {}): __pb__.JsonValue {
+    const j: __pb__.JsonObject = {};
+    if (o.emitDefaults || this.snake_case != 0) {
+      j[(o.useProtoNames ? "snake_case" : "snakeCase")] = this.snake_case;
+    }
+    if (o.emitDefaults || this.renamed != "") {
+      j[(o.useProtoNames ? "renamed" : "otherName")] = this.renamed;
+    }
+    if (o.emitDefaults || !this.big_number.isZero()) {
+      j[(o.useProtoNames ? "big_number" : "bigNumber")] = this.big_number.toString();
+    }
+    if (o.emitDefaults || this.some_bytes.length > 0) {
+      j[(o.useProtoNames ? "some_bytes" : "someBytes")] = __pb__.Internal.bytesToJSON(this.some_bytes);
+    }
+    if (o.emitDefaults || this.a_double != 0.0) {
+      j[(o.useProtoNames ? "a_double" : "aDouble")] = __pb__.Internal.floatToJSON(this.a_double);
+    }
+    if (o.emitDefaults || this.a_color != 0) {
+      j[(o.useProtoNames ? "a_color" : "aColor")] = ColorToJSON(this.a_color);
+    }
+    if (o.emitDefaults || this.many_colors.length > 0) {
+      j[(o.useProtoNames ? "many_colors" : "manyColors")] = this.many_colors.map(elem => ColorToJSON(elem));
+    }
+    if (o.emitDefaults || this.has_maybe()) {
+      j["maybe"] = this.maybe;
+    }
+    if (o.emitDefaults || this.an_inner != null) {
+      const msg = this.an_inner;
+      j[(o.useProtoNames ? "an_inner" : "anInner")] = msg == null ? null : msg.ToJSON(o);
+    }
+    if (o.emitDefaults || this.many_inners.length > 0) {
+      j[(o.useProtoNames ? "many_inners" : "manyInners")] = this.many_inners.map(elem => elem.ToJSON(o));
+    }
+    if (o.emitDefaults || this.int_map.size > 0) {
+      const m: __pb__.JsonObject = {};
+      for (const [k, v] of this.int_map) {
+        m[String(k)] = v.ToJSON(o);
+      }
+      j[(o.useProtoNames ? "int_map" : "intMap")] = m;
+    }
+    if (o.emitDefaults || this.bool_map.size > 0) {
+      const m: __pb__.JsonObject = {};
+      for (const [k, v] of this.bool_map) {
+        m[String(k)] = v;
+      }
+      j[(o.useProtoNames ? "bool_map" : "boolMap")] = m;
+    }
+    switch (this.choice.kind) {
+      case 20:
+      j[(o.useProtoNames ? "choice_string" : "choiceString")] = (this.choice as example7.choice.choice_string).value;
+      break;
+      case 21:
+      {
+        const msg = (this.choice as example7.choice.choice_inner).value;
+        j[(o.useProtoNames ? "choice_inner" : "choiceInner")] = (msg == null ? new example7.Inner() : msg).ToJSON(o);
+      }
+      break;
+    }
+    return j;
+  }
+
+  // toObject returns the message as a plain object, holding no classes.
+  toObject(): Iexample7 {
+    const o: Iexample7 = {};
+    o.snake_case = this.snake_case;
+    o.renamed = this.renamed;
+    o.big_number = this.big_number.toString();
+    o.some_bytes = __pb__.Internal.bytesToJSON(this.some_bytes);
+    o.a_double = this.a_double;
+    o.a_color = this.a_color;
+    o.many_colors = this.many_colors.slice();
+    if (this.has_maybe()) {
+      o.maybe = this.maybe;
+    }
+    if (this.an_inner != null) {
+      o.an_inner = this.an_inner.toObject();
+    }
+    o.many_inners = this.many_inners.map(v => v.toObject());
+    {
+      const m: { [k: string]: example7.IInner } = {};
+      for (const [k, v] of this.int_map) {
+        m[String(k)] = v.toObject();
+      }
+      o.int_map = m;
+    }
+    {
+      const m: { [k: string]: string } = {};
+      for (const [k, v] of this.bool_map) {
+        m[String(k)] = v;
+      }
+      o.bool_map = m;
+    }
+    if (this.choice instanceof example7.choice.choice_string) {
+      o.choice_string = this.choice.value;
+    }
+    if (this.choice instanceof example7.choice.choice_inner) {
+      o.choice_inner = this.choice.value.toObject();
+    }
+    return o;
+  }
+
+  // fromObject returns a message from its plain object form.
+  static fromObject(o: Iexample7): example7 {
+    const m = new example7();
+    if (o.snake_case !== undefined) m.snake_case = o.snake_case;
+    if (o.renamed !== undefined) m.renamed = o.renamed;
+    if (o.big_number !== undefined) m.big_number = __longFromString(o.big_number, true);
+    if (o.some_bytes !== undefined) m.some_bytes = __pb__.Internal.bytesFromJSON(o.some_bytes);
+    if (o.a_double !== undefined) m.a_double = o.a_double;
+    if (o.a_color !== undefined) m.a_color = o.a_color;
+    if (o.many_colors !== undefined) m.many_colors = o.many_colors.slice();
+    if (o.maybe !== undefined) m.maybe = o.maybe;
+    if (o.an_inner !== undefined) m.an_inner = example7.Inner.fromObject(o.an_inner);
+    if (o.many_inners !== undefined) m.many_inners = o.many_inners.map(v => example7.Inner.fromObject(v));
+    if (o.int_map !== undefined) {
+      const obj = o.int_map;
+      for (const k of Object.keys(obj)) {
+        m.int_map.set(Number(k), example7.Inner.fromObject(obj[k]));
+      }
+    }
+    if (o.bool_map !== undefined) {
+      const obj = o.bool_map;
+      for (const k of Object.keys(obj)) {
+        m.bool_map.set(k == "true", obj[k]);
+      }
+    }
+    if (o.choice_string !== undefined) m.choice = new example7.choice.choice_string(o.choice_string);
+    if (o.choice_inner !== undefined) m.choice = new example7.choice.choice_inner(example7.Inner.fromObject(o.choice_inner));
+    return m;
+  }
+
+  // equals reports whether other holds the same values as the message.
+  equals(other: example7): boolean {
+    if (this === other) return true;
+    if (this.snake_case !== other.snake_case) return false;
+    if (this.renamed !== other.renamed) return false;
+    if (!this.big_number.equals(other.big_number)) return false;
+    if (!__pb__.Internal.bytesEqual(this.some_bytes, other.some_bytes)) return false;
+    if (!__pb__.Internal.floatEqual(this.a_double, other.a_double)) return false;
+    if (this.a_color !== other.a_color) return false;
+    if (!__pb__.Internal.arrayEqual(this.many_colors, other.many_colors)) return false;
+    if (!(this.has_maybe() === other.has_maybe() && this.maybe === other.maybe)) return false;
+    if (!__pb__.Internal.optionalEqual(this.an_inner, other.an_inner, (x, y) => x.equals(y))) return false;
+    if (!__pb__.Internal.arrayEqual(this.many_inners, other.many_inners, (x, y) => x.equals(y))) return false;
+    if (!__pb__.Internal.mapEqual(this.int_map, other.int_map, (x, y) => x.equals(y))) return false;
+    if (!__pb__.Internal.mapEqual(this.bool_map, other.bool_map)) return false;
+    if (this.choice.kind !== other.choice.kind) return false;
+    if (this.choice instanceof example7.choice.choice_string && other.choice instanceof example7.choice.choice_string && this.choice.value !== other.choice.value) return false;
+    if (this.choice instanceof example7.choice.choice_inner && other.choice instanceof example7.choice.choice_inner && !this.choice.value.equals(other.choice.value)) return false;
+    if (!__pb__.Internal.unknownEqual(this.unknownFields, other.unknownFields)) return false;
+    return true;
+  }
+
+  // clone returns a deep copy of the message.
+  clone(): example7 {
+    const m = new example7();
+    m.snake_case = this.snake_case;
+    m.renamed = this.renamed;
+    m.big_number = this.big_number;
+    m.some_bytes = this.some_bytes.slice();
+    m.a_double = this.a_double;
+    m.a_color = this.a_color;
+    m.many_colors = this.many_colors.slice();
+    if (this.has_maybe()) m.maybe = this.maybe;
+    m.an_inner = this.an_inner == null ? null : this.an_inner.clone();
+    m.many_inners = this.many_inners.map(v => v.clone());
+    for (const [k, v] of this.int_map) {
+      m.int_map.set(k, v.clone());
+    }
+    for (const [k, v] of this.bool_map) {
+      m.bool_map.set(k, v);
+    }
+    if (this.choice instanceof example7.choice.choice_string) m.choice = new example7.choice.choice_string(this.choice.value);
+    if (this.choice instanceof example7.choice.choice_inner) m.choice = new example7.choice.choice_inner(this.choice.value.clone());
+    m.unknownFields = this.unknownFields.map(u => u.slice());
+    return m;
+  }
+
+  // hashCode returns a hash of the message's values, which is equal for
+  // equal messages and stable across runs.
+  hashCode(): number {
+    let h = 0;
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashNumber(this.snake_case));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashString(this.renamed));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashLong(this.big_number));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashBytes(this.some_bytes));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashNumber(this.a_double));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashNumber(this.a_color));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashArray(this.many_colors, __pb__.Internal.hashNumber));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashNumber(this.maybe));
+    h = __pb__.Internal.hashCombine(h, this.an_inner == null ? 0 : this.an_inner.hashCode());
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashArray(this.many_inners, v => v.hashCode()));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashMap(this.int_map, __pb__.Internal.hashNumber, v => v.hashCode()));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashMap(this.bool_map, __pb__.Internal.hashBool, __pb__.Internal.hashString));
+    h = __pb__.Internal.hashCombine(h, this.choice.kind);
+    if (this.choice instanceof example7.choice.choice_string) h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashString(this.choice.value));
+    if (this.choice instanceof example7.choice.choice_inner) h = __pb__.Internal.hashCombine(h, this.choice.value.hashCode());
+    return h;
+  }
+}
+
+export namespace example7.choice {
+  export class choice_string {
+    static readonly kind = 20;
+    readonly kind = 20;
+    value: string;
+    constructor(v: string) {
+      this.value = v;
+    }
+  }
+
+  export class choice_inner {
+    static readonly kind = 21;
+    readonly kind = 21;
+    value: example7.Inner | null;
+    constructor(v: example7.Inner | null) {
+      this.value = v;
+    }
+  }
+
+  export type oneof_type = __pb__.OneofNotSet | choice_string | choice_inner;
+
+  export function WriteTo(oo: oneof_type, e: __pb__.Internal.Encoder):void {
+    switch (oo.kind) {
+      case 20:
+      e.writeTag(20, 2);
+      e.writeString((oo as choice_string).value);
+      return;
+      case 21:
+      {
+        let nested = new __pb__.Internal.Encoder();
+        let msg = (oo as choice_inner).value;
+        if (msg != null) {
+          msg.WriteTo(nested);
+        }
+        e.writeEncoder(nested, 21);
+        return
+      }
+    }
+  }
+}
+
+export namespace example7 {
+  export interface InnerInit {
+    value?: string;
+  }
+
+  export interface IInner {
+    value?: string;
+  }
+
+  export class Inner implements __pb__.Message {
+    static readonly typeName = "foo.json.example7.Inner";
+
+    static readonly fields: __pb__.FieldInfo[] = [
+      { name: "value", number: 1, type: __pb__.FieldType.STRING, label: __pb__.FieldLabel.OPTIONAL, jsonName: "value", member: "value" },
+    ];
+
+    value: string;
+    // The encoding of fields which were not recognized when decoding.
+    unknownFields: Uint8Array[];
+
+    constructor(init?: InnerInit) {
+      this.value = "";
+      this.unknownFields = [];
+      if (init !== undefined) {
+        if (init.value !== undefined) this.value = init.value;
+      }
+    }
+
+    MergeFrom(d: __pb__.Internal.Decoder): void {
+      while (!d.isEOF()) {
+        let [fn, wt] = d.readTag();
+        switch(fn) {
+          case 1:
+          this.value = d.readValidString();
+          break;
+          default:
+          this.unknownFields.push(d.readUnknown(wt, fn));
+        }
+      }
+    }
+
+    WriteTo(e: __pb__.Internal.Encoder): void {
+      if (this.value != "") {
+        e.writeTag(1, 2);
+        e.writeString(this.value);
+      }
+      e.writeUnknown(this.unknownFields);
+    }
+
+    MergeFromJSON(j: __pb__.JsonValue, o: __pb__.JsonOptions = {}): void {
+      const obj = __pb__.Internal.objectFromJSON(j);
+      for (const k in obj) {
+        const v = obj[k];
+        if (v === null) {
+          continue;
+        }
+        switch (k) {
+          case "value":
+          this.value = __pb__.Internal.stringFromJSON(v);
+          break;
+          default:
+          __pb__.Internal.unknownFieldFromJSON(k, o);
+        }
+      }
+    }
+
+    ToJSON(o: __pb__.JsonOptions = {}): __pb__.JsonValue {
+      const j: __pb__.JsonObject = {};
+      if (o.emitDefaults || this.value != "") {
+        j["value"] = this.value;
+      }
+      return j;
+    }
+
+    // toObject returns the message as a plain object, holding no classes.
+    toObject(): IInner {
+      const o: IInner = {};
+      o.value = this.value;
+      return o;
+    }
+
+    // fromObject returns a message from its plain object form.
+    static fromObject(o: IInner): Inner {
+      const m = new Inner();
+      if (o.value !== undefined) m.value = o.value;
+      return m;
+    }
+
+    // equals reports whether other holds the same values as the message.
+    equals(other: Inner): boolean {
+      if (this === other) return true;
+      if (this.value !== other.value) return false;
+      if (!__pb__.Internal.unknownEqual(this.unknownFields, other.unknownFields)) return false;
+      return true;
+    }
+
+    // clone returns a deep copy of the message.
+    clone(): Inner {
+      const m = new Inner();
+      m.value = this.value;
+      m.unknownFields = this.unknownFields.map(u => u.slice());
+      return m;
+    }
+
+    // hashCode returns a hash of the message's values, which is equal for
+    // equal messages and stable across runs.
+    hashCode(): number {
+      let h = 0;
+      h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashString(this.value));
+      return h;
+    }
+  }
+}
+
+export namespace example7 {
+  export interface IntMapEntryInit {
+    key?: number;
+    value?: example7.Inner | example7.InnerInit;
+  }
+
+  export interface IIntMapEntry {
+    key?: number;
+    value?: example7.IInner;
+  }
+
+  export class IntMapEntry implements __pb__.Message {
+    static readonly typeName = "foo.json.example7.IntMapEntry";
+
+    static readonly fields: __pb__.FieldInfo[] = [
+      { name: "key", number: 1, type: __pb__.FieldType.INT32, label: __pb__.FieldLabel.OPTIONAL, jsonName: "key", member: "key" },
+      { name: "value", number: 2, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.OPTIONAL, jsonName: "value", member: "value", messageType: () => example7.Inner },
+    ];
+
+    key: number;
+    value: example7.Inner | null;
+    // The encoding of fields which were not recognized when decoding.
+    unknownFields: Uint8Array[];
+
+    constructor(init?: IntMapEntryInit) {
+      this.key = 0;
+      this.value = null;
+      this.unknownFields = [];
+      if (init !== undefined) {
+        if (init.key !== undefined) this.key = init.key;
+        if (init.value !== undefined) this.value = __pb__.Internal.fromInit(example7.Inner, init.value);
+      }
+    }
+
+    MergeFrom(d: __pb__.Internal.Decoder): void {
+      while (!d.isEOF()) {
+        let [fn, wt] = d.readTag();
+        switch(fn) {
+          case 1:
+          this.key = d.readVarInt32();
+          break;
+          case 2:
+          if (this.value == null) this.value = new example7.Inner();
+          this.value.MergeFrom(d.readDecoder());
+          break;
+          default:
+          this.unknownFields.push(d.readUnknown(wt, fn));
+        }
+      }
+    }
+
+    WriteTo(e: __pb__.Internal.Encoder): void {
+      if (this.key != 0) {
+        e.writeTag(1, 0);
+        e.writeNumberAsVarint(this.key);
+      }
+      {
+        const msg = this.value;
+        if (msg != null) {
+          let nested = new __pb__.Internal.Encoder();
+          msg.WriteTo(nested);
+          e.writeEncoder(nested, 2);
+        }
+      }
+      e.writeUnknown(this.unknownFields);
+    }
+
+    MergeFromJSON(j: __pb__.JsonValue, o: __pb__.JsonOptions = {}): void {
+      const obj = __pb__.Internal.objectFromJSON(j);
+      for (const k in obj) {
+        const v = obj[k];
+        if (v === null) {
+          continue;
+        }
+        switch (k) {
+          case "key":
+          this.key = __pb__.Internal.int32FromJSON(v);
+          break;
+          case "value":
+          if (this.value == null) this.value = new example7.Inner();
+          this.value.MergeFromJSON(v, o);
+          break;
+          default:
+          __pb__.Internal.unknownFieldFromJSON(k, o);
+        }
+      }
+    }
+
+    ToJSON(o: __pb__.JsonOptions = {}): __pb__.JsonValue {
+      const j: __pb__.JsonObject = {};
+      if (o.emitDefaults || this.key != 0) {
+        j["key"] = this.key;
+      }
+      if (o.emitDefaults || this.value != null) {
+        const msg = this.value;
+        j["value"] = msg == null ? null : msg.ToJSON(o);
+      }
+      return j;
+    }
+
+    // toObject returns the message as a plain object, holding no classes.
+    toObject(): IIntMapEntry {
+      const o: IIntMapEntry = {};
+      o.key = this.key;
+      if (this.value != null) {
+        o.value = this.value.toObject();
+      }
+      return o;
+    }
+
+    // fromObject returns a message from its plain object form.
+    static fromObject(o: IIntMapEntry): IntMapEntry {
+      const m = new IntMapEntry();
+      if (o.key !== undefined) m.key = o.key;
+      if (o.value !== undefined) m.value = example7.Inner.fromObject(o.value);
+      return m;
+    }
+
+    // equals reports whether other holds the same values as the message.
+    equals(other: IntMapEntry): boolean {
+      if (this === other) return true;
+      if (this.key !== other.key) return false;
+      if (!__pb__.Internal.optionalEqual(this.value, other.value, (x, y) => x.equals(y))) return false;
+      if (!__pb__.Internal.unknownEqual(this.unknownFields, other.unknownFields)) return false;
+      return true;
+    }
+
+    // clone returns a deep copy of the message.
+    clone(): IntMapEntry {
+      const m = new IntMapEntry();
+      m.key = this.key;
+      m.value = this.value == null ? null : this.value.clone();
+      m.unknownFields = this.unknownFields.map(u => u.slice());
+      return m;
+    }
+
+    // hashCode returns a hash of the message's values, which is equal for
+    // equal messages and stable across runs.
+    hashCode(): number {
+      let h = 0;
+      h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashNumber(this.key));
+      h = __pb__.Internal.hashCombine(h, this.value == null ? 0 : this.value.hashCode());
+      return h;
+    }
+  }
+}
+
+export namespace example7 {
+  export interface BoolMapEntryInit {
+    key?: boolean;
+    value?: string;
+  }
+
+  export interface IBoolMapEntry {
+    key?: boolean;
+    value?: string;
+  }
+
+  export class BoolMapEntry implements __pb__.Message {
+    static readonly typeName = "foo.json.example7.BoolMapEntry";
+
+    static readonly fields: __pb__.FieldInfo[] = [
+      { name: "key", number: 1, type: __pb__.FieldType.BOOL, label: __pb__.FieldLabel.OPTIONAL, jsonName: "key", member: "key" },
+      { name: "value", number: 2, type: __pb__.FieldType.STRING, label: __pb__.FieldLabel.OPTIONAL, jsonName: "value", member: "value" },
+    ];
+
+    key: boolean;
+    value: string;
+    // The encoding of fields which were not recognized when decoding.
+    unknownFields: Uint8Array[];
+
+    constructor(init?: BoolMapEntryInit) {
+      this.key = false;
+      this.value = "";
+      this.unknownFields = [];
+      if (init !== undefined) {
+        if (init.key !== undefined) this.key = init.key;
+        if (init.value !== undefined) this.value = init.value;
+      }
+    }
+
+    MergeFrom(d: __pb__.Internal.Decoder): void {
+      while (!d.isEOF()) {
+        let [fn, wt] = d.readTag();
+        switch(fn) {
+          case 1:
+          this.key = d.readBool();
+          break;
+          case 2:
+          this.value = d.readValidString();
+          break;
+          default:
+          this.unknownFields.push(d.readUnknown(wt, fn));
+        }
+      }
+    }
+
+    WriteTo(e: __pb__.Internal.Encoder): void {
+      if (this.key != false) {
+        e.writeTag(1, 0);
+        e.writeBool(this.key);
+      }
+      if (this.value != "") {
+        e.writeTag(2, 2);
+        e.writeString(this.value);
+      }
+      e.writeUnknown(this.unknownFields);
+    }
+
+    MergeFromJSON(j: __pb__.JsonValue, o: __pb__.JsonOptions = {}): void {
+      const obj = __pb__.Internal.objectFromJSON(j);
+      for (const k in obj) {
+        const v = obj[k];
+        if (v === null) {
+          continue;
+        }
+        switch (k) {
+          case "key":
+          this.key = __pb__.Internal.boolFromJSON(v);
+          break;
+          case "value":
+          this.value = __pb__.Internal.stringFromJSON(v);
+          break;
+          default:
+          __pb__.Internal.unknownFieldFromJSON(k, o);
+        }
+      }
+    }
+
+    ToJSON(o: __pb__.JsonOptions = {}): __pb__.JsonValue {
+      const j: __pb__.JsonObject = {};
+      if (o.emitDefaults || this.key != false) {
+        j["key"] = this.key;
+      }
+      if (o.emitDefaults || this.value != "") {
+        j["value"] = this.value;
+      }
+      return j;
+    }
+
+    // toObject returns the message as a plain object, holding no classes.
+    toObject(): IBoolMapEntry {
+      const o: IBoolMapEntry = {};
+      o.key = this.key;
+      o.value = this.value;
+      return o;
+    }
+
+    // fromObject returns a message from its plain object form.
+    static fromObject(o: IBoolMapEntry): BoolMapEntry {
+      const m = new BoolMapEntry();
+      if (o.key !== undefined) m.key = o.key;
+      if (o.value !== undefined) m.value = o.value;
+      return m;
+    }
+
+    // equals reports whether other holds the same values as the message.
+    equals(other: BoolMapEntry): boolean {
+      if (this === other) return true;
+      if (this.key !== other.key) return false;
+      if (this.value !== other.value) return false;
+      if (!__pb__.Internal.unknownEqual(this.unknownFields, other.unknownFields)) return false;
+      return true;
+    }
+
+    // clone returns a deep copy of the message.
+    clone(): BoolMapEntry {
+      const m = new BoolMapEntry();
+      m.key = this.key;
+      m.value = this.value;
+      m.unknownFields = this.unknownFields.map(u => u.slice());
+      return m;
+    }
+
+    // hashCode returns a hash of the message's values, which is equal for
+    // equal messages and stable across runs.
+    hashCode(): number {
+      let h = 0;
+      h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashBool(this.key));
+      h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashString(this.value));
+      return h;
+    }
+  }
+}
+
+// fileDescriptor is the google.protobuf.FileDescriptorProto of example7.proto.
+export const fileDescriptor = new __pb__.FileDescriptor(
+  "example7.proto",
+  "Cg5leGFtcGxlNy5wcm90bxIIZm9vLmpzb24ipAYKCGV4YW1wbGU3Eh0KCnNuYWtlX2Nhc2UYASAB" +
+    "KAVSCXNuYWtlQ2FzZRIaCgdyZW5hbWVkGAIgASgJUglvdGhlck5hbWUSHQoKYmlnX251bWJlchgD" +
+    "IAEoBFIJYmlnTnVtYmVyEh0KCnNvbWVfYnl0ZXMYBCABKAxSCXNvbWVCeXRlcxIZCghhX2RvdWJs" +
+    "ZRgFIAEoAVIHYURvdWJsZRIoCgdhX2NvbG9yGAYgASgOMg8uZm9vLmpzb24uQ29sb3JSBmFDb2xv" +
+    "chIwCgttYW55X2NvbG9ycxgHIAMoDjIPLmZvby5qc29uLkNvbG9yUgptYW55Q29sb3JzEhkKBW1h" +
+    "eWJlGAggASgFSAFSBW1heWJliAEBEjMKCGFuX2lubmVyGAogASgLMhguZm9vLmpzb24uZXhhbXBs" +
+    "ZTcuSW5uZXJSB2FuSW5uZXISOQoLbWFueV9pbm5lcnMYCyADKAsyGC5mb28uanNvbi5leGFtcGxl" +
+    "Ny5Jbm5lclIKbWFueUlubmVycxI3CgdpbnRfbWFwGAwgAygLMh4uZm9vLmpzb24uZXhhbXBsZTcu" +
+    "SW50TWFwRW50cnlSBmludE1hcBI6Cghib29sX21hcBgNIAMoCzIfLmZvby5qc29uLmV4YW1wbGU3" +
+    "LkJvb2xNYXBFbnRyeVIHYm9vbE1hcBIlCg1jaG9pY2Vfc3RyaW5nGBQgASgJSABSDGNob2ljZVN0" +
+    "cmluZxI9CgxjaG9pY2VfaW5uZXIYFSABKAsyGC5mb28uanNvbi5leGFtcGxlNy5Jbm5lckgAUgtj" +
+    "aG9pY2VJbm5lchodCgVJbm5lchIUCgV2YWx1ZRgBIAEoCVIFdmFsdWUaUwoLSW50TWFwRW50cnkS" +
+    "EAoDa2V5GAEgASgFUgNrZXkSLgoFdmFsdWUYAiABKAsyGC5mb28uanNvbi5leGFtcGxlNy5Jbm5l" +
+    "clIFdmFsdWU6AjgBGjoKDEJvb2xNYXBFbnRyeRIQCgNrZXkYASABKAhSA2tleRIUCgV2YWx1ZRgC" +
+    "IAEoCVIFdmFsdWU6AjgBQggKBmNob2ljZUIICgZfbWF5YmUqPQoFQ29sb3ISFQoRQ09MT1JfVU5T" +
+    "UEVDSUZJRUQQABINCglDT0xPUl9SRUQQARIOCgpDT0xPUl9CTFVFEAJKhgkKBhIEAAAgAQoICgEM" +
+    "EgMAABIKCAoBAhIDAgARCgoKAgUAEgQEAAgBCgoKAwUAARIDBAUKCgsKBAUAAgASAwUCGAoMCgUF" +
+    "AAIAARIDBQITCgwKBQUAAgACEgMFFhcKCwoEBQACARIDBgIQCgwKBQUAAgEBEgMGAgsKDAoFBQAC" +
+    "AQISAwYODwoLCgQFAAICEgMHAhEKDAoFBQACAgESAwcCDAoMCgUFAAICAhIDBw8QCgoKAgQAEgQK" +
+    "ACABCgoKAwQAARIDCggQCgsKBAQAAgASAwsCFwoMCgUEAAIABRIDCwIHCgwKBQQAAgABEgMLCBIK" +
+    "DAoFBAACAAMSAwsVFgoLCgQEAAIBEgMMAi8KDAoFBAACAQUSAwwCCAoMCgUEAAIBARIDDAkQCgwK" +
+    "BQQAAgEDEgMMExQKDAoFBAACAQgSAwwVLgoMCgUEAAIBChIDDBYtCgsKBAQAAgISAw0CGAoMCgUE" +
+    "AAICBRIDDQIICgwKBQQAAgIBEgMNCRMKDAoFBAACAgMSAw0WFwoLCgQEAAIDEgMOAhcKDAoFBAAC" +
+    "AwUSAw4CBwoMCgUEAAIDARIDDggSCgwKBQQAAgMDEgMOFRYKCwoEBAACBBIDDwIWCgwKBQQAAgQF" +
+    "EgMPAggKDAoFBAACBAESAw8JEQoMCgUEAAIEAxIDDxQVCgsKBAQAAgUSAxACFAoMCgUEAAIFBhID" +
+    "EAIHCgwKBQQAAgUBEgMQCA8KDAoFBAACBQMSAxASEwoLCgQEAAIGEgMRAiEKDAoFBAACBgQSAxEC" +
+    "CgoMCgUEAAIGBhIDEQsQCgwKBQQAAgYBEgMRERwKDAoFBAACBgMSAxEfIAoLCgQEAAIHEgMSAhsK" +
+    "DAoFBAACBwQSAxICCgoMCgUEAAIHBRIDEgsQCgwKBQQAAgcBEgMSERYKDAoFBAACBwMSAxIZGgoM" +
+    "CgQEAAMAEgQUAhYDCgwKBQQAAwABEgMUCg8KDQoGBAADAAIAEgMVBBUKDgoHBAADAAIABRIDFQQK" +
+    "Cg4KBwQAAwACAAESAxULEAoOCgcEAAMAAgADEgMVExQKCwoEBAACCBIDFwIWCgwKBQQAAggGEgMX" +
+    "AgcKDAoFBAACCAESAxcIEAoMCgUEAAIIAxIDFxMVCgsKBAQAAgkSAxgCIgoMCgUEAAIJBBIDGAIK" +
+    "CgwKBQQAAgkGEgMYCxAKDAoFBAACCQESAxgRHAoMCgUEAAIJAxIDGB8hCgsKBAQAAgoSAxkCIQoM" +
+    "CgUEAAIKBhIDGQITCgwKBQQAAgoBEgMZFBsKDAoFBAACCgMSAxkeIAoLCgQEAAILEgMaAiIKDAoF" +
+    "BAACCwYSAxoCEwoMCgUEAAILARIDGhQcCgwKBQQAAgsDEgMaHyEKDAoEBAAIABIEHAIfAwoMCgUE" +
+    "AAgAARIDHAgOCgsKBAQAAgwSAx0EHgoMCgUEAAIMBRIDHQQKCgwKBQQAAgwBEgMdCxgKDAoFBAAC" +
+    "DAMSAx0bHQoLCgQEAAINEgMeBBwKDAoFBAACDQYSAx4ECQoMCgUEAAINARIDHgoWCgwKBQQAAg0D" +
+    "EgMeGRtiBnByb3RvMw==",
+  []
+);
+
+__pb__.globalRegistry.add(example7);
+__pb__.globalRegistry.add(example7.Inner);
+__pb__.globalRegistry.addEnum(ColorInfo);
+__pb__.globalRegistry.addFile(fileDescriptor);
@@ -0,0 +1,566 @@
+// Generated by the protocol buffer compiler.  DO NOT EDIT!
+// Source: example8.proto
+
+import * as __pb__ from '../../lib/protobuf'
+
+
+export interface example8Init {
+  created?: Date;
+  history?: Date[];
+  deadlines?: Map<string, Date>;
+  timeout?: __pb__.Duration;
+  when?: example8.when.oneof_type;
+  at?: Date;
+  after?: __pb__.Duration;
+}
+
+export interface Iexample8 {
+  created?: string;
+  history?: string[];
+  deadlines?: { [k: string]: string };
+  timeout?: string;
+  at?: string;
+  after?: string;
+}
+
+/**
+ * Generated with wkt_timestamp=date,wkt_duration=helper.
+ */
+export class example8 implements __pb__.Message {
+  static readonly typeName = "foo.wkt.example8";
+
+  static readonly fields: __pb__.FieldInfo[] = [
+    { name: "created", number: 1, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.OPTIONAL, jsonName: "created", member: "created", messageType: () => __pb__.Timestamp },
+    { name: "history", number: 2, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.REPEATED, jsonName: "history", member: "history", messageType: () => __pb__.Timestamp },
+    { name: "deadlines", number: 3, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.REPEATED, jsonName: "deadlines", member: "deadlines", map: { key: __pb__.FieldType.STRING, value: __pb__.FieldType.MESSAGE }, messageType: () => __pb__.Timestamp },
+    { name: "timeout", number: 4, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.OPTIONAL, jsonName: "timeout", member: "timeout", messageType: () => __pb__.Duration },
+    { name: "at", number: 5, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.OPTIONAL, jsonName: "at", member: "when", oneof: "when", oneofCase: () => example8.when.at, messageType: () => __pb__.Timestamp },
+    { name: "after", number: 6, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.OPTIONAL, jsonName: "after", member: "when", oneof: "when", oneofCase: () => example8.when.after, messageType: () => __pb__.Duration },
+  ];
+
+  created: Date | null;
+  history: Date[];
+  deadlines: Map<string, Date>;
+  timeout: __pb__.Duration | null;
+  when: example8.when.oneof_type;
+  // The encoding of fields which were not recognized when decoding.
+  unknownFields: Uint8Array[];
+
+  constructor(init?: example8Init) {
+    this.created = null;
+    this.history = [];
+    this.deadlines = new Map<string, Date>();
+    this.timeout = null;
+    this.when = __pb__.OneofNotSet.singleton;
+    this.unknownFields = [];
+    if (init !== undefined) {
+      if (init.created !== undefined) this.created = init.created;
+      if (init.history !== undefined) this.history = init.history.slice();
+      if (init.deadlines !== undefined) {
+        for (const [k, v] of init.deadlines) {
+          this.deadlines.set(k, v);
+        }
+      }
+      if (init.timeout !== undefined) this.timeout = init.timeout;
+      if (init.when !== undefined) this.when = init.when;
+      if (init.at !== undefined) this.when = new example8.when.at(init.at);
+      if (init.after !== undefined) this.when = new example8.when.after(init.after);
+    }
+  }
+
+  MergeFrom(d: __pb__.Internal.Decoder): void {
+    while (!d.isEOF()) {
+      let [fn, wt] = d.readTag();
+      switch(fn) {
+        case 1:
+        {
+          let msg = this.created == null ? new __pb__.Timestamp() : __pb__.Timestamp.fromDate(this.created);
+          msg.MergeFrom(d.readDecoder());
+          this.created = msg.toDate();
+        }
+        break;
+        case 2:
+        {
+          let obj = new __pb__.Timestamp();
+          obj.MergeFrom(d.readDecoder());
+          this.history.push(obj.toDate())
+        }
+        break;
+        case 3:
+        {
+          let obj = new example8.DeadlinesEntry();
+          obj.MergeFrom(d.readDecoder());
+          this.deadlines.set(obj.key, obj.value == null ? new Date(0) : obj.value);
+        }
+        break;
+        case 4:
+        if (this.timeout == null) this.timeout = new __pb__.Duration();
+        this.timeout.MergeFrom(d.readDecoder());
+        break;
+        case 5:
+        {
+          let msg = new __pb__.Timestamp();
+          msg.MergeFrom(d.readDecoder());
+          this.when = new example8.when.at(msg.toDate());
+        }
+        break;
+        case 6:
+        {
+          let msg = new __pb__.Duration();
+          msg.MergeFrom(d.readDecoder());
+          this.when = new example8.when.after(msg);
+        }
+        break;
+        default:
+        this.unknownFields.push(d.readUnknown(wt, fn));
+      }
+    }
+  }
+
+  WriteTo(e: __pb__.Internal.Encoder): void {
+    {
+      const msg = this.created;
+      if (msg != null) {
+        let nested = new __pb__.Internal.Encoder();
+        __pb__.Timestamp.fromDate(msg).WriteTo(nested);
+        e.writeEncoder(nested, 1);
+      }
+    }
+    {
+      for (const msg of this.history) {
+        let nested = new __pb__.Internal.Encoder();
+        __pb__.Timestamp.fromDate(msg).WriteTo(nested);
+        e.writeEncoder(nested, 2);
+      }
+    }
+    for (const [k, v] of this.deadlines) {
+      let obj = new example8.DeadlinesEntry();
+      obj.key = k;
+      obj.value = v;
+      let nested = new __pb__.Internal.Encoder();
+      obj.WriteTo(nested);
+      e.writeEncoder(nested, 3);
+    }
+    {
+      const msg = this.timeout;
+      if (msg != null) {
+        let nested = new __pb__.Internal.Encoder();
+        msg.WriteTo(nested);
+        e.writeEncoder(nested, 4);
+      }
+    }
+    example8.when.WriteTo(this.when, e);
+    e.writeUnknown(this.unknownFields);
+  }
+
+  MergeFromJSON(j: __pb__.JsonValue, o: __pb__.JsonOptions = {}): void {
+    const obj = __pb__.Internal.objectFromJSON(j);
+    for (const k in obj) {
+      const v = obj[k];
+      if (v === null) {
+        continue;
+      }
+      switch (k) {
+        case "created":
+        {
+          let msg = new __pb__.Timestamp();
+          msg.MergeFromJSON(v, o);
+          this.created = msg.toDate();
+        }
+        break;
+        case "history":
+        for (const elem of __pb__.Internal.arrayFromJSON(v)) {
+          {
+            let msg = new __pb__.Timestamp();
+            msg.MergeFromJSON(elem, o);
+            this.history.push(msg.toDate());
+          }
+        }
+        break;
+        case "deadlines":
+        {
+          const m = __pb__.Internal.objectFromJSON(v);
+          for (const mk in m) {
+            {
+              let msg = new __pb__.Timestamp();
+              msg.MergeFromJSON(m[mk], o);
+              this.deadlines.set(mk, msg.toDate());
+            }
+          }
+        }
+        break;
+        case "timeout":
+        if (this.timeout == null) this.timeout = new __pb__.Duration();
+        this.timeout.MergeFromJSON(v, o);
+        break;
+        case "at":
+        {
+          let msg = new __pb__.Timestamp();
+          msg.MergeFromJSON(v, o);
+          this.when = new example8.when.at(msg.toDate());
+        }
+        break;
+        case "after":
+        {
+          let msg = new __pb__.Duration();
+          msg.MergeFromJSON(v, o);
+          this.when = new example8.when.after(msg);
+        }
+        break;
+        default:
+        __pb__.Internal.unknownFieldFromJSON(k, o);
+      }
+    }
+  }
+
+  ToJSON(o: __pb__.JsonOptions = {}): __pb__.JsonValue {
+    const j: __pb__.JsonObject = {};
+    if (o.emitDefaults || this.created != null) {
+      const msg = this.created;
+      j["created"] = msg == null ? null : __pb__.Timestamp.fromDate(msg).ToJSON(o);
+    }
+    if (o.emitDefaults || this.history.length > 0) {
+      j["history"] = this.history.map(elem => __pb__.Timestamp.fromDate(elem).ToJSON(o));
+    }
+    if (o.emitDefaults || this.deadlines.size > 0) {
+      const m: __pb__.JsonObject = {};
+      for (const [k, v] of this.deadlines) {
+        m[String(k)] = __pb__.Timestamp.fromDate(v).ToJSON(o);
+      }
+      j["deadlines"] = m;
+    }
+    if (o.emitDefaults || this.timeout != null) {
+      const msg = this.timeout;
+      j["timeout"] = msg == null ? null : msg.ToJSON(o);
+    }
+    switch (this.when.kind) {
+      case 5:
+      {
+        const msg = (this.when as example8.when.at).value;
+        j["at"] = __pb__.Timestamp.fromDate((msg == null ? new Date(0) : msg)).ToJSON(o);
+      }
+      break;
+      case 6:
+      {
+        const msg = (this.when as example8.when.after).value;
+        j["after"] = (msg == null ? new __pb__.Duration() : msg).ToJSON(o);
+      }
+      break;
+    }
+    return j;
+  }
+
+  // toObject returns the message as a plain object, holding no classes.
+  toObject(): Iexample8 {
+    const o: Iexample8 = {};
+    if (this.created != null) {
+      o.created = __pb__.Timestamp.fromDate(this.created).ToJSON() as string;
+    }
+    o.history = this.history.map(v => __pb__.Timestamp.fromDate(v).ToJSON() as string);
+    {
+      const m: { [k: string]: string } = {};
+      for (const [k, v] of this.deadlines) {
+        m[k] = __pb__.Timestamp.fromDate(v).ToJSON() as string;
+      }
+      o.deadlines = m;
+    }
+    if (this.timeout != null) {
+      o.timeout = this.timeout.ToJSON() as string;
+    }
+    if (this.when instanceof example8.when.at) {
+      o.at = __pb__.Timestamp.fromDate(this.when.value).ToJSON() as string;
+    }
+    if (this.when instanceof example8.when.after) {
+      o.after = this.when.value.ToJSON() as string;
+    }
+    return o;
+  }
+
+  // fromObject returns a message from its plain object form.
+  static fromObject(o: Iexample8): example8 {
+    const m = new example8();
+    if (o.created !== undefined) m.created = __pb__.Internal.fromJSONValue(new __pb__.Timestamp(), o.created).toDate();
+    if (o.history !== undefined) m.history = o.history.map(v => __pb__.Internal.fromJSONValue(new __pb__.Timestamp(), v).toDate());
+    if (o.deadlines !== undefined) {
+      const obj = o.deadlines;
+      for (const k of Object.keys(obj)) {
+        m.deadlines.set(k, __pb__.Internal.fromJSONValue(new __pb__.Timestamp(), obj[k]).toDate());
+      }
+    }
+    if (o.timeout !== undefined) m.timeout = __pb__.Internal.fromJSONValue(new __pb__.Duration(), o.timeout);
+    if (o.at !== undefined) m.when = new example8.when.at(__pb__.Internal.fromJSONValue(new __pb__.Timestamp(), o.at).toDate());
+    if (o.after !== undefined) m.when = new example8.when.after(__pb__.Internal.fromJSONValue(new __pb__.Duration(), o.after));
+    return m;
+  }
+
+  // equals reports whether other holds the same values as the message.
+  equals(other: example8): boolean {
+    if (this === other) return true;
+    if (!__pb__.Internal.optionalEqual(this.created, other.created, (x, y) => x.getTime() === y.getTime())) return false;
+    if (!__pb__.Internal.arrayEqual(this.history, other.history, (x, y) => x.getTime() === y.getTime())) return false;
+    if (!__pb__.Internal.mapEqual(this.deadlines, other.deadlines, (x, y) => x.getTime() === y.getTime())) return false;
+    if (!__pb__.Internal.optionalEqual(this.timeout, other.timeout, __pb__.Internal.wireEqual)) return false;
+    if (this.when.kind !== other.when.kind) return false;
+    if (this.when instanceof example8.when.at && other.when instanceof example8.when.at && this.when.value.getTime() !== other.when.value.getTime()) return false;
+    if (this.when instanceof example8.when.after && other.when instanceof example8.when.after && !__pb__.Internal.wireEqual(this.when.value, other.when.value)) return false;
+    if (!__pb__.Internal.unknownEqual(this.unknownFields, other.unknownFields)) return false;
+    return true;
+  }
+
+  // clone returns a deep copy of the message.
+  clone(): example8 {
+    const m = new example8();
+    m.created = this.created == null ? null : new Date(this.created.getTime());
+    m.history = this.history.map(v => new Date(v.getTime()));
+    for (const [k, v] of this.deadlines) {
+      m.deadlines.set(k, new Date(v.getTime()));
+    }
+    m.timeout = this.timeout == null ? null : __pb__.Internal.wireClone(this.timeout, new __pb__.Duration());
+    if (this.when instanceof example8.when.at) m.when = new example8.when.at(new Date(this.when.value.getTime()));
+    if (this.when instanceof example8.when.after) m.when = new example8.when.after(__pb__.Internal.wireClone(this.when.value, new __pb__.Duration()));
+    m.unknownFields = this.unknownFields.map(u => u.slice());
+    return m;
+  }
+
+  // hashCode returns a hash of the message's values, which is equal for
+  // equal messages and stable across runs.
+  hashCode(): number {
+    let h = 0;
+    h = __pb__.Internal.hashCombine(h, this.created == null ? 0 : __pb__.Internal.hashNumber(this.created.getTime()));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashArray(this.history, v => __pb__.Internal.hashNumber(v.getTime())));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashMap(this.deadlines, __pb__.Internal.hashString, v => __pb__.Internal.hashNumber(v.getTime())));
+    h = __pb__.Internal.hashCombine(h, this.timeout == null ? 0 : __pb__.Internal.hashBytes(__pb__.Marshal(this.timeout)));
+    h = __pb__.Internal.hashCombine(h, this.when.kind);
+    if (this.when instanceof example8.when.at) h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashNumber(this.when.value.getTime()));
+    if (this.when instanceof example8.when.after) h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashBytes(__pb__.Marshal(this.when.value)));
+    return h;
+  }
+}
+
+export namespace example8.when {
+  export class at {
+    static readonly kind = 5;
+    readonly kind = 5;
+    value: Date | null;
+    constructor(v: Date | null) {
+      this.value = v;
+    }
+  }
+
+  export class after {
+    static readonly kind = 6;
+    readonly kind = 6;
+    value: __pb__.Duration | null;
+    constructor(v: __pb__.Duration | null) {
+      this.value = v;
+    }
+  }
+
+  export type oneof_type = __pb__.OneofNotSet | at | after;
+
+  export function WriteTo(oo: oneof_type, e: __pb__.Internal.Encoder):void {
+    switch (oo.kind) {
+      case 5:
+      {
+        let nested = new __pb__.Internal.Encoder();
+        let msg = (oo as at).value;
+        if (msg != null) {
+          __pb__.Timestamp.fromDate(msg).WriteTo(nested);
+        }
+        e.writeEncoder(nested, 5);
+        return
+      }
+      case 6:
+      {
+        let nested = new __pb__.Internal.Encoder();
+        let msg = (oo as after).value;
+        if (msg != null) {
+          msg.WriteTo(nested);
+        }
+        e.writeEncoder(nested, 6);
+        return
+      }
+    }
+  }
+}
+
+export namespace example8 {
+  export interface DeadlinesEntryInit {
+    key?: string;
+    value?: Date;
+  }
+
+  export interface IDeadlinesEntry {
+    key?: string;
+    value?: string;
+  }
+
+  export class DeadlinesEntry implements __pb__.Message {
+    static readonly typeName = "foo.wkt.example8.DeadlinesEntry";
+
+    static readonly fields: __pb__.FieldInfo[] = [
+      { name: "key", number: 1, type: __pb__.FieldType.STRING, label: __pb__.FieldLabel.OPTIONAL, jsonName: "key", member: "key" },
+      { name: "value", number: 2, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.OPTIONAL, jsonName: "value", member: "value", messageType: () => __pb__.Timestamp },
+    ];
+
+    key: string;
+    value: Date | null;
+    // The encoding of fields which were not recognized when decoding.
+    unknownFields: Uint8Array[];
+
+    constructor(init?: DeadlinesEntryInit) {
+      this.key = "";
+      this.value = null;
+      this.unknownFields = [];
+      if (init !== undefined) {
+        if (init.key !== undefined) this.key = init.key;
+        if (init.value !== undefined) this.value = init.value;
+      }
+    }
+
+    MergeFrom(d: __pb__.Internal.Decoder): void {
+      while (!d.isEOF()) {
+        let [fn, wt] = d.readTag();
+        switch(fn) {
+          case 1:
+          this.key = d.readValidString();
+          break;
+          case 2:
+          {
+            let msg = this.value == null ? new __pb__.Timestamp() : __pb__.Timestamp.fromDate(this.value);
+            msg.MergeFrom(d.readDecoder());
+            this.value = msg.toDate();
+          }
+          break;
+          default:
+          this.unknownFields.push(d.readUnknown(wt, fn));
+        }
+      }
+    }
+
+    WriteTo(e: __pb__.Internal.Encoder): void {
+      if (this.key != "") {
+        e.writeTag(1, 2);
+        e.writeString(this.key);
+      }
+      {
+        const msg = this.value;
+        if (msg != null) {
+          let nested = new __pb__.Internal.Encoder();
+          __pb__.Timestamp.fromDate(msg).WriteTo(nested);
+          e.writeEncoder(nested, 2);
+        }
+      }
+      e.writeUnknown(this.unknownFields);
+    }
+
+    MergeFromJSON(j: __pb__.JsonValue, o: __pb__.JsonOptions = {}): void {
+      const obj = __pb__.Internal.objectFromJSON(j);
+      for (const k in obj) {
+        const v = obj[k];
+        if (v === null) {
+          continue;
+        }
+        switch (k) {
+          case "key":
+          this.key = __pb__.Internal.stringFromJSON(v);
+          break;
+          case "value":
+          {
+            let msg = new __pb__.Timestamp();
+            msg.MergeFromJSON(v, o);
+            this.value = msg.toDate();
+          }
+          break;
+          default:
+          __pb__.Internal.unknownFieldFromJSON(k, o);
+        }
+      }
+    }
+
+    ToJSON(o: __pb__.JsonOptions = {}): __pb__.JsonValue {
+      const j: __pb__.JsonObject = {};
+      if (o.emitDefaults || this.key != "") {
+        j["key"] = this.key;
+      }
+      if (o.emitDefaults || this.value != null) {
+        const msg = this.value;
+        j["value"] = msg == null ? null : __pb__.Timestamp.fromDate(msg).ToJSON(o);
+      }
+      return j;
+    }
+
+    // toObject returns the message as a plain object, holding no classes.
+    toObject(): IDeadlinesEntry {
+      const o: IDeadlinesEntry = {};
+      o.key = this.key;
+      if (this.value != null) {
+        o.value = __pb__.Timestamp.fromDate(this.value).ToJSON() as string;
+      }
+      return o;
+    }
+
+    // fromObject returns a message from its plain object form.
+    static fromObject(o: IDeadlinesEntry): DeadlinesEntry {
+      const m = new DeadlinesEntry();
+      if (o.key !== undefined) m.key = o.key;
+      if (o.value !== undefined) m.value = __pb__.Internal.fromJSONValue(new __pb__.Timestamp(), o.value).toDate();
+      return m;
+    }
+
+    // equals reports whether other holds the same values as the message.
+    equals(other: DeadlinesEntry): boolean {
+      if (this === other) return true;
+      if (this.key !== other.key) return false;
+      if (!__pb__.Internal.optionalEqual(this.value, other.value, (x, y) => x.getTime() === y.getTime())) return false;
+      if (!__pb__.Internal.unknownEqual(this.unknownFields, other.unknownFields)) return false;
+      return true;
+    }
+
+    // clone returns a deep copy of the message.
+    clone(): DeadlinesEntry {
+      const m = new DeadlinesEntry();
+      m.key = this.key;
+      m.value = this.value == null ? null : new Date(this.value.getTime());
+      m.unknownFields = this.unknownFields.map(u => u.slice());
+      return m;
+    }
+
+    // hashCode returns a hash of the message's values, which is equal for
+    // equal messages and stable across runs.
+    hashCode(): number {
+      let h = 0;
+      h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashString(this.key));
+      h = __pb__.Internal.hashCombine(h, this.value == null ? 0 : __pb__.Internal.hashNumber(this.value.getTime()));
+      return h;
+    }
+  }
+}
+
+// fileDescriptor is the google.protobuf.FileDescriptorProto of example8.proto.
+export const fileDescriptor = new __pb__.FileDescriptor(
+  "example8.proto",
+  "Cg5leGFtcGxlOC5wcm90bxIHZm9vLndrdBoeZ29vZ2xlL3Byb3RvYnVmL2R1cmF0aW9uLnByb3Rv" +
+    "Gh9nb29nbGUvcHJvdG9idWYvdGltZXN0YW1wLnByb3RvIq4DCghleGFtcGxlOBI0CgdjcmVhdGVk" +
+    "GAEgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcFIHY3JlYXRlZBI0CgdoaXN0b3J5GAIg" +
+    "AygLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcFIHaGlzdG9yeRI+CglkZWFkbGluZXMYAyAD" +
+    "KAsyIC5mb28ud2t0LmV4YW1wbGU4LkRlYWRsaW5lc0VudHJ5UglkZWFkbGluZXMSMwoHdGltZW91" +
+    "dBgEIAEoCzIZLmdvb2dsZS5wcm90b2J1Zi5EdXJhdGlvblIHdGltZW91dBIsCgJhdBgFIAEoCzIa" +
+    "Lmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIAFICYXQSMQoFYWZ0ZXIYBiABKAsyGS5nb29nbGUu" +
+    "cHJvdG9idWYuRHVyYXRpb25IAFIFYWZ0ZXIaWAoORGVhZGxpbmVzRW50cnkSEAoDa2V5GAEgASgJ" +
+    "UgNrZXkSMAoFdmFsdWUYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wUgV2YWx1ZToC" +
+    "OAFCBgoEd2hlbkr4AwoGEgQAABIBCggKAQwSAwAAEgoICgECEgMCABAKCQoCAwASAwQAKAoJCgID" +
+    "ARIDBQApCkQKAgQAEgQIABIBGjggR2VuZXJhdGVkIHdpdGggd2t0X3RpbWVzdGFtcD1kYXRlLHdr" +
+    "dF9kdXJhdGlvbj1oZWxwZXIuCgoKCgMEAAESAwgIEAoLCgQEAAIAEgMJAigKDAoFBAACAAYSAwkC" +
+    "GwoMCgUEAAIAARIDCRwjCgwKBQQAAgADEgMJJicKCwoEBAACARIDCgIxCgwKBQQAAgEEEgMKAgoK" +
+    "DAoFBAACAQYSAwoLJAoMCgUEAAIBARIDCiUsCgwKBQQAAgEDEgMKLzAKCwoEBAACAhIDCwI3CgwK" +
+    "BQQAAgIGEgMLAigKDAoFBAACAgESAwspMgoMCgUEAAICAxIDCzU2CgsKBAQAAgMSAwwCJwoMCgUE" +
+    "AAIDBhIDDAIaCgwKBQQAAgMBEgMMGyIKDAoFBAACAwMSAwwlJgoMCgQEAAgAEgQOAhEDCgwKBQQA" +
+    "CAABEgMOCAwKCwoEBAACBBIDDwQlCgwKBQQAAgQGEgMPBB0KDAoFBAACBAESAw8eIAoMCgUEAAIE" +
+    "AxIDDyMkCgsKBAQAAgUSAxAEJwoMCgUEAAIFBhIDEAQcCgwKBQQAAgUBEgMQHSIKDAoFBAACBQMS" +
+    "AxAlJmIGcHJvdG8z",
+  ["google/protobuf/duration.proto", "google/protobuf/timestamp.proto"]
+);
+
+__pb__.globalRegistry.add(example8);
+__pb__.globalRegistry.addFile(fileDescriptor);
@@ -0,0 +1,695 @@
+// Generated by the protocol buffer compiler.  DO NOT EDIT!
+// Source: example9.proto
+
+import * as __pb__ from '../../lib/protobuf'
+import * as __long from 'long'
+import {fromString as __longFromString } from 'long'
+
+
+export interface example9Init {
+  astring?: string;
+  aint64?: __long;
+  abool?: boolean;
+  abytes?: Uint8Array;
+  adouble?: number;
+  many?: number[];
+  amap?: Map<string, number>;
+  aoneof?: example9.aoneof.oneof_type;
+  oneint?: number;
+  oneuint?: __long;
+}
+
+export interface Iexample9 {
+  astring?: string | null;
+  aint64?: string | null;
+  abool?: boolean | null;
+  abytes?: string | null;
+  adouble?: number | null;
+  many?: (number | null)[];
+  amap?: { [k: string]: number | null };
+  oneint?: number | null;
+  oneuint?: string | null;
+}
+
+/**
+ * Generated with wkt_wrappers=primitive.
+ */
+export class example9 implements __pb__.Message {
+  static readonly typeName = "foo.wrappers.example9";
+
+  static readonly fields: __pb__.FieldInfo[] = [
+    { name: "astring", number: 1, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.OPTIONAL, jsonName: "astring", member: "astring", messageType: () => __pb__.StringValue },
+    { name: "aint64", number: 2, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.OPTIONAL, jsonName: "aint64", member: "aint64", messageType: () => __pb__.Int64Value },
+    { name: "abool", number: 3, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.OPTIONAL, jsonName: "abool", member: "abool", messageType: () => __pb__.BoolValue },
+    { name: "abytes", number: 4, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.OPTIONAL, jsonName: "abytes", member: "abytes", messageType: () => __pb__.BytesValue },
+    { name: "adouble", number: 5, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.OPTIONAL, jsonName: "adouble", member: "adouble", messageType: () => __pb__.DoubleValue },
+    { name: "many", number: 6, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.REPEATED, jsonName: "many", member: "many", messageType: () => __pb__.UInt32Value },
+    { name: "amap", number: 7, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.REPEATED, jsonName: "amap", member: "amap", map: { key: __pb__.FieldType.STRING, value: __pb__.FieldType.MESSAGE }, messageType: () => __pb__.FloatValue },
+    { name: "oneint", number: 8, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.OPTIONAL, jsonName: "oneint", member: "aoneof", oneof: "aoneof", oneofCase: () => example9.aoneof.oneint, messageType: () => __pb__.Int32Value },
+    { name: "oneuint", number: 9, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.OPTIONAL, jsonName: "oneuint", member: "aoneof", oneof: "aoneof", oneofCase: () => example9.aoneof.oneuint, messageType: () => __pb__.UInt64Value },
+  ];
+
+  astring: string | null;
+  aint64: __long | null;
+  abool: boolean | null;
+  abytes: Uint8Array | null;
+  adouble: number | null;
+  many: number[];
+  amap: Map<string, number>;
+  aoneof: example9.aoneof.oneof_type;
+  // The encoding of fields which were not recognized when decoding.
+  unknownFields: Uint8Array[];
+
+  constructor(init?: example9Init) {
+    this.astring = null;
+    this.aint64 = null;
+    this.abool = null;
+    this.abytes = null;
+    this.adouble = null;
+    this.many = [];
+    this.amap = new Map<string, number>();
+    this.aoneof = __pb__.OneofNotSet.singleton;
+    this.unknownFields = [];
+    if (init !== undefined) {
+      if (init.astring !== undefined) this.astring = init.astring;
+      if (init.aint64 !== undefined) this.aint64 = init.aint64;
+      if (init.abool !== undefined) this.abool = init.abool;
+      if (init.abytes !== undefined) this.abytes = init.abytes;
+      if (init.adouble !== undefined) this.adouble = init.adouble;
+      if (init.many !== undefined) this.many = init.many.slice();
+      if (init.amap !== undefined) {
+        for (const [k, v] of init.amap) {
+          this.amap.set(k, v);
+        }
+      }
+      if (init.aoneof !== undefined) this.aoneof = init.aoneof;
+      if (init.oneint !== undefined) this.aoneof = new example9.aoneof.oneint(init.oneint);
+      if (init.oneuint !== undefined) this.aoneof = new example9.aoneof.oneuint(init.oneuint);
+    }
+  }
+
+  MergeFrom(d: __pb__.Internal.Decoder): void {
+    while (!d.isEOF()) {
+      let [fn, wt] = d.readTag();
+      switch(fn) {
+        case 1:
+        {
+          let msg = this.astring == null ? new __pb__.StringValue() : new __pb__.StringValue(this.astring);
+          msg.MergeFrom(d.readDecoder());
+          this.astring = msg.value;
+        }
+        break;
+        case 2:
+        {
+          let msg = this.aint64 == null ? new __pb__.Int64Value() : new __pb__.Int64Value(this.aint64);
+          msg.MergeFrom(d.readDecoder());
+          this.aint64 = msg.value;
+        }
+        break;
+        case 3:
+        {
+          let msg = this.abool == null ? new __pb__.BoolValue() : new __pb__.BoolValue(this.abool);
+          msg.MergeFrom(d.readDecoder());
+          this.abool = msg.value;
+        }
+        break;
+        case 4:
+        {
+          let msg = this.abytes == null ? new __pb__.BytesValue() : new __pb__.BytesValue(this.abytes);
+          msg.MergeFrom(d.readDecoder());
+          this.abytes = msg.value;
+        }
+        break;
+        case 5:
+        {
+          let msg = this.adouble == null ? new __pb__.DoubleValue() : new __pb__.DoubleValue(this.adouble);
+          msg.MergeFrom(d.readDecoder());
+          this.adouble = msg.value;
+        }
+        break;
+        case 6:
+        {
+          let obj = new __pb__.UInt32Value();
+          obj.MergeFrom(d.readDecoder());
+          this.many.push(obj.value)
+        }
+        break;
+        case 7:
+        {
+          let obj = new example9.AmapEntry();
+          obj.MergeFrom(d.readDecoder());
+          this.amap.set(obj.key, obj.value == null ? 0.0 : obj.value);
+        }
+        break;
+        case 8:
+        {
+          let msg = new __pb__.Int32Value();
+          msg.MergeFrom(d.readDecoder());
+          this.aoneof = new example9.aoneof.oneint(msg.value);
+        }
+        break;
+        case 9:
+        {
+          let msg = new __pb__.UInt64Value();
+          msg.MergeFrom(d.readDecoder());
+          this.aoneof = new example9.aoneof.oneuint(msg.value);
+        }
+        break;
+        default:
+        this.unknownFields.push(d.readUnknown(wt, fn));
+      }
+    }
+  }
+
+  WriteTo(e: __pb__.Internal.Encoder): void {
+    {
+      const msg = this.astring;
+      if (msg != null) {
+        let nested = new __pb__.Internal.Encoder();
+        new __pb__.StringValue(msg).WriteTo(nested);
+        e.writeEncoder(nested, 1);
+      }
+    }
+    {
+      const msg = this.aint64;
+      if (msg != null) {
+        let nested = new __pb__.Internal.Encoder();
+        new __pb__.Int64Value(msg).WriteTo(nested);
+        e.writeEncoder(nested, 2);
+      }
+    }
+    {
+      const msg = this.abool;
+      if (msg != null) {
+        let nested = new __pb__.Internal.Encoder();
+        new __pb__.BoolValue(msg).WriteTo(nested);
+        e.writeEncoder(nested, 3);
+      }
+    }
+    {
+      const msg = this.abytes;
+      if (msg != null) {
+        let nested = new __pb__.Internal.Encoder();
+        new __pb__.BytesValue(msg).WriteTo(nested);
+        e.writeEncoder(nested, 4);
+      }
+    }
+    {
+      const msg = this.adouble;
+      if (msg != null) {
+        let nested = new __pb__.Internal.Encoder();
+        new __pb__.DoubleValue(msg).WriteTo(nested);
+        e.writeEncoder(nested, 5);
+      }
+    }
+    {
+      for (const msg of this.many) {
+        let nested = new __pb__.Internal.Encoder();
+        new __pb__.UInt32Value(msg).WriteTo(nested);
+        e.writeEncoder(nested, 6);
+      }
+    }
+    for (const [k, v] of this.amap) {
+      let obj = new example9.AmapEntry();
+      obj.key = k;
+      obj.value = v;
+      let nested = new __pb__.Internal.Encoder();
+      obj.WriteTo(nested);
+      e.writeEncoder(nested, 7);
+    }
+    example9.aoneof.WriteTo(this.aoneof, e);
+    e.writeUnknown(this.unknownFields);
+  }
+
+  MergeFromJSON(j: __pb__.JsonValue, o: __pb__.JsonOptions = {}): void {
+    const obj = __pb__.Internal.objectFromJSON(j);
+    for (const k in obj) {
+      const v = obj[k];
+      if (v === null) {
+        continue;
+      }
+      switch (k) {
+        case "astring":
+        {
+          let msg = new __pb__.StringValue();
+          msg.MergeFromJSON(v, o);
+          this.astring = msg.value;
+        }
+        break;
+        case "aint64":
+        {
+          let msg = new __pb__.Int64Value();
+          msg.MergeFromJSON(v, o);
+          this.aint64 = msg.value;
+        }
+        break;
+        case "abool":
+        {
+          let msg = new __pb__.BoolValue();
+          msg.MergeFromJSON(v, o);
+          this.abool = msg.value;
+        }
+        break;
+        case "abytes":
+        {
+          let msg = new __pb__.BytesValue();
+          msg.MergeFromJSON(v, o);
+          this.abytes = msg.value;
+        }
+        break;
+        case "adouble":
+        {
+          let msg = new __pb__.DoubleValue();
+          msg.MergeFromJSON(v, o);
+          this.adouble = msg.value;
+        }
+        break;
+        case "many":
+        for (const elem of __pb__.Internal.arrayFromJSON(v)) {
+          {
+            let msg = new __pb__.UInt32Value();
+            msg.MergeFromJSON(elem, o);
+            this.many.push(msg.value);
+          }
+        }
+        break;
+        case "amap":
+        {
+          const m = __pb__.Internal.objectFromJSON(v);
+          for (const mk in m) {
+            {
+              let msg = new __pb__.FloatValue();
+              msg.MergeFromJSON(m[mk], o);
+              this.amap.set(mk, msg.value);
+            }
+          }
+        }
+        break;
+        case "oneint":
+        {
+          let msg = new __pb__.Int32Value();
+          msg.MergeFromJSON(v, o);
+          this.aoneof = new example9.aoneof.oneint(msg.value);
+        }
+        break;
+        case "oneuint":
+        {
+          let msg = new __pb__.UInt64Value();
+          msg.MergeFromJSON(v, o);
+          this.aoneof = new example9.aoneof.oneuint(msg.value);
+        }
+        break;
+        default:
+        __pb__.Internal.unknownFieldFromJSON(k, o);
+      }
+    }
+  }
+
+  ToJSON(o: __pb__.JsonOptions = {}): __pb__.JsonValue {
+    const j: __pb__.JsonObject = {};
+    if (o.emitDefaults || this.astring != null) {
+      const msg = this.astring;
+      j["astring"] = msg == null ? null : new __pb__.StringValue(msg).ToJSON(o);
+    }
+    if (o.emitDefaults || this.aint64 != null) {
+      const msg = this.aint64;
+      j["aint64"] = msg == null ? null : new __pb__.Int64Value(msg).ToJSON(o);
+    }
+    if (o.emitDefaults || this.abool != null) {
+      const msg = this.abool;
+      j["abool"] = msg == null ? null : new __pb__.BoolValue(msg).ToJSON(o);
+    }
+    if (o.emitDefaults || this.abytes != null) {
+      const msg = this.abytes;
+      j["abytes"] = msg == null ? null : new __pb__.BytesValue(msg).ToJSON(o);
+    }
+    if (o.emitDefaults || this.adouble != null) {
+      const msg = this.adouble;
+      j["adouble"] = msg == null ? null : new __pb__.DoubleValue(msg).ToJSON(o);
+    }
+    if (o.emitDefaults || this.many.length > 0) {
+      j["many"] = this.many.map(elem => new __pb__.UInt32Value(elem).ToJSON(o));
+    }
+    if (o.emitDefaults || this.amap.size > 0) {
+      const m: __pb__.JsonObject = {};
+      for (const [k, v] of this.amap) {
+        m[String(k)] = new __pb__.FloatValue(v).ToJSON(o);
+      }
+      j["amap"] = m;
+    }
+    switch (this.aoneof.kind) {
+      case 8:
+      {
+        const msg = (this.aoneof as example9.aoneof.oneint).value;
+        j["oneint"] = new __pb__.Int32Value((msg == null ? 0 : msg)).ToJSON(o);
+      }
+      break;
+      case 9:
+      {
+        const msg = (this.aoneof as example9.aoneof.oneuint).value;
+        j["oneuint"] = new __pb__.UInt64Value((msg == null ? __long.UZERO : msg)).ToJSON(o);
+      }
+      break;
+    }
+    return j;
+  }
+
+  // toObject returns the message as a plain object, holding no classes.
+  toObject(): Iexample9 {
+    const o: Iexample9 = {};
+    if (this.astring != null) {
+      o.astring = this.astring;
+    }
+    if (this.aint64 != null) {
+      o.aint64 = this.aint64.toString();
+    }
+    if (this.abool != null) {
+      o.abool = this.abool;
+    }
+    if (this.abytes != null) {
+      o.abytes = __pb__.Internal.bytesToJSON(this.abytes);
+    }
+    if (this.adouble != null) {
+      o.adouble = this.adouble;
+    }
+    o.many = this.many.slice();
+    {
+      const m: { [k: string]: number | null } = {};
+      for (const [k, v] of this.amap) {
+        m[k] = v;
+      }
+      o.amap = m;
+    }
+    if (this.aoneof instanceof example9.aoneof.oneint) {
+      o.oneint = this.aoneof.value;
+    }
+    if (this.aoneof instanceof example9.aoneof.oneuint) {
+      o.oneuint = this.aoneof.value === null ? null : this.aoneof.value.toString();
+    }
+    return o;
+  }
+
+  // fromObject returns a message from its plain object form.
+  static fromObject(o: Iexample9): example9 {
+    const m = new example9();
+    if (o.astring !== undefined) m.astring = o.astring;
+    if (o.aint64 !== undefined) m.aint64 = o.aint64 === null ? null : __longFromString(o.aint64, false);
+    if (o.abool !== undefined) m.abool = o.abool;
+    if (o.abytes !== undefined) m.abytes = o.abytes === null ? null : __pb__.Internal.bytesFromJSON(o.abytes);
+    if (o.adouble !== undefined) m.adouble = o.adouble;
+    if (o.many !== undefined) m.many = o.many.slice();
+    if (o.amap !== undefined) {
+      const obj = o.amap;
+      for (const k of Object.keys(obj)) {
+        m.amap.set(k, obj[k]);
+      }
+    }
+    if (o.oneint !== undefined) m.aoneof = new example9.aoneof.oneint(o.oneint);
+    if (o.oneuint !== undefined) m.aoneof = new example9.aoneof.oneuint(o.oneuint === null ? null : __longFromString(o.oneuint, true));
+    return m;
+  }
+
+  // equals reports whether other holds the same values as the message.
+  equals(other: example9): boolean {
+    if (this === other) return true;
+    if (this.astring !== other.astring) return false;
+    if (!__pb__.Internal.optionalEqual(this.aint64, other.aint64, (x, y) => x.equals(y))) return false;
+    if (this.abool !== other.abool) return false;
+    if (!__pb__.Internal.optionalEqual(this.abytes, other.abytes, __pb__.Internal.bytesEqual)) return false;
+    if (!__pb__.Internal.optionalEqual(this.adouble, other.adouble, __pb__.Internal.floatEqual)) return false;
+    if (!__pb__.Internal.arrayEqual(this.many, other.many)) return false;
+    if (!__pb__.Internal.mapEqual(this.amap, other.amap, (x, y) => __pb__.Internal.optionalEqual(x, y, __pb__.Internal.floatEqual))) return false;
+    if (this.aoneof.kind !== other.aoneof.kind) return false;
+    if (this.aoneof instanceof example9.aoneof.oneint && other.aoneof instanceof example9.aoneof.oneint && this.aoneof.value !== other.aoneof.value) return false;
+    if (this.aoneof instanceof example9.aoneof.oneuint && other.aoneof instanceof example9.aoneof.oneuint && !__pb__.Internal.optionalEqual(this.aoneof.value, other.aoneof.value, (x, y) => x.equals(y))) return false;
+    if (!__pb__.Internal.unknownEqual(this.unknownFields, other.unknownFields)) return false;
+    return true;
+  }
+
+  // clone returns a deep copy of the message.
+  clone(): example9 {
+    const m = new example9();
+    m.astring = this.astring;
+    m.aint64 = this.aint64;
+    m.abool = this.abool;
+    m.abytes = this.abytes === null ? null : this.abytes.slice();
+    m.adouble = this.adouble;
+    m.many = this.many.slice();
+    for (const [k, v] of this.amap) {
+      m.amap.set(k, v);
+    }
+    if (this.aoneof instanceof example9.aoneof.oneint) m.aoneof = new example9.aoneof.oneint(this.aoneof.value);
+    if (this.aoneof instanceof example9.aoneof.oneuint) m.aoneof = new example9.aoneof.oneuint(this.aoneof.value);
+    m.unknownFields = this.unknownFields.map(u => u.slice());
+    return m;
+  }
+
+  // hashCode returns a hash of the message's values, which is equal for
+  // equal messages and stable across runs.
+  hashCode(): number {
+    let h = 0;
+    h = __pb__.Internal.hashCombine(h, this.astring === null ? 0 : __pb__.Internal.hashString(this.astring));
+    h = __pb__.Internal.hashCombine(h, this.aint64 === null ? 0 : __pb__.Internal.hashLong(this.aint64));
+    h = __pb__.Internal.hashCombine(h, this.abool === null ? 0 : __pb__.Internal.hashBool(this.abool));
+    h = __pb__.Internal.hashCombine(h, this.abytes === null ? 0 : __pb__.Internal.hashBytes(this.abytes));
+    h = __pb__.Internal.hashCombine(h, this.adouble === null ? 0 : __pb__.Internal.hashNumber(this.adouble));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashArray(this.many, v => v === null ? 0 : __pb__.Internal.hashNumber(v)));
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashMap(this.amap, __pb__.Internal.hashString, v => v === null ? 0 : __pb__.Internal.hashNumber(v)));
+    h = __pb__.Internal.hashCombine(h, this.aoneof.kind);
+    if (this.aoneof instanceof example9.aoneof.oneint) h = __pb__.Internal.hashCombine(h, this.aoneof.value === null ? 0 : __pb__.Internal.hashNumber(this.aoneof.value));
+    if (this.aoneof instanceof example9.aoneof.oneuint) h = __pb__.Internal.hashCombine(h, this.aoneof.value === null ? 0 : __pb__.Internal.hashLong(this.aoneof.value));
+    return h;
+  }
+}
+
+export namespace example9.aoneof {
+  export class oneint {
+    static readonly kind = 8;
+    readonly kind = 8;
+    value: number | null;
+    constructor(v: number | null) {
+      this.value = v;
+    }
+  }
+
+  export class oneuint {
+    static readonly kind = 9;
+    readonly kind = 9;
+    value: __long | null;
+    constructor(v: __long | null) {
+      this.value = v;
+    }
+  }
+
+  export type oneof_type = __pb__.OneofNotSet | oneint | oneuint;
+
+  export function WriteTo(oo: oneof_type, e: __pb__.Internal.Encoder):void {
+    switch (oo.kind) {
+      case 8:
+      {
+        let nested = new __pb__.Internal.Encoder();
+        let msg = (oo as oneint).value;
+        if (msg != null) {
+          new __pb__.Int32Value(msg).WriteTo(nested);
+        }
+        e.writeEncoder(nested, 8);
+        return
+      }
+      case 9:
+      {
+        let nested = new __pb__.Internal.Encoder();
+        let msg = (oo as oneuint).value;
+        if (msg != null) {
+          new __pb__.UInt64Value(msg).WriteTo(nested);
+        }
+        e.writeEncoder(nested, 9);
+        return
+      }
+    }
+  }
+}
+
+export namespace example9 {
+  export interface AmapEntryInit {
+    key?: string;
+    value?: number;
+  }
+
+  export interface IAmapEntry {
+    key?: string;
+    value?: number | null;
+  }
+
+  export class AmapEntry implements __pb__.Message {
+    static readonly typeName = "foo.wrappers.example9.AmapEntry";
+
+    static readonly fields: __pb__.FieldInfo[] = [
+      { name: "key", number: 1, type: __pb__.FieldType.STRING, label: __pb__.FieldLabel.OPTIONAL, jsonName: "key", member: "key" },
+      { name: "value", number: 2, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.OPTIONAL, jsonName: "value", member: "value", messageType: () => __pb__.FloatValue },
+    ];
+
+    key: string;
+    value: number | null;
+    // The encoding of fields which were not recognized when decoding.
+    unknownFields: Uint8Array[];
+
+    constructor(init?: AmapEntryInit) {
+      this.key = "";
+      this.value = null;
+      this.unknownFields = [];
+      if (init !== undefined) {
+        if (init.key !== undefined) this.key = init.key;
+        if (init.value !== undefined) this.value = init.value;
+      }
+    }
+
+    MergeFrom(d: __pb__.Internal.Decoder): void {
+      while (!d.isEOF()) {
+        let [fn, wt] = d.readTag();
+        switch(fn) {
+          case 1:
+          this.key = d.readValidString();
+          break;
+          case 2:
+          {
+            let msg = this.value == null ? new __pb__.FloatValue() : new __pb__.FloatValue(this.value);
+            msg.MergeFrom(d.readDecoder());
+            this.value = msg.value;
+          }
+          break;
+          default:
+          this.unknownFields.push(d.readUnknown(wt, fn));
+        }
+      }
+    }
+
+    WriteTo(e: __pb__.Internal.Encoder): void {
+      if (this.key != "") {
+        e.writeTag(1, 2);
+        e.writeString(this.key);
+      }
+      {
+        const msg = this.value;
+        if (msg != null) {
+          let nested = new __pb__.Internal.Encoder();
+          new __pb__.FloatValue(msg).WriteTo(nested);
+          e.writeEncoder(nested, 2);
+        }
+      }
+      e.writeUnknown(this.unknownFields);
+    }
+
+    MergeFromJSON(j: __pb__.JsonValue, o: __pb__.JsonOptions = {}): void {
+      const obj = __pb__.Internal.objectFromJSON(j);
+      for (const k in obj) {
+        const v = obj[k];
+        if (v === null) {
+          continue;
+        }
+        switch (k) {
+          case "key":
+          this.key = __pb__.Internal.stringFromJSON(v);
+          break;
+          case "value":
+          {
+            let msg = new __pb__.FloatValue();
+            msg.MergeFromJSON(v, o);
+            this.value = msg.value;
+          }
+          break;
+          default:
+          __pb__.Internal.unknownFieldFromJSON(k, o);
+        }
+      }
+    }
+
+    ToJSON(o: __pb__.JsonOptions = {}): __pb__.JsonValue {
+      const j: __pb__.JsonObject = {};
+      if (o.emitDefaults || this.key != "") {
+        j["key"] = this.key;
+      }
+      if (o.emitDefaults || this.value != null) {
+        const msg = this.value;
+        j["value"] = msg == null ? null : new __pb__.FloatValue(msg).ToJSON(o);
+      }
+      return j;
+    }
+
+    // toObject returns the message as a plain object, holding no classes.
+    toObject(): IAmapEntry {
+      const o: IAmapEntry = {};
+      o.key = this.key;
+      if (this.value != null) {
+        o.value = this.value;
+      }
+      return o;
+    }
+
+    // fromObject returns a message from its plain object form.
+    static fromObject(o: IAmapEntry): AmapEntry {
+      const m = new AmapEntry();
+      if (o.key !== undefined) m.key = o.key;
+      if (o.value !== undefined) m.value = o.value;
+      return m;
+    }
+
+    // equals reports whether other holds the same values as the message.
+    equals(other: AmapEntry): boolean {
+      if (this === other) return true;
+      if (this.key !== other.key) return false;
+      if (!__pb__.Internal.optionalEqual(this.value, other.value, __pb__.Internal.floatEqual)) return false;
+      if (!__pb__.Internal.unknownEqual(this.unknownFields, other.unknownFields)) return false;
+      return true;
+    }
+
+    // clone returns a deep copy of the message.
+    clone(): AmapEntry {
+      const m = new AmapEntry();
+      m.key = this.key;
+      m.value = this.value;
+      m.unknownFields = this.unknownFields.map(u => u.slice());
+      return m;
+    }
+
+    // hashCode returns a hash of the message's values, which is equal for
+    // equal messages and stable across runs.
+    hashCode(): number {
+      let h = 0;
+      h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashString(this.key));
+      h = __pb__.Internal.hashCombine(h, this.value === null ? 0 : __pb__.Internal.hashNumber(this.value));
+      return h;
+    }
+  }
+}
+
+// fileDescriptor is the google.protobuf.FileDescriptorProto of example9.proto.
+export const fileDescriptor = new __pb__.FileDescriptor(
+  "example9.proto",
+  "Cg5leGFtcGxlOS5wcm90bxIMZm9vLndyYXBwZXJzGh5nb29nbGUvcHJvdG9idWYvd3JhcHBlcnMu" +
+    "cHJvdG8izwQKCGV4YW1wbGU5EjYKB2FzdHJpbmcYASABKAsyHC5nb29nbGUucHJvdG9idWYuU3Ry" +
+    "aW5nVmFsdWVSB2FzdHJpbmcSMwoGYWludDY0GAIgASgLMhsuZ29vZ2xlLnByb3RvYnVmLkludDY0" +
+    "VmFsdWVSBmFpbnQ2NBIwCgVhYm9vbBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5Cb29sVmFsdWVS" +
+    "BWFib29sEjMKBmFieXRlcxgEIAEoCzIbLmdvb2dsZS5wcm90b2J1Zi5CeXRlc1ZhbHVlUgZhYnl0" +
+    "ZXMSNgoHYWRvdWJsZRgFIAEoCzIcLmdvb2dsZS5wcm90b2J1Zi5Eb3VibGVWYWx1ZVIHYWRvdWJs" +
+    "ZRIwCgRtYW55GAYgAygLMhwuZ29vZ2xlLnByb3RvYnVmLlVJbnQzMlZhbHVlUgRtYW55EjQKBGFt" +
+    "YXAYByADKAsyIC5mb28ud3JhcHBlcnMuZXhhbXBsZTkuQW1hcEVudHJ5UgRhbWFwEjUKBm9uZWlu" +
+    "dBgIIAEoCzIbLmdvb2dsZS5wcm90b2J1Zi5JbnQzMlZhbHVlSABSBm9uZWludBI4CgdvbmV1aW50" +
+    "GAkgASgLMhwuZ29vZ2xlLnByb3RvYnVmLlVJbnQ2NFZhbHVlSABSB29uZXVpbnQaVAoJQW1hcEVu" +
+    "dHJ5EhAKA2tleRgBIAEoCVIDa2V5EjEKBXZhbHVlGAIgASgLMhsuZ29vZ2xlLnByb3RvYnVmLkZs" +
+    "b2F0VmFsdWVSBXZhbHVlOgI4AUIICgZhb25lb2ZKggUKBhIEAAAUAQoICgEMEgMAABIKCAoBAhID" +
+    "AgAVCgkKAgMAEgMEACgKNAoCBAASBAcAFAEaKCBHZW5lcmF0ZWQgd2l0aCB3a3Rfd3JhcHBlcnM9" +
+    "cHJpbWl0aXZlLgoKCgoDBAABEgMHCBAKCwoEBAACABIDCAIqCgwKBQQAAgAGEgMIAh0KDAoFBAAC" +
+    "AAESAwgeJQoMCgUEAAIAAxIDCCgpCgsKBAQAAgESAwkCKAoMCgUEAAIBBhIDCQIcCgwKBQQAAgEB" +
+    "EgMJHSMKDAoFBAACAQMSAwkmJwoLCgQEAAICEgMKAiYKDAoFBAACAgYSAwoCGwoMCgUEAAICARID" +
+    "ChwhCgwKBQQAAgIDEgMKJCUKCwoEBAACAxIDCwIoCgwKBQQAAgMGEgMLAhwKDAoFBAACAwESAwsd" +
+    "IwoMCgUEAAIDAxIDCyYnCgsKBAQAAgQSAwwCKgoMCgUEAAIEBhIDDAIdCgwKBQQAAgQBEgMMHiUK" +
+    "DAoFBAACBAMSAwwoKQoLCgQEAAIFEgMNAjAKDAoFBAACBQQSAw0CCgoMCgUEAAIFBhIDDQsmCgwK" +
+    "BQQAAgUBEgMNJysKDAoFBAACBQMSAw0uLwoLCgQEAAIGEgMOAjMKDAoFBAACBgYSAw4CKQoMCgUE" +
+    "AAIGARIDDiouCgwKBQQAAgYDEgMOMTIKDAoEBAAIABIEEAITAwoMCgUEAAgAARIDEAgOCgsKBAQA" +
+    "AgcSAxEEKgoMCgUEAAIHBhIDEQQeCgwKBQQAAgcBEgMRHyUKDAoFBAACBwMSAxEoKQoLCgQEAAII" +
+    "EgMSBCwKDAoFBAACCAYSAxIEHwoMCgUEAAIIARIDEiAnCgwKBQQAAggDEgMSKitiBnByb3RvMw==",
+  ["google/protobuf/wrappers.proto"]
+);
+
+__pb__.globalRegistry.add(example9);
+__pb__.globalRegistry.addFile(fileDescriptor);
@@ -0,0 +1,889 @@
+// Generated by the protocol buffer compiler.  DO NOT EDIT!
+// Source: google/protobuf/struct.proto
+
+import * as __pb__ from '../../../../lib/protobuf'
+
+
+/**
+ * `NullValue` is a singleton enumeration to represent the null value for the
+ * `Value` type union.
+ *
+ *  The JSON representation for `NullValue` is JSON `null`.
+ */
+export const enum NullValue {
+  /**
+   * Null value.
+   */
+  NULL_VALUE = 0,
+}
+
+export function NullValueToJSON(v: NullValue): string | number {
+  switch (v as number) {
+    case 0:
+    return "NULL_VALUE";
+  }
+  return v;
+}
+
+export function NullValueFromJSON(v: __pb__.JsonValue, o: __pb__.JsonOptions = {}): NullValue | undefined {
+  switch (v) {
+    case "NULL_VALUE":
+    return NullValue.NULL_VALUE;
+  }
+  return __pb__.Internal.enumFromJSON(v, o);
+}
+
+export const NullValueInfo: __pb__.EnumInfo = {
+  typeName: "google.protobuf.NullValue",
+  values: [
+    { name: "NULL_VALUE", number: 0 },
+  ],
+};
+
+export interface StructInit {
+  /**
+   * Unordered map of dynamically typed values.
+   */
+  fields?: Map<string, Value | ValueInit>;
+}
+
+export interface IStruct {
+  /**
+   * Unordered map of dynamically typed values.
+   */
+  fields?: { [k: string]: IValue };
+}
+
+/**
+ * `Struct` represents a structured data value, consisting of fields
+ * which map to dynamically typed values. In some languages, `Struct`
+ * might be supported by a native representation. For example, in
+ * scripting languages like JS a struct is represented as an
+ * object. The details of that representation are described together
+ * with the proto support for the language.
+ *
+ * The JSON representation for `Struct` is JSON object.
+ */
+export class Struct implements __pb__.Message {
+  static readonly typeName = "google.protobuf.Struct";
+
+  static readonly fields: __pb__.FieldInfo[] = [
+    { name: "fields", number: 1, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.REPEATED, jsonName: "fields", member: "fields", map: { key: __pb__.FieldType.STRING, value: __pb__.FieldType.MESSAGE }, messageType: () => Value },
+  ];
+
+  /**
+   * Unordered map of dynamically typed values.
+   */
+  fields: Map<string, Value>;
+  // The encoding of fields which were not recognized when decoding.
+  unknownFields: Uint8Array[];
+
+  constructor(init?: StructInit) {
+    this.fields = new Map<string, Value>();
+    this.unknownFields = [];
+    if (init !== undefined) {
+      if (init.fields !== undefined) {
+        for (const [k, v] of init.fields) {
+          this.fields.set(k, __pb__.Internal.fromInit(Value, v));
+        }
+      }
+    }
+  }
+
+  MergeFrom(d: __pb__.Internal.Decoder): void {
+    while (!d.isEOF()) {
+      let [fn, wt] = d.readTag();
+      switch(fn) {
+        case 1:
+        {
+          let obj = new Struct.FieldsEntry();
+          obj.MergeFrom(d.readDecoder());
+          this.fields.set(obj.key, obj.value == null ? new Value() : obj.value);
+        }
+        break;
+        default:
+        this.unknownFields.push(d.readUnknown(wt, fn));
+      }
+    }
+  }
+
+  WriteTo(e: __pb__.Internal.Encoder): void {
+    for (const [k, v] of this.fields) {
+      let obj = new Struct.FieldsEntry();
+      obj.key = k;
+      obj.value = v;
+      let nested = new __pb__.Internal.Encoder();
+      obj.WriteTo(nested);
+      e.writeEncoder(nested, 1);
+    }
+    e.writeUnknown(this.unknownFields);
+  }
+
+  MergeFromJSON(j: __pb__.JsonValue, _: __pb__.JsonOptions = {}): void {
+    const v = new __pb__.Struct();
+    v.MergeFromJSON(j);
+    __pb__.Unmarshal(__pb__.Marshal(v), this);
+  }
+
+  ToJSON(_: __pb__.JsonOptions = {}): __pb__.JsonValue {
+    const v = new __pb__.Struct();
+    __pb__.Unmarshal(__pb__.Marshal(this), v);
+    return v.ToJSON();
+  }
+
+  // toObject returns the message as a plain object, holding no classes.
+  toObject(): IStruct {
+    const o: IStruct = {};
+    {
+      const m: { [k: string]: IValue } = {};
+      for (const [k, v] of this.fields) {
+        m[k] = v.toObject();
+      }
+      o.fields = m;
+    }
+    return o;
+  }
+
+  // fromObject returns a message from its plain object form.
+  static fromObject(o: IStruct): Struct {
+    const m = new Struct();
+    if (o.fields !== undefined) {
+      const obj = o.fields;
+      for (const k of Object.keys(obj)) {
+        m.fields.set(k, Value.fromObject(obj[k]));
+      }
+    }
+    return m;
+  }
+
+  // equals reports whether other holds the same values as the message.
+  equals(other: Struct): boolean {
+    if (this === other) return true;
+    if (!__pb__.Internal.mapEqual(this.fields, other.fields, (x, y) => x.equals(y))) return false;
+    if (!__pb__.Internal.unknownEqual(this.unknownFields, other.unknownFields)) return false;
+    return true;
+  }
+
+  // clone returns a deep copy of the message.
+  clone(): Struct {
+    const m = new Struct();
+    for (const [k, v] of this.fields) {
+      m.fields.set(k, v.clone());
+    }
+    m.unknownFields = this.unknownFields.map(u => u.slice());
+    return m;
+  }
+
+  // hashCode returns a hash of the message's values, which is equal for
+  // equal messages and stable across runs.
+  hashCode(): number {
+    let h = 0;
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashMap(this.fields, __pb__.Internal.hashString, v => v.hashCode()));
+    return h;
+  }
+}
+
+export namespace Struct {
+  export interface FieldsEntryInit {
+    key?: string;
+    value?: Value | ValueInit;
+  }
+
+  export interface IFieldsEntry {
+    key?: string;
+    value?: IValue;
+  }
+
+  export class FieldsEntry implements __pb__.Message {
+    static readonly typeName = "google.protobuf.Struct.FieldsEntry";
+
+    static readonly fields: __pb__.FieldInfo[] = [
+      { name: "key", number: 1, type: __pb__.FieldType.STRING, label: __pb__.FieldLabel.OPTIONAL, jsonName: "key", member: "key" },
+      { name: "value", number: 2, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.OPTIONAL, jsonName: "value", member: "value", messageType: () => Value },
+    ];
+
+    key: string;
+    value: Value | null;
+    // The encoding of fields which were not recognized when decoding.
+    unknownFields: Uint8Array[];
+
+    constructor(init?: FieldsEntryInit) {
+      this.key = "";
+      this.value = null;
+      this.unknownFields = [];
+      if (init !== undefined) {
+        if (init.key !== undefined) this.key = init.key;
+        if (init.value !== undefined) this.value = __pb__.Internal.fromInit(Value, init.value);
+      }
+    }
+
+    MergeFrom(d: __pb__.Internal.Decoder): void {
+      while (!d.isEOF()) {
+        let [fn, wt] = d.readTag();
+        switch(fn) {
+          case 1:
+          this.key = d.readValidString();
+          break;
+          case 2:
+          if (this.value == null) this.value = new Value();
+          this.value.MergeFrom(d.readDecoder());
+          break;
+          default:
+          this.unknownFields.push(d.readUnknown(wt, fn));
+        }
+      }
+    }
+
+    WriteTo(e: __pb__.Internal.Encoder): void {
+      if (this.key != "") {
+        e.writeTag(1, 2);
+        e.writeString(this.key);
+      }
+      {
+        const msg = this.value;
+        if (msg != null) {
+          let nested = new __pb__.Internal.Encoder();
+          msg.WriteTo(nested);
+          e.writeEncoder(nested, 2);
+        }
+      }
+      e.writeUnknown(this.unknownFields);
+    }
+
+    MergeFromJSON(j: __pb__.JsonValue, o: __pb__.JsonOptions = {}): void {
+      const obj = __pb__.Internal.objectFromJSON(j);
+      for (const k in obj) {
+        const v = obj[k];
+        if (v === null && k != "value") {
+          continue;
+        }
+        switch (k) {
+          case "key":
+          this.key = __pb__.Internal.stringFromJSON(v);
+          break;
+          case "value":
+          if (this.value == null) this.value = new Value();
+          this.value.MergeFromJSON(v, o);
+          break;
+          default:
+          __pb__.Internal.unknownFieldFromJSON(k, o);
+        }
+      }
+    }
+
+    ToJSON(o: __pb__.JsonOptions = {}): __pb__.JsonValue {
+      const j: __pb__.JsonObject = {};
+      if (o.emitDefaults || this.key != "") {
+        j["key"] = this.key;
+      }
+      if (o.emitDefaults || this.value != null) {
+        const msg = this.value;
+        j["value"] = msg == null ? null : msg.ToJSON(o);
+      }
+      return j;
+    }
+
+    // toObject returns the message as a plain object, holding no classes.
+    toObject(): IFieldsEntry {
+      const o: IFieldsEntry = {};
+      o.key = this.key;
+      if (this.value != null) {
+        o.value = this.value.toObject();
+      }
+      return o;
+    }
+
+    // fromObject returns a message from its plain object form.
+    static fromObject(o: IFieldsEntry): FieldsEntry {
+      const m = new FieldsEntry();
+      if (o.key !== undefined) m.key = o.key;
+      if (o.value !== undefined) m.value = Value.fromObject(o.value);
+      return m;
+    }
+
+    // equals reports whether other holds the same values as the message.
+    equals(other: FieldsEntry): boolean {
+      if (this === other) return true;
+      if (this.key !== other.key) return false;
+      if (!__pb__.Internal.optionalEqual(this.value, other.value, (x, y) => x.equals(y))) return false;
+      if (!__pb__.Internal.unknownEqual(this.unknownFields, other.unknownFields)) return false;
+      return true;
+    }
+
+    // clone returns a deep copy of the message.
+    clone(): FieldsEntry {
+      const m = new FieldsEntry();
+      m.key = this.key;
+      m.value = this.value == null ? null : this.value.clone();
+      m.unknownFields = this.unknownFields.map(u => u.slice());
+      return m;
+    }
+
+    // hashCode returns a hash of the message's values, which is equal for
+    // equal messages and stable across runs.
+    hashCode(): number {
+      let h = 0;
+      h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashString(this.key));
+      h = __pb__.Internal.hashCombine(h, this.value == null ? 0 : this.value.hashCode());
+      return h;
+    }
+  }
+}
+
+export interface ValueInit {
+  /**
+   * The kind of value.
+   */
+  kind?: Value.kind.oneof_type;
+  /**
+   * Represents a null value.
+   */
+  null_value?: NullValue;
+  /**
+   * Represents a double value.
+   */
+  number_value?: number;
+  /**
+   * Represents a string value.
+   */
+  string_value?: string;
+  /**
+   * Represents a boolean value.
+   */
+  bool_value?: boolean;
+  /**
+   * Represents a structured value.
+   */
+  struct_value?: Struct | StructInit;
+  /**
+   * Represents a repeated `Value`.
+   */
+  list_value?: ListValue | ListValueInit;
+}
+
+export interface IValue {
+  /**
+   * Represents a null value.
+   */
+  null_value?: NullValue;
+  /**
+   * Represents a double value.
+   */
+  number_value?: number;
+  /**
+   * Represents a string value.
+   */
+  string_value?: string;
+  /**
+   * Represents a boolean value.
+   */
+  bool_value?: boolean;
+  /**
+   * Represents a structured value.
+   */
+  struct_value?: IStruct;
+  /**
+   * Represents a repeated `Value`.
+   */
+  list_value?: IListValue;
+}
+
+/**
+ * `Value` represents a dynamically typed value which can be either
+ * null, a number, a string, a boolean, a recursive struct value, or a
+ * list of values. A producer of value is expected to set one of that
+ * variants, absence of any variant indicates an error.
+ *
+ * The JSON representation for `Value` is JSON value.
+ */
+export class Value implements __pb__.Message {
+  static readonly typeName = "google.protobuf.Value";
+
+  static readonly fields: __pb__.FieldInfo[] = [
+    { name: "null_value", number: 1, type: __pb__.FieldType.ENUM, label: __pb__.FieldLabel.OPTIONAL, jsonName: "nullValue", member: "kind", oneof: "kind", oneofCase: () => Value.kind.null_value, enumType: () => NullValueInfo },
+    { name: "number_value", number: 2, type: __pb__.FieldType.DOUBLE, label: __pb__.FieldLabel.OPTIONAL, jsonName: "numberValue", member: "kind", oneof: "kind", oneofCase: () => Value.kind.number_value },
+    { name: "string_value", number: 3, type: __pb__.FieldType.STRING, label: __pb__.FieldLabel.OPTIONAL, jsonName: "stringValue", member: "kind", oneof: "kind", oneofCase: () => Value.kind.string_value },
+    { name: "bool_value", number: 4, type: __pb__.FieldType.BOOL, label: __pb__.FieldLabel.OPTIONAL, jsonName: "boolValue", member: "kind", oneof: "kind", oneofCase: () => Value.kind.bool_value },
+    { name: "struct_value", number: 5, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.OPTIONAL, jsonName: "structValue", member: "kind", oneof: "kind", oneofCase: () => Value.kind.struct_value, messageType: () => Struct },
+    { name: "list_value", number: 6, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.OPTIONAL, jsonName: "listValue", member: "kind", oneof: "kind", oneofCase: () => Value.kind.list_value, messageType: () => ListValue },
+  ];
+
+  /**
+   * The kind of value.
+   */
+  kind: Value.kind.oneof_type;
+  // The encoding of fields which were not recognized when decoding.
+  unknownFields: Uint8Array[];
+
+  constructor(init?: ValueInit) {
+    this.kind = __pb__.OneofNotSet.singleton;
+    this.unknownFields = [];
+    if (init !== undefined) {
+      if (init.kind !== undefined) this.kind = init.kind;
+      if (init.null_value !== undefined) this.kind = new Value.kind.null_value(init.null_value);
+      if (init.number_value !== undefined) this.kind = new Value.kind.number_value(init.number_value);
+      if (init.string_value !== undefined) this.kind = new Value.kind.string_value(init.string_value);
+      if (init.bool_value !== undefined) this.kind = new Value.kind.bool_value(init.bool_value);
+      if (init.struct_value !== undefined) this.kind = new Value.kind.struct_value(__pb__.Internal.fromInit(Struct, init.struct_value));
+      if (init.list_value !== undefined) this.kind = new Value.kind.list_value(__pb__.Internal.fromInit(ListValue, init.list_value));
+    }
+  }
+
+  MergeFrom(d: __pb__.Internal.Decoder): void {
+    while (!d.isEOF()) {
+      let [fn, wt] = d.readTag();
+      switch(fn) {
+        case 1:
+        this.kind = new Value.kind.null_value(d.readVarintSignedAsNumber());
+        break;
+        case 2:
+        this.kind = new Value.kind.number_value(d.readDouble());
+        break;
+        case 3:
+        this.kind = new Value.kind.string_value(d.readValidString());
+        break;
+        case 4:
+        this.kind = new Value.kind.bool_value(d.readBool());
+        break;
+        case 5:
+        {
+          let msg = new Struct();
+          msg.MergeFrom(d.readDecoder());
+          this.kind = new Value.kind.struct_value(msg);
+        }
+        break;
+        case 6:
+        {
+          let msg = new ListValue();
+          msg.MergeFrom(d.readDecoder());
+          this.kind = new Value.kind.list_value(msg);
+        }
+        break;
+        default:
+        this.unknownFields.push(d.readUnknown(wt, fn));
+      }
+    }
+  }
+
+  WriteTo(e: __pb__.Internal.Encoder): void {
+    Value.kind.WriteTo(this.kind, e);
+    e.writeUnknown(this.unknownFields);
+  }
+
+  MergeFromJSON(j: __pb__.JsonValue, _: __pb__.JsonOptions = {}): void {
+    const v = new __pb__.Value();
+    v.MergeFromJSON(j);
+    __pb__.Unmarshal(__pb__.Marshal(v), this);
+  }
+
+  ToJSON(_: __pb__.JsonOptions = {}): __pb__.JsonValue {
+    const v = new __pb__.Value();
+    __pb__.Unmarshal(__pb__.Marshal(this), v);
+    return v.ToJSON();
+  }
+
+  // toObject returns the message as a plain object, holding no classes.
+  toObject(): IValue {
+    const o: IValue = {};
+    if (this.kind instanceof Value.kind.null_value) {
+      o.null_value = this.kind.value;
+    }
+    if (this.kind instanceof Value.kind.number_value) {
+      o.number_value = this.kind.value;
+    }
+    if (this.kind instanceof Value.kind.string_value) {
+      o.string_value = this.kind.value;
+    }
+    if (this.kind instanceof Value.kind.bool_value) {
+      o.bool_value = this.kind.value;
+    }
+    if (this.kind instanceof Value.kind.struct_value) {
+      o.struct_value = this.kind.value.toObject();
+    }
+    if (this.kind instanceof Value.kind.list_value) {
+      o.list_value = this.kind.value.toObject();
+    }
+    return o;
+  }
+
+  // fromObject returns a message from its plain object form.
+  static fromObject(o: IValue): Value {
+    const m = new Value();
+    if (o.null_value !== undefined) m.kind = new Value.kind.null_value(o.null_value);
+    if (o.number_value !== undefined) m.kind = new Value.kind.number_value(o.number_value);
+    if (o.string_value !== undefined) m.kind = new Value.kind.string_value(o.string_value);
+    if (o.bool_value !== undefined) m.kind = new Value.kind.bool_value(o.bool_value);
+    if (o.struct_value !== undefined) m.kind = new Value.kind.struct_value(Struct.fromObject(o.struct_value));
+    if (o.list_value !== undefined) m.kind = new Value.kind.list_value(ListValue.fromObject(o.list_value));
+    return m;
+  }
+
+  // equals reports whether other holds the same values as the message.
+  equals(other: Value): boolean {
+    if (this === other) return true;
+    if (this.kind.kind !== other.kind.kind) return false;
+    if (this.kind instanceof Value.kind.null_value && other.kind instanceof Value.kind.null_value && this.kind.value !== other.kind.value) return false;
+    if (this.kind instanceof Value.kind.number_value && other.kind instanceof Value.kind.number_value && !__pb__.Internal.floatEqual(this.kind.value, other.kind.value)) return false;
+    if (this.kind instanceof Value.kind.string_value && other.kind instanceof Value.kind.string_value && this.kind.value !== other.kind.value) return false;
+    if (this.kind instanceof Value.kind.bool_value && other.kind instanceof Value.kind.bool_value && this.kind.value !== other.kind.value) return false;
+    if (this.kind instanceof Value.kind.struct_value && other.kind instanceof Value.kind.struct_value && !this.kind.value.equals(other.kind.value)) return false;
+    if (this.kind instanceof Value.kind.list_value && other.kind instanceof Value.kind.list_value && !this.kind.value.equals(other.kind.value)) return false;
+    if (!__pb__.Internal.unknownEqual(this.unknownFields, other.unknownFields)) return false;
+    return true;
+  }
+
+  // clone returns a deep copy of the message.
+  clone(): Value {
+    const m = new Value();
+    if (this.kind instanceof Value.kind.null_value) m.kind = new Value.kind.null_value(this.kind.value);
+    if (this.kind instanceof Value.kind.number_value) m.kind = new Value.kind.number_value(this.kind.value);
+    if (this.kind instanceof Value.kind.string_value) m.kind = new Value.kind.string_value(this.kind.value);
+    if (this.kind instanceof Value.kind.bool_value) m.kind = new Value.kind.bool_value(this.kind.value);
+    if (this.kind instanceof Value.kind.struct_value) m.kind = new Value.kind.struct_value(this.kind.value.clone());
+    if (this.kind instanceof Value.kind.list_value) m.kind = new Value.kind.list_value(this.kind.value.clone());
+    m.unknownFields = this.unknownFields.map(u => u.slice());
+    return m;
+  }
+
+  // hashCode returns a hash of the message's values, which is equal for
+  // equal messages and stable across runs.
+  hashCode(): number {
+    let h = 0;
+    h = __pb__.Internal.hashCombine(h, this.kind.kind);
+    if (this.kind instanceof Value.kind.null_value) h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashNumber(this.kind.value));
+    if (this.kind instanceof Value.kind.number_value) h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashNumber(this.kind.value));
+    if (this.kind instanceof Value.kind.string_value) h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashString(this.kind.value));
+    if (this.kind instanceof Value.kind.bool_value) h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashBool(this.kind.value));
+    if (this.kind instanceof Value.kind.struct_value) h = __pb__.Internal.hashCombine(h, this.kind.value.hashCode());
+    if (this.kind instanceof Value.kind.list_value) h = __pb__.Internal.hashCombine(h, this.kind.value.hashCode());
+    return h;
+  }
+}
+
+export namespace Value.kind {
+  /**
+   * Represents a null value.
+   */
+  export class null_value {
+    static readonly kind = 1;
+    readonly kind = 1;
+    value: NullValue;
+    constructor(v: NullValue) {
+      this.value = v;
+    }
+  }
+
+  /**
+   * Represents a double value.
+   */
+  export class number_value {
+    static readonly kind = 2;
+    readonly kind = 2;
+    value: number;
+    constructor(v: number) {
+      this.value = v;
+    }
+  }
+
+  /**
+   * Represents a string value.
+   */
+  export class string_value {
+    static readonly kind = 3;
+    readonly kind = 3;
+    value: string;
+    constructor(v: string) {
+      this.value = v;
+    }
+  }
+
+  /**
+   * Represents a boolean value.
+   */
+  export class bool_value {
+    static readonly kind = 4;
+    readonly kind = 4;
+    value: boolean;
+    constructor(v: boolean) {
+      this.value = v;
+    }
+  }
+
+  /**
+   * Represents a structured value.
+   */
+  export class struct_value {
+    static readonly kind = 5;
+    readonly kind = 5;
+    value: Struct | null;
+    constructor(v: Struct | null) {
+      this.value = v;
+    }
+  }
+
+  /**
+   * Represents a repeated `Value`.
+   */
+  export class list_value {
+    static readonly kind = 6;
+    readonly kind = 6;
+    value: ListValue | null;
+    constructor(v: ListValue | null) {
+      this.value = v;
+    }
+  }
+
+  export type oneof_type = __pb__.OneofNotSet | null_value | number_value | string_value | bool_value | struct_value | list_value;
+
+  export function WriteTo(oo: oneof_type, e: __pb__.Internal.Encoder):void {
+    switch (oo.kind) {
+      case 1:
+      e.writeTag(1, 0);
+      e.writeNumberAsVarint((oo as null_value).value);
+      return;
+      case 2:
+      e.writeTag(2, 1);
+      e.writeDouble((oo as number_value).value);
+      return;
+      case 3:
+      e.writeTag(3, 2);
+      e.writeString((oo as string_value).value);
+      return;
+      case 4:
+      e.writeTag(4, 0);
+      e.writeBool((oo as bool_value).value);
+      return;
+      case 5:
+      {
+        let nested = new __pb__.Internal.Encoder();
+        let msg = (oo as struct_value).value;
+        if (msg != null) {
+          msg.WriteTo(nested);
+        }
+        e.writeEncoder(nested, 5);
+        return
+      }
+      case 6:
+      {
+        let nested = new __pb__.Internal.Encoder();
+        let msg = (oo as list_value).value;
+        if (msg != null) {
+          msg.WriteTo(nested);
+        }
+        e.writeEncoder(nested, 6);
+        return
+      }
+    }
+  }
+}
+
+export interface ListValueInit {
+  /**
+   * Repeated field of dynamically typed values.
+   */
+  values?: (Value | ValueInit)[];
+}
+
+export interface IListValue {
+  /**
+   * Repeated field of dynamically typed values.
+   */
+  values?: IValue[];
+}
+
+/**
+ * `ListValue` is a wrapper around a repeated field of values.
+ *
+ * The JSON representation for `ListValue` is JSON array.
+ */
+export class ListValue implements __pb__.Message {
+  static readonly typeName = "google.protobuf.ListValue";
+
+  static readonly fields: __pb__.FieldInfo[] = [
+    { name: "values", number: 1, type: __pb__.FieldType.MESSAGE, label: __pb__.FieldLabel.REPEATED, jsonName: "values", member: "values", messageType: () => Value },
+  ];
+
+  /**
+   * Repeated field of dynamically typed values.
+   */
+  values: Value[];
+  // The encoding of fields which were not recognized when decoding.
+  unknownFields: Uint8Array[];
+
+  constructor(init?: ListValueInit) {
+    this.values = [];
+    this.unknownFields = [];
+    if (init !== undefined) {
+      if (init.values !== undefined) this.values = init.values.map(v => __pb__.Internal.fromInit(Value, v));
+    }
+  }
+
+  MergeFrom(d: __pb__.Internal.Decoder): void {
+    while (!d.isEOF()) {
+      let [fn, wt] = d.readTag();
+      switch(fn) {
+        case 1:
+        {
+          let obj = new Value();
+          obj.MergeFrom(d.readDecoder());
+          this.values.push(obj)
+        }
+        break;
+        default:
+        this.unknownFields.push(d.readUnknown(wt, fn));
+      }
+    }
+  }
+
+  WriteTo(e: __pb__.Internal.Encoder): void {
+    {
+      for (const msg of this.values) {
+        let nested = new __pb__.Internal.Encoder();
+        msg.WriteTo(nested);
+        e.writeEncoder(nested, 1);
+      }
+    }
+    e.writeUnknown(this.unknownFields);
+  }
+
+  MergeFromJSON(j: __pb__.JsonValue, _: __pb__.JsonOptions = {}): void {
+    const v = new __pb__.ListValue();
+    v.MergeFromJSON(j);
+    __pb__.Unmarshal(__pb__.Marshal(v), this);
+  }
+
+  ToJSON(_: __pb__.JsonOptions = {}): __pb__.JsonValue {
+    const v = new __pb__.ListValue();
+    __pb__.Unmarshal(__pb__.Marshal(this), v);
+    return v.ToJSON();
+  }
+
+  // toObject returns the message as a plain object, holding no classes.
+  toObject(): IListValue {
+    const o: IListValue = {};
+    o.values = this.values.map(v => v.toObject());
+    return o;
+  }
+
+  // fromObject returns a message from its plain object form.
+  static fromObject(o: IListValue): ListValue {
+    const m = new ListValue();
+    if (o.values !== undefined) m.values = o.values.map(v => Value.fromObject(v));
+    return m;
+  }
+
+  // equals reports whether other holds the same values as the message.
+  equals(other: ListValue): boolean {
+    if (this === other) return true;
+    if (!__pb__.Internal.arrayEqual(this.values, other.values, (x, y) => x.equals(y))) return false;
+    if (!__pb__.Internal.unknownEqual(this.unknownFields, other.unknownFields)) return false;
+    return true;
+  }
+
+  // clone returns a deep copy of the message.
+  clone(): ListValue {
+    const m = new ListValue();
+    m.values = this.values.map(v => v.clone());
+    m.unknownFields = this.unknownFields.map(u => u.slice());
+    return m;
+  }
+
+  // hashCode returns a hash of the message's values, which is equal for
+  // equal messages and stable across runs.
+  hashCode(): number {
+    let h = 0;
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashArray(this.values, v => v.hashCode()));
+    return h;
+  }
+}
+
+// fileDescriptor is the google.protobuf.FileDescriptorProto of google/protobuf/struct.proto.
+export const fileDescriptor = new __pb__.FileDescriptor(
+  "google/protobuf/struct.proto",
+  "Chxnb29nbGUvcHJvdG9idWYvc3RydWN0LnByb3RvEg9nb29nbGUucHJvdG9idWYimAEKBlN0cnVj" +
+    "dBI7CgZmaWVsZHMYASADKAsyIy5nb29nbGUucHJvdG9idWYuU3RydWN0LkZpZWxkc0VudHJ5UgZm" +
+    "aWVsZHMaUQoLRmllbGRzRW50cnkSEAoDa2V5GAEgASgJUgNrZXkSLAoFdmFsdWUYAiABKAsyFi5n" +
+    "b29nbGUucHJvdG9idWYuVmFsdWVSBXZhbHVlOgI4ASKyAgoFVmFsdWUSOwoKbnVsbF92YWx1ZRgB" +
+    "IAEoDjIaLmdvb2dsZS5wcm90b2J1Zi5OdWxsVmFsdWVIAFIJbnVsbFZhbHVlEiMKDG51bWJlcl92" +
+    "YWx1ZRgCIAEoAUgAUgtudW1iZXJWYWx1ZRIjCgxzdHJpbmdfdmFsdWUYAyABKAlIAFILc3RyaW5n" +
+    "VmFsdWUSHwoKYm9vbF92YWx1ZRgEIAEoCEgAUglib29sVmFsdWUSPAoMc3RydWN0X3ZhbHVlGAUg" +
+    "ASgLMhcuZ29vZ2xlLnByb3RvYnVmLlN0cnVjdEgAUgtzdHJ1Y3RWYWx1ZRI7CgpsaXN0X3ZhbHVl" +
+    "GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLkxpc3RWYWx1ZUgAUglsaXN0VmFsdWVCBgoEa2luZCI7" +
+    "CglMaXN0VmFsdWUSLgoGdmFsdWVzGAEgAygLMhYuZ29vZ2xlLnByb3RvYnVmLlZhbHVlUgZ2YWx1" +
+    "ZXMqGwoJTnVsbFZhbHVlEg4KCk5VTExfVkFMVUUQAEKBAQoTY29tLmdvb2dsZS5wcm90b2J1ZkIL" +
+    "U3RydWN0UHJvdG9QAVoxZ2l0aHViLmNvbS9nb2xhbmcvcHJvdG9idWYvcHR5cGVzL3N0cnVjdDtz" +
+    "dHJ1Y3RwYvgBAaICA0dQQqoCHkdvb2dsZS5Qcm90b2J1Zi5XZWxsS25vd25UeXBlc0qZHQoGEgQe" +
+    "AF8BCswMCgEMEgMeABIywQwgUHJvdG9jb2wgQnVmZmVycyAtIEdvb2dsZSdzIGRhdGEgaW50ZXJj" +
+    "aGFuZ2UgZm9ybWF0CiBDb3B5cmlnaHQgMjAwOCBHb29nbGUgSW5jLiAgQWxsIHJpZ2h0cyByZXNl" +
+    "cnZlZC4KIGh0dHBzOi8vZGV2ZWxvcGVycy5nb29nbGUuY29tL3Byb3RvY29sLWJ1ZmZlcnMvCgog" +
+    "UmVkaXN0cmlidXRpb24gYW5kIHVzZSBpbiBzb3VyY2UgYW5kIGJpbmFyeSBmb3Jtcywgd2l0aCBv" +
+    "ciB3aXRob3V0CiBtb2RpZmljYXRpb24sIGFyZSBwZXJtaXR0ZWQgcHJvdmlkZWQgdGhhdCB0aGUg" +
+    "Zm9sbG93aW5nIGNvbmRpdGlvbnMgYXJlCiBtZXQ6CgogICAgICogUmVkaXN0cmlidXRpb25zIG9m" +
+    "IHNvdXJjZSBjb2RlIG11c3QgcmV0YWluIHRoZSBhYm92ZSBjb3B5cmlnaHQKIG5vdGljZSwgdGhp" +
+    "cyBsaXN0IG9mIGNvbmRpdGlvbnMgYW5kIHRoZSBmb2xsb3dpbmcgZGlzY2xhaW1lci4KICAgICAq" +
+    "IFJlZGlzdHJpYnV0aW9ucyBpbiBiaW5hcnkgZm9ybSBtdXN0IHJlcHJvZHVjZSB0aGUgYWJvdmUK" +
+    "IGNvcHlyaWdodCBub3RpY2UsIHRoaXMgbGlzdCBvZiBjb25kaXRpb25zIGFuZCB0aGUgZm9sbG93" +
+    "aW5nIGRpc2NsYWltZXIKIGluIHRoZSBkb2N1bWVudGF0aW9uIGFuZC9vciBvdGhlciBtYXRlcmlh" +
+    "bHMgcHJvdmlkZWQgd2l0aCB0aGUKIGRpc3RyaWJ1dGlvbi4KICAgICAqIE5laXRoZXIgdGhlIG5h" +
+    "bWUgb2YgR29vZ2xlIEluYy4gbm9yIHRoZSBuYW1lcyBvZiBpdHMKIGNvbnRyaWJ1dG9ycyBtYXkg" +
+    "YmUgdXNlZCB0byBlbmRvcnNlIG9yIHByb21vdGUgcHJvZHVjdHMgZGVyaXZlZCBmcm9tCiB0aGlz" +
+    "IHNvZnR3YXJlIHdpdGhvdXQgc3BlY2lmaWMgcHJpb3Igd3JpdHRlbiBwZXJtaXNzaW9uLgoKIFRI" +
+    "SVMgU09GVFdBUkUgSVMgUFJPVklERUQgQlkgVEhFIENPUFlSSUdIVCBIT0xERVJTIEFORCBDT05U" +
+    "UklCVVRPUlMKICJBUyBJUyIgQU5EIEFOWSBFWFBSRVNTIE9SIElNUExJRUQgV0FSUkFOVElFUywg" +
+    "SU5DTFVESU5HLCBCVVQgTk9UCiBMSU1JVEVEIFRPLCBUSEUgSU1QTElFRCBXQVJSQU5USUVTIE9G" +
+    "IE1FUkNIQU5UQUJJTElUWSBBTkQgRklUTkVTUyBGT1IKIEEgUEFSVElDVUxBUiBQVVJQT1NFIEFS" +
+    "RSBESVNDTEFJTUVELiBJTiBOTyBFVkVOVCBTSEFMTCBUSEUgQ09QWVJJR0hUCiBPV05FUiBPUiBD" +
+    "T05UUklCVVRPUlMgQkUgTElBQkxFIEZPUiBBTlkgRElSRUNULCBJTkRJUkVDVCwgSU5DSURFTlRB" +
+    "TCwKIFNQRUNJQUwsIEVYRU1QTEFSWSwgT1IgQ09OU0VRVUVOVElBTCBEQU1BR0VTIChJTkNMVURJ" +
+    "TkcsIEJVVCBOT1QKIExJTUlURUQgVE8sIFBST0NVUkVNRU5UIE9GIFNVQlNUSVRVVEUgR09PRFMg" +
+    "T1IgU0VSVklDRVM7IExPU1MgT0YgVVNFLAogREFUQSwgT1IgUFJPRklUUzsgT1IgQlVTSU5FU1Mg" +
+    "SU5URVJSVVBUSU9OKSBIT1dFVkVSIENBVVNFRCBBTkQgT04gQU5ZCiBUSEVPUlkgT0YgTElBQklM" +
+    "SVRZLCBXSEVUSEVSIElOIENPTlRSQUNULCBTVFJJQ1QgTElBQklMSVRZLCBPUiBUT1JUCiAoSU5D" +
+    "TFVESU5HIE5FR0xJR0VOQ0UgT1IgT1RIRVJXSVNFKSBBUklTSU5HIElOIEFOWSBXQVkgT1VUIE9G" +
+    "IFRIRSBVU0UKIE9GIFRISVMgU09GVFdBUkUsIEVWRU4gSUYgQURWSVNFRCBPRiBUSEUgUE9TU0lC" +
+    "SUxJVFkgT0YgU1VDSCBEQU1BR0UuCgoICgECEgMgABgKCAoBCBIDIgA7CgkKAgglEgMiADsKCAoB" +
+    "CBIDIwAfCgkKAggfEgMjAB8KCAoBCBIDJABICgkKAggLEgMkAEgKCAoBCBIDJQAsCgkKAggBEgMl" +
+    "ACwKCAoBCBIDJgAsCgkKAggIEgMmACwKCAoBCBIDJwAiCgkKAggKEgMnACIKCAoBCBIDKAAhCgkK" +
+    "AggkEgMoACEKswMKAgQAEgQzADYBGqYDIGBTdHJ1Y3RgIHJlcHJlc2VudHMgYSBzdHJ1Y3R1cmVk" +
+    "IGRhdGEgdmFsdWUsIGNvbnNpc3Rpbmcgb2YgZmllbGRzCiB3aGljaCBtYXAgdG8gZHluYW1pY2Fs" +
+    "bHkgdHlwZWQgdmFsdWVzLiBJbiBzb21lIGxhbmd1YWdlcywgYFN0cnVjdGAKIG1pZ2h0IGJlIHN1" +
+    "cHBvcnRlZCBieSBhIG5hdGl2ZSByZXByZXNlbnRhdGlvbi4gRm9yIGV4YW1wbGUsIGluCiBzY3Jp" +
+    "cHRpbmcgbGFuZ3VhZ2VzIGxpa2UgSlMgYSBzdHJ1Y3QgaXMgcmVwcmVzZW50ZWQgYXMgYW4KIG9i" +
+    "amVjdC4gVGhlIGRldGFpbHMgb2YgdGhhdCByZXByZXNlbnRhdGlvbiBhcmUgZGVzY3JpYmVkIHRv" +
+    "Z2V0aGVyCiB3aXRoIHRoZSBwcm90byBzdXBwb3J0IGZvciB0aGUgbGFuZ3VhZ2UuCgogVGhlIEpT" +
+    "T04gcmVwcmVzZW50YXRpb24gZm9yIGBTdHJ1Y3RgIGlzIEpTT04gb2JqZWN0LgoKCgoDBAABEgMz" +
+    "CA4KOQoEBAACABIDNQIgGiwgVW5vcmRlcmVkIG1hcCBvZiBkeW5hbWljYWxseSB0eXBlZCB2YWx1" +
+    "ZXMuCgoMCgUEAAIABhIDNQIUCgwKBQQAAgABEgM1FRsKDAoFBAACAAMSAzUeHwrDAgoCBAESBD4A" +
+    "TgEatgIgYFZhbHVlYCByZXByZXNlbnRzIGEgZHluYW1pY2FsbHkgdHlwZWQgdmFsdWUgd2hpY2gg" +
+    "Y2FuIGJlIGVpdGhlcgogbnVsbCwgYSBudW1iZXIsIGEgc3RyaW5nLCBhIGJvb2xlYW4sIGEgcmVj" +
+    "dXJzaXZlIHN0cnVjdCB2YWx1ZSwgb3IgYQogbGlzdCBvZiB2YWx1ZXMuIEEgcHJvZHVjZXIgb2Yg" +
+    "dmFsdWUgaXMgZXhwZWN0ZWQgdG8gc2V0IG9uZSBvZiB0aGF0CiB2YXJpYW50cywgYWJzZW5jZSBv" +
+    "ZiBhbnkgdmFyaWFudCBpbmRpY2F0ZXMgYW4gZXJyb3IuCgogVGhlIEpTT04gcmVwcmVzZW50YXRp" +
+    "b24gZm9yIGBWYWx1ZWAgaXMgSlNPTiB2YWx1ZS4KCgoKAwQBARIDPggNCiIKBAQBCAASBEACTQMa" +
+    "FCBUaGUga2luZCBvZiB2YWx1ZS4KCgwKBQQBCAABEgNACAwKJwoEBAECABIDQgQdGhogUmVwcmVz" +
+    "ZW50cyBhIG51bGwgdmFsdWUuCgoMCgUEAQIABhIDQgQNCgwKBQQBAgABEgNCDhgKDAoFBAECAAMS" +
+    "A0IbHAopCgQEAQIBEgNEBBwaHCBSZXByZXNlbnRzIGEgZG91YmxlIHZhbHVlLgoKDAoFBAECAQUS" +
+    "A0QECgoMCgUEAQIBARIDRAsXCgwKBQQBAgEDEgNEGhsKKQoEBAECAhIDRgQcGhwgUmVwcmVzZW50" +
+    "cyBhIHN0cmluZyB2YWx1ZS4KCgwKBQQBAgIFEgNGBAoKDAoFBAECAgESA0YLFwoMCgUEAQICAxID" +
+    "RhobCioKBAQBAgMSA0gEGBodIFJlcHJlc2VudHMgYSBib29sZWFuIHZhbHVlLgoKDAoFBAECAwUS" +
+    "A0gECAoMCgUEAQIDARIDSAkTCgwKBQQBAgMDEgNIFhcKLQoEBAECBBIDSgQcGiAgUmVwcmVzZW50" +
+    "cyBhIHN0cnVjdHVyZWQgdmFsdWUuCgoMCgUEAQIEBhIDSgQKCgwKBQQBAgQBEgNKCxcKDAoFBAEC" +
+    "BAMSA0oaGwotCgQEAQIFEgNMBB0aICBSZXByZXNlbnRzIGEgcmVwZWF0ZWQgYFZhbHVlYC4KCgwK" +
+    "BQQBAgUGEgNMBA0KDAoFBAECBQESA0wOGAoMCgUEAQIFAxIDTBscCqkBCgIFABIEVABXARqcASBg" +
+    "TnVsbFZhbHVlYCBpcyBhIHNpbmdsZXRvbiBlbnVtZXJhdGlvbiB0byByZXByZXNlbnQgdGhlIG51" +
+    "bGwgdmFsdWUgZm9yIHRoZQogYFZhbHVlYCB0eXBlIHVuaW9uLgoKICBUaGUgSlNPTiByZXByZXNl" +
+    "bnRhdGlvbiBmb3IgYE51bGxWYWx1ZWAgaXMgSlNPTiBgbnVsbGAuCgoKCgMFAAESA1QFDgoaCgQF" +
+    "AAIAEgNWAhEaDSBOdWxsIHZhbHVlLgoKDAoFBQACAAESA1YCDAoMCgUFAAIAAhIDVg8QCoIBCgIE" +
+    "AhIEXABfARp2IGBMaXN0VmFsdWVgIGlzIGEgd3JhcHBlciBhcm91bmQgYSByZXBlYXRlZCBmaWVs" +
+    "ZCBvZiB2YWx1ZXMuCgogVGhlIEpTT04gcmVwcmVzZW50YXRpb24gZm9yIGBMaXN0VmFsdWVgIGlz" +
+    "IEpTT04gYXJyYXkuCgoKCgMEAgESA1wIEQo6CgQEAgIAEgNeAhwaLSBSZXBlYXRlZCBmaWVsZCBv" +
+    "ZiBkeW5hbWljYWxseSB0eXBlZCB2YWx1ZXMuCgoMCgUEAgIABBIDXgIKCgwKBQQCAgAGEgNeCxAK" +
+    "DAoFBAICAAESA14RFwoMCgUEAgIAAxIDXhobYgZwcm90bzM=",
+  []
+);
+
+__pb__.globalRegistry.add(Struct);
+__pb__.globalRegistry.add(Value);
+__pb__.globalRegistry.add(ListValue);
+__pb__.globalRegistry.addEnum(NullValueInfo);
+__pb__.globalRegistry.addFile(fileDescriptor);
@@ -0,0 +1,1098 @@
+// Generated by the protocol buffer compiler.  DO NOT EDIT!
+// Source: google/protobuf/wrappers.proto
+
+import * as __pb__ from '../../../../lib/protobuf'
+import * as __long from 'long'
+import {fromString as __longFromString } from 'long'
+
+
+export interface DoubleValueInit {
+  /**
+   * The double value.
+   */
+  value?: number;
+}
+
+export interface IDoubleValue {
+  /**
+   * The double value.
+   */
+  value?: number;
+}
+
+/**
+ * Wrapper message for `double`.
+ *
+ * The JSON representation for `DoubleValue` is JSON number.
+ */
+export class DoubleValue implements __pb__.Message {
+  static readonly typeName = "google.protobuf.DoubleValue";
+
+  static readonly fields: __pb__.FieldInfo[] = [
+    { name: "value", number: 1, type: __pb__.FieldType.DOUBLE, label: __pb__.FieldLabel.OPTIONAL, jsonName: "value", member: "value" },
+  ];
+
+  /**
+   * The double value.
+   */
+  value: number;
+  // The encoding of fields which were not recognized when decoding.
+  unknownFields: Uint8Array[];
+
+  constructor(init?: DoubleValueInit) {
+    this.value = 0.0;
+    this.unknownFields = [];
+    if (init !== undefined) {
+      if (init.value !== undefined) this.value = init.value;
+    }
+  }
+
+  MergeFrom(d: __pb__.Internal.Decoder): void {
+    while (!d.isEOF()) {
+      let [fn, wt] = d.readTag();
+      switch(fn) {
+        case 1:
+        this.value = d.readDouble();
+        break;
+        default:
+        this.unknownFields.push(d.readUnknown(wt, fn));
+      }
+    }
+  }
+
+  WriteTo(e: __pb__.Internal.Encoder): void {
+    if (this.value != 0.0) {
+      e.writeTag(1, 1);
+      e.writeDouble(this.value);
+    }
+    e.writeUnknown(this.unknownFields);
+  }
+
+  MergeFromJSON(j: __pb__.JsonValue, _: __pb__.JsonOptions = {}): void {
+    const v = new __pb__.DoubleValue();
+    v.MergeFromJSON(j);
+    this.value = v.value;
+  }
+
+  ToJSON(_: __pb__.JsonOptions = {}): __pb__.JsonValue {
+    return new __pb__.DoubleValue(this.value).ToJSON();
+  }
+
+  // toObject returns the message as a plain object, holding no classes.
+  toObject(): IDoubleValue {
+    const o: IDoubleValue = {};
+    o.value = this.value;
+    return o;
+  }
+
+  // fromObject returns a message from its plain object form.
+  static fromObject(o: IDoubleValue): DoubleValue {
+    const m = new DoubleValue();
+    if (o.value !== undefined) m.value = o.value;
+    return m;
+  }
+
+  // equals reports whether other holds the same values as the message.
+  equals(other: DoubleValue): boolean {
+    if (this === other) return true;
+    if (!__pb__.Internal.floatEqual(this.value, other.value)) return false;
+    if (!__pb__.Internal.unknownEqual(this.unknownFields, other.unknownFields)) return false;
+    return true;
+  }
+
+  // clone returns a deep copy of the message.
+  clone(): DoubleValue {
+    const m = new DoubleValue();
+    m.value = this.value;
+    m.unknownFields = this.unknownFields.map(u => u.slice());
+    return m;
+  }
+
+  // hashCode returns a hash of the message's values, which is equal for
+  // equal messages and stable across runs.
+  hashCode(): number {
+    let h = 0;
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashNumber(this.value));
+    return h;
+  }
+}
+
+export interface FloatValueInit {
+  /**
+   * The float value.
+   */
+  value?: number;
+}
+
+export interface IFloatValue {
+  /**
+   * The float value.
+   */
+  value?: number;
+}
+
+/**
+ * Wrapper message for `float`.
+ *
+ * The JSON representation for `FloatValue` is JSON number.
+ */
+export class FloatValue implements __pb__.Message {
+  static readonly typeName = "google.protobuf.FloatValue";
+
+  static readonly fields: __pb__.FieldInfo[] = [
+    { name: "value", number: 1, type: __pb__.FieldType.FLOAT, label: __pb__.FieldLabel.OPTIONAL, jsonName: "value", member: "value" },
+  ];
+
+  /**
+   * The float value.
+   */
+  value: number;
+  // The encoding of fields which were not recognized when decoding.
+  unknownFields: Uint8Array[];
+
+  constructor(init?: FloatValueInit) {
+    this.value = 0.0;
+    this.unknownFields = [];
+    if (init !== undefined) {
+      if (init.value !== undefined) this.value = init.value;
+    }
+  }
+
+  MergeFrom(d: __pb__.Internal.Decoder): void {
+    while (!d.isEOF()) {
+      let [fn, wt] = d.readTag();
+      switch(fn) {
+        case 1:
+        this.value = d.readFloat();
+        break;
+        default:
+        this.unknownFields.push(d.readUnknown(wt, fn));
+      }
+    }
+  }
+
+  WriteTo(e: __pb__.Internal.Encoder): void {
+    if (this.value != 0.0) {
+      e.writeTag(1, 5);
+      e.writeFloat(this.value);
+    }
+    e.writeUnknown(this.unknownFields);
+  }
+
+  MergeFromJSON(j: __pb__.JsonValue, _: __pb__.JsonOptions = {}): void {
+    const v = new __pb__.FloatValue();
+    v.MergeFromJSON(j);
+    this.value = v.value;
+  }
+
+  ToJSON(_: __pb__.JsonOptions = {}): __pb__.JsonValue {
+    return new __pb__.FloatValue(this.value).ToJSON();
+  }
+
+  // toObject returns the message as a plain object, holding no classes.
+  toObject(): IFloatValue {
+    const o: IFloatValue = {};
+    o.value = this.value;
+    return o;
+  }
+
+  // fromObject returns a message from its plain object form.
+  static fromObject(o: IFloatValue): FloatValue {
+    const m = new FloatValue();
+    if (o.value !== undefined) m.value = o.value;
+    return m;
+  }
+
+  // equals reports whether other holds the same values as the message.
+  equals(other: FloatValue): boolean {
+    if (this === other) return true;
+    if (!__pb__.Internal.floatEqual(this.value, other.value)) return false;
+    if (!__pb__.Internal.unknownEqual(this.unknownFields, other.unknownFields)) return false;
+    return true;
+  }
+
+  // clone returns a deep copy of the message.
+  clone(): FloatValue {
+    const m = new FloatValue();
+    m.value = this.value;
+    m.unknownFields = this.unknownFields.map(u => u.slice());
+    return m;
+  }
+
+  // hashCode returns a hash of the message's values, which is equal for
+  // equal messages and stable across runs.
+  hashCode(): number {
+    let h = 0;
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashNumber(this.value));
+    return h;
+  }
+}
+
+export interface Int64ValueInit {
+  /**
+   * The int64 value.
+   */
+  value?: __long;
+}
+
+export interface IInt64Value {
+  /**
+   * The int64 value.
+   */
+  value?: string;
+}
+
+/**
+ * Wrapper message for `int64`.
+ *
+ * The JSON representation for `Int64Value` is JSON string.
+ */
+export class Int64Value implements __pb__.Message {
+  static readonly typeName = "google.protobuf.Int64Value";
+
+  static readonly fields: __pb__.FieldInfo[] = [
+    { name: "value", number: 1, type: __pb__.FieldType.INT64, label: __pb__.FieldLabel.OPTIONAL, jsonName: "value", member: "value" },
+  ];
+
+  /**
+   * The int64 value.
+   */
+  value: __long;
+  // The encoding of fields which were not recognized when decoding.
+  unknownFields: Uint8Array[];
+
+  constructor(init?: Int64ValueInit) {
+    this.value = __long.ZERO;
+    this.unknownFields = [];
+    if (init !== undefined) {
+      if (init.value !== undefined) this.value = init.value;
+    }
+  }
+
+  MergeFrom(d: __pb__.Internal.Decoder): void {
+    while (!d.isEOF()) {
+      let [fn, wt] = d.readTag();
+      switch(fn) {
+        case 1:
+        this.value = d.readVarintSigned();
+        break;
+        default:
+        this.unknownFields.push(d.readUnknown(wt, fn));
+      }
+    }
+  }
+
+  WriteTo(e: __pb__.Internal.Encoder): void {
+    if (this.value != __long.ZERO) {
+      e.writeTag(1, 0);
+      e.writeVarint(this.value);
+    }
+    e.writeUnknown(this.unknownFields);
+  }
+
+  MergeFromJSON(j: __pb__.JsonValue, _: __pb__.JsonOptions = {}): void {
+    const v = new __pb__.Int64Value();
+    v.MergeFromJSON(j);
+    this.value = v.value;
+  }
+
+  ToJSON(_: __pb__.JsonOptions = {}): __pb__.JsonValue {
+    return new __pb__.Int64Value(this.value).ToJSON();
+  }
+
+  // toObject returns the message as a plain object, holding no classes.
+  toObject(): IInt64Value {
+    const o: IInt64Value = {};
+    o.value = this.value.toString();
+    return o;
+  }
+
+  // fromObject returns a message from its plain object form.
+  static fromObject(o: IInt64Value): Int64Value {
+    const m = new Int64Value();
+    if (o.value !== undefined) m.value = __longFromString(o.value, false);
+    return m;
+  }
+
+  // equals reports whether other holds the same values as the message.
+  equals(other: Int64Value): boolean {
+    if (this === other) return true;
+    if (!this.value.equals(other.value)) return false;
+    if (!__pb__.Internal.unknownEqual(this.unknownFields, other.unknownFields)) return false;
+    return true;
+  }
+
+  // clone returns a deep copy of the message.
+  clone(): Int64Value {
+    const m = new Int64Value();
+    m.value = this.value;
+    m.unknownFields = this.unknownFields.map(u => u.slice());
+    return m;
+  }
+
+  // hashCode returns a hash of the message's values, which is equal for
+  // equal messages and stable across runs.
+  hashCode(): number {
+    let h = 0;
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashLong(this.value));
+    return h;
+  }
+}
+
+export interface UInt64ValueInit {
+  /**
+   * The uint64 value.
+   */
+  value?: __long;
+}
+
+export interface IUInt64Value {
+  /**
+   * The uint64 value.
+   */
+  value?: string;
+}
+
+/**
+ * Wrapper message for `uint64`.
+ *
+ * The JSON representation for `UInt64Value` is JSON string.
+ */
+export class UInt64Value implements __pb__.Message {
+  static readonly typeName = "google.protobuf.UInt64Value";
+
+  static readonly fields: __pb__.FieldInfo[] = [
+    { name: "value", number: 1, type: __pb__.FieldType.UINT64, label: __pb__.FieldLabel.OPTIONAL, jsonName: "value", member: "value" },
+  ];
+
+  /**
+   * The uint64 value.
+   */
+  value: __long;
+  // The encoding of fields which were not recognized when decoding.
+  unknownFields: Uint8Array[];
+
+  constructor(init?: UInt64ValueInit) {
+    this.value = __long.UZERO;
+    this.unknownFields = [];
+    if (init !== undefined) {
+      if (init.value !== undefined) this.value = init.value;
+    }
+  }
+
+  MergeFrom(d: __pb__.Internal.Decoder): void {
+    while (!d.isEOF()) {
+      let [fn, wt] = d.readTag();
+      switch(fn) {
+        case 1:
+        this.value = d.readVarint();
+        break;
+        default:
+        this.unknownFields.push(d.readUnknown(wt, fn));
+      }
+    }
+  }
+
+  WriteTo(e: __pb__.Internal.Encoder): void {
+    if (this.value != __long.UZERO) {
+      e.writeTag(1, 0);
+      e.writeVarint(this.value);
+    }
+    e.writeUnknown(this.unknownFields);
+  }
+
+  MergeFromJSON(j: __pb__.JsonValue, _: __pb__.JsonOptions = {}): void {
+    const v = new __pb__.UInt64Value();
+    v.MergeFromJSON(j);
+    this.value = v.value;
+  }
+
+  ToJSON(_: __pb__.JsonOptions = {}): __pb__.JsonValue {
+    return new __pb__.UInt64Value(this.value).ToJSON();
+  }
+
+  // toObject returns the message as a plain object, holding no classes.
+  toObject(): IUInt64Value {
+    const o: IUInt64Value = {};
+    o.value = this.value.toString();
+    return o;
+  }
+
+  // fromObject returns a message from its plain object form.
+  static fromObject(o: IUInt64Value): UInt64Value {
+    const m = new UInt64Value();
+    if (o.value !== undefined) m.value = __longFromString(o.value, true);
+    return m;
+  }
+
+  // equals reports whether other holds the same values as the message.
+  equals(other: UInt64Value): boolean {
+    if (this === other) return true;
+    if (!this.value.equals(other.value)) return false;
+    if (!__pb__.Internal.unknownEqual(this.unknownFields, other.unknownFields)) return false;
+    return true;
+  }
+
+  // clone returns a deep copy of the message.
+  clone(): UInt64Value {
+    const m = new UInt64Value();
+    m.value = this.value;
+    m.unknownFields = this.unknownFields.map(u => u.slice());
+    return m;
+  }
+
+  // hashCode returns a hash of the message's values, which is equal for
+  // equal messages and stable across runs.
+  hashCode(): number {
+    let h = 0;
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashLong(this.value));
+    return h;
+  }
+}
+
+export interface Int32ValueInit {
+  /**
+   * The int32 value.
+   */
+  value?: number;
+}
+
+export interface IInt32Value {
+  /**
+   * The int32 value.
+   */
+  value?: number;
+}
+
+/**
+ * Wrapper message for `int32`.
+ *
+ * The JSON representation for `Int32Value` is JSON number.
+ */
+export class Int32Value implements __pb__.Message {
+  static readonly typeName = "google.protobuf.Int32Value";
+
+  static readonly fields: __pb__.FieldInfo[] = [
+    { name: "value", number: 1, type: __pb__.FieldType.INT32, label: __pb__.FieldLabel.OPTIONAL, jsonName: "value", member: "value" },
+  ];
+
+  /**
+   * The int32 value.
+   */
+  value: number;
+  // The encoding of fields which were not recognized when decoding.
+  unknownFields: Uint8Array[];
+
+  constructor(init?: Int32ValueInit) {
+    this.value = 0;
+    this.unknownFields = [];
+    if (init !== undefined) {
+      if (init.value !== undefined) this.value = init.value;
+    }
+  }
+
+  MergeFrom(d: __pb__.Internal.Decoder): void {
+    while (!d.isEOF()) {
+      let [fn, wt] = d.readTag();
+      switch(fn) {
+        case 1:
+        this.value = d.readVarInt32();
+        break;
+        default:
+        this.unknownFields.push(d.readUnknown(wt, fn));
+      }
+    }
+  }
+
+  WriteTo(e: __pb__.Internal.Encoder): void {
+    if (this.value != 0) {
+      e.writeTag(1, 0);
+      e.writeNumberAsVarint(this.value);
+    }
+    e.writeUnknown(this.unknownFields);
+  }
+
+  MergeFromJSON(j: __pb__.JsonValue, _: __pb__.JsonOptions = {}): void {
+    const v = new __pb__.Int32Value();
+    v.MergeFromJSON(j);
+    this.value = v.value;
+  }
+
+  ToJSON(_: __pb__.JsonOptions = {}): __pb__.JsonValue {
+    return new __pb__.Int32Value(this.value).ToJSON();
+  }
+
+  // toObject returns the message as a plain object, holding no classes.
+  toObject(): IInt32Value {
+    const o: IInt32Value = {};
+    o.value = this.value;
+    return o;
+  }
+
+  // fromObject returns a message from its plain object form.
+  static fromObject(o: IInt32Value): Int32Value {
+    const m = new Int32Value();
+    if (o.value !== undefined) m.value = o.value;
+    return m;
+  }
+
+  // equals reports whether other holds the same values as the message.
+  equals(other: Int32Value): boolean {
+    if (this === other) return true;
+    if (this.value !== other.value) return false;
+    if (!__pb__.Internal.unknownEqual(this.unknownFields, other.unknownFields)) return false;
+    return true;
+  }
+
+  // clone returns a deep copy of the message.
+  clone(): Int32Value {
+    const m = new Int32Value();
+    m.value = this.value;
+    m.unknownFields = this.unknownFields.map(u => u.slice());
+    return m;
+  }
+
+  // hashCode returns a hash of the message's values, which is equal for
+  // equal messages and stable across runs.
+  hashCode(): number {
+    let h = 0;
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashNumber(this.value));
+    return h;
+  }
+}
+
+export interface UInt32ValueInit {
+  /**
+   * The uint32 value.
+   */
+  value?: number;
+}
+
+export interface IUInt32Value {
+  /**
+   * The uint32 value.
+   */
+  value?: number;
+}
+
+/**
+ * Wrapper message for `uint32`.
+ *
+ * The JSON representation for `UInt32Value` is JSON number.
+ */
+export class UInt32Value implements __pb__.Message {
+  static readonly typeName = "google.protobuf.UInt32Value";
+
+  static readonly fields: __pb__.FieldInfo[] = [
+    { name: "value", number: 1, type: __pb__.FieldType.UINT32, label: __pb__.FieldLabel.OPTIONAL, jsonName: "value", member: "value" },
+  ];
+
+  /**
+   * The uint32 value.
+   */
+  value: number;
+  // The encoding of fields which were not recognized when decoding.
+  unknownFields: Uint8Array[];
+
+  constructor(init?: UInt32ValueInit) {
+    this.value = 0;
+    this.unknownFields = [];
+    if (init !== undefined) {
+      if (init.value !== undefined) this.value = init.value;
+    }
+  }
+
+  MergeFrom(d: __pb__.Internal.Decoder): void {
+    while (!d.isEOF()) {
+      let [fn, wt] = d.readTag();
+      switch(fn) {
+        case 1:
+        this.value = d.readVarUint32();
+        break;
+        default:
+        this.unknownFields.push(d.readUnknown(wt, fn));
+      }
+    }
+  }
+
+  WriteTo(e: __pb__.Internal.Encoder): void {
+    if (this.value != 0) {
+      e.writeTag(1, 0);
+      e.writeNumberAsVarint(this.value);
+    }
+    e.writeUnknown(this.unknownFields);
+  }
+
+  MergeFromJSON(j: __pb__.JsonValue, _: __pb__.JsonOptions = {}): void {
+    const v = new __pb__.UInt32Value();
+    v.MergeFromJSON(j);
+    this.value = v.value;
+  }
+
+  ToJSON(_: __pb__.JsonOptions = {}): __pb__.JsonValue {
+    return new __pb__.UInt32Value(this.value).ToJSON();
+  }
+
+  // toObject returns the message as a plain object, holding no classes.
+  toObject(): IUInt32Value {
+    const o: IUInt32Value = {};
+    o.value = this.value;
+    return o;
+  }
+
+  // fromObject returns a message from its plain object form.
+  static fromObject(o: IUInt32Value): UInt32Value {
+    const m = new UInt32Value();
+    if (o.value !== undefined) m.value = o.value;
+    return m;
+  }
+
+  // equals reports whether other holds the same values as the message.
+  equals(other: UInt32Value): boolean {
+    if (this === other) return true;
+    if (this.value !== other.value) return false;
+    if (!__pb__.Internal.unknownEqual(this.unknownFields, other.unknownFields)) return false;
+    return true;
+  }
+
+  // clone returns a deep copy of the message.
+  clone(): UInt32Value {
+    const m = new UInt32Value();
+    m.value = this.value;
+    m.unknownFields = this.unknownFields.map(u => u.slice());
+    return m;
+  }
+
+  // hashCode returns a hash of the message's values, which is equal for
+  // equal messages and stable across runs.
+  hashCode(): number {
+    let h = 0;
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashNumber(this.value));
+    return h;
+  }
+}
+
+export interface BoolValueInit {
+  /**
+   * The bool value.
+   */
+  value?: boolean;
+}
+
+export interface IBoolValue {
+  /**
+   * The bool value.
+   */
+  value?: boolean;
+}
+
+/**
+ * Wrapper message for `bool`.
+ *
+ * The JSON representation for `BoolValue` is JSON `true` and `false`.
+ */
+export class BoolValue implements __pb__.Message {
+  static readonly typeName = "google.protobuf.BoolValue";
+
+  static readonly fields: __pb__.FieldInfo[] = [
+    { name: "value", number: 1, type: __pb__.FieldType.BOOL, label: __pb__.FieldLabel.OPTIONAL, jsonName: "value", member: "value" },
+  ];
+
+  /**
+   * The bool value.
+   */
+  value: boolean;
+  // The encoding of fields which were not recognized when decoding.
+  unknownFields: Uint8Array[];
+
+  constructor(init?: BoolValueInit) {
+    this.value = false;
+    this.unknownFields = [];
+    if (init !== undefined) {
+      if (init.value !== undefined) this.value = init.value;
+    }
+  }
+
+  MergeFrom(d: __pb__.Internal.Decoder): void {
+    while (!d.isEOF()) {
+      let [fn, wt] = d.readTag();
+      switch(fn) {
+        case 1:
+        this.value = d.readBool();
+        break;
+        default:
+        this.unknownFields.push(d.readUnknown(wt, fn));
+      }
+    }
+  }
+
+  WriteTo(e: __pb__.Internal.Encoder): void {
+    if (this.value != false) {
+      e.writeTag(1, 0);
+      e.writeBool(this.value);
+    }
+    e.writeUnknown(this.unknownFields);
+  }
+
+  MergeFromJSON(j: __pb__.JsonValue, _: __pb__.JsonOptions = {}): void {
+    const v = new __pb__.BoolValue();
+    v.MergeFromJSON(j);
+    this.value = v.value;
+  }
+
+  ToJSON(_: __pb__.JsonOptions = {}): __pb__.JsonValue {
+    return new __pb__.BoolValue(this.value).ToJSON();
+  }
+
+  // toObject returns the message as a plain object, holding no classes.
+  toObject(): IBoolValue {
+    const o: IBoolValue = {};
+    o.value = this.value;
+    return o;
+  }
+
+  // fromObject returns a message from its plain object form.
+  static fromObject(o: IBoolValue): BoolValue {
+    const m = new BoolValue();
+    if (o.value !== undefined) m.value = o.value;
+    return m;
+  }
+
+  // equals reports whether other holds the same values as the message.
+  equals(other: BoolValue): boolean {
+    if (this === other) return true;
+    if (this.value !== other.value) return false;
+    if (!__pb__.Internal.unknownEqual(this.unknownFields, other.unknownFields)) return false;
+    return true;
+  }
+
+  // clone returns a deep copy of the message.
+  clone(): BoolValue {
+    const m = new BoolValue();
+    m.value = this.value;
+    m.unknownFields = this.unknownFields.map(u => u.slice());
+    return m;
+  }
+
+  // hashCode returns a hash of the message's values, which is equal for
+  // equal messages and stable across runs.
+  hashCode(): number {
+    let h = 0;
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashBool(this.value));
+    return h;
+  }
+}
+
+export interface StringValueInit {
+  /**
+   * The string value.
+   */
+  value?: string;
+}
+
+export interface IStringValue {
+  /**
+   * The string value.
+   */
+  value?: string;
+}
+
+/**
+ * Wrapper message for `string`.
+ *
+ * The JSON representation for `StringValue` is JSON string.
+ */
+export class StringValue implements __pb__.Message {
+  static readonly typeName = "google.protobuf.StringValue";
+
+  static readonly fields: __pb__.FieldInfo[] = [
+    { name: "value", number: 1, type: __pb__.FieldType.STRING, label: __pb__.FieldLabel.OPTIONAL, jsonName: "value", member: "value" },
+  ];
+
+  /**
+   * The string value.
+   */
+  value: string;
+  // The encoding of fields which were not recognized when decoding.
+  unknownFields: Uint8Array[];
+
+  constructor(init?: StringValueInit) {
+    this.value = "";
+    this.unknownFields = [];
+    if (init !== undefined) {
+      if (init.value !== undefined) this.value = init.value;
+    }
+  }
+
+  MergeFrom(d: __pb__.Internal.Decoder): void {
+    while (!d.isEOF()) {
+      let [fn, wt] = d.readTag();
+      switch(fn) {
+        case 1:
+        this.value = d.readValidString();
+        break;
+        default:
+        this.unknownFields.push(d.readUnknown(wt, fn));
+      }
+    }
+  }
+
+  WriteTo(e: __pb__.Internal.Encoder): void {
+    if (this.value != "") {
+      e.writeTag(1, 2);
+      e.writeString(this.value);
+    }
+    e.writeUnknown(this.unknownFields);
+  }
+
+  MergeFromJSON(j: __pb__.JsonValue, _: __pb__.JsonOptions = {}): void {
+    const v = new __pb__.StringValue();
+    v.MergeFromJSON(j);
+    this.value = v.value;
+  }
+
+  ToJSON(_: __pb__.JsonOptions = {}): __pb__.JsonValue {
+    return new __pb__.StringValue(this.value).ToJSON();
+  }
+
+  // toObject returns the message as a plain object, holding no classes.
+  toObject(): IStringValue {
+    const o: IStringValue = {};
+    o.value = this.value;
+    return o;
+  }
+
+  // fromObject returns a message from its plain object form.
+  static fromObject(o: IStringValue): StringValue {
+    const m = new StringValue();
+    if (o.value !== undefined) m.value = o.value;
+    return m;
+  }
+
+  // equals reports whether other holds the same values as the message.
+  equals(other: StringValue): boolean {
+    if (this === other) return true;
+    if (this.value !== other.value) return false;
+    if (!__pb__.Internal.unknownEqual(this.unknownFields, other.unknownFields)) return false;
+    return true;
+  }
+
+  // clone returns a deep copy of the message.
+  clone(): StringValue {
+    const m = new StringValue();
+    m.value = this.value;
+    m.unknownFields = this.unknownFields.map(u => u.slice());
+    return m;
+  }
+
+  // hashCode returns a hash of the message's values, which is equal for
+  // equal messages and stable across runs.
+  hashCode(): number {
+    let h = 0;
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashString(this.value));
+    return h;
+  }
+}
+
+export interface BytesValueInit {
+  /**
+   * The bytes value.
+   */
+  value?: Uint8Array;
+}
+
+export interface IBytesValue {
+  /**
+   * The bytes value.
+   */
+  value?: string;
+}
+
+/**
+ * Wrapper message for `bytes`.
+ *
+ * The JSON representation for `BytesValue` is JSON string.
+ */
+export class BytesValue implements __pb__.Message {
+  static readonly typeName = "google.protobuf.BytesValue";
+
+  static readonly fields: __pb__.FieldInfo[] = [
+    { name: "value", number: 1, type: __pb__.FieldType.BYTES, label: __pb__.FieldLabel.OPTIONAL, jsonName: "value", member: "value" },
+  ];
+
+  /**
+   * The bytes value.
+   */
+  value: Uint8Array;
+  // The encoding of fields which were not recognized when decoding.
+  unknownFields: Uint8Array[];
+
+  constructor(init?: BytesValueInit) {
+    this.value = new Uint8Array(0);
+    this.unknownFields = [];
+    if (init !== undefined) {
+      if (init.value !== undefined) this.value = init.value;
+    }
+  }
+
+  MergeFrom(d: __pb__.Internal.Decoder): void {
+    while (!d.isEOF()) {
+      let [fn, wt] = d.readTag();
+      switch(fn) {
+        case 1:
+        this.value = d.readBytes();
+        break;
+        default:
+        this.unknownFields.push(d.readUnknown(wt, fn));
+      }
+    }
+  }
+
+  WriteTo(e: __pb__.Internal.Encoder): void {
+    if (this.value.length != 0) {
+      e.writeTag(1, 2);
+      e.writeBytes(this.value);
+    }
+    e.writeUnknown(this.unknownFields);
+  }
+
+  MergeFromJSON(j: __pb__.JsonValue, _: __pb__.JsonOptions = {}): void {
+    const v = new __pb__.BytesValue();
+    v.MergeFromJSON(j);
+    this.value = v.value;
+  }
+
+  ToJSON(_: __pb__.JsonOptions = {}): __pb__.JsonValue {
+    return new __pb__.BytesValue(this.value).ToJSON();
+  }
+
+  // toObject returns the message as a plain object, holding no classes.
+  toObject(): IBytesValue {
+    const o: IBytesValue = {};
+    o.value = __pb__.Internal.bytesToJSON(this.value);
+    return o;
+  }
+
+  // fromObject returns a message from its plain object form.
+  static fromObject(o: IBytesValue): BytesValue {
+    const m = new BytesValue();
+    if (o.value !== undefined) m.value = __pb__.Internal.bytesFromJSON(o.value);
+    return m;
+  }
+
+  // equals reports whether other holds the same values as the message.
+  equals(other: BytesValue): boolean {
+    if (this === other) return true;
+    if (!__pb__.Internal.bytesEqual(this.value, other.value)) return false;
+    if (!__pb__.Internal.unknownEqual(this.unknownFields, other.unknownFields)) return false;
+    return true;
+  }
+
+  // clone returns a deep copy of the message.
+  clone(): BytesValue {
+    const m = new BytesValue();
+    m.value = this.value.slice();
+    m.unknownFields = this.unknownFields.map(u => u.slice());
+    return m;
+  }
+
+  // hashCode returns a hash of the message's values, which is equal for
+  // equal messages and stable across runs.
+  hashCode(): number {
+    let h = 0;
+    h = __pb__.Internal.hashCombine(h, __pb__.Internal.hashBytes(this.value));
+    return h;
+  }
+}
+
+// fileDescriptor is the google.protobuf.FileDescriptorProto of google/protobuf/wrappers.proto.
+export const fileDescriptor = new __pb__.FileDescriptor(
+  "google/protobuf/wrappers.proto",
+  "Ch5nb29nbGUvcHJvdG9idWYvd3JhcHBlcnMucHJvdG8SD2dvb2dsZS5wcm90b2J1ZiIjCgtEb3Vi" +
+    "bGVWYWx1ZRIUCgV2YWx1ZRgBIAEoAVIFdmFsdWUiIgoKRmxvYXRWYWx1ZRIUCgV2YWx1ZRgBIAEo" +
+    "AlIFdmFsdWUiIgoKSW50NjRWYWx1ZRIUCgV2YWx1ZRgBIAEoA1IFdmFsdWUiIwoLVUludDY0VmFs" +
+    "dWUSFAoFdmFsdWUYASABKARSBXZhbHVlIiIKCkludDMyVmFsdWUSFAoFdmFsdWUYASABKAVSBXZh" +
+    "bHVlIiMKC1VJbnQzMlZhbHVlEhQKBXZhbHVlGAEgASgNUgV2YWx1ZSIhCglCb29sVmFsdWUSFAoF" +
+    "dmFsdWUYASABKAhSBXZhbHVlIiMKC1N0cmluZ1ZhbHVlEhQKBXZhbHVlGAEgASgJUgV2YWx1ZSIi" +
+    "CgpCeXRlc1ZhbHVlEhQKBXZhbHVlGAEgASgMUgV2YWx1ZUJ8ChNjb20uZ29vZ2xlLnByb3RvYnVm" +
+    "Qg1XcmFwcGVyc1Byb3RvUAFaKmdpdGh1Yi5jb20vZ29sYW5nL3Byb3RvYnVmL3B0eXBlcy93cmFw" +
+    "cGVyc/gBAaICA0dQQqoCHkdvb2dsZS5Qcm90b2J1Zi5XZWxsS25vd25UeXBlc0quHQoGEgQjAHUB" +
+    "CsMOCgEMEgMjABIywQwgUHJvdG9jb2wgQnVmZmVycyAtIEdvb2dsZSdzIGRhdGEgaW50ZXJjaGFu" +
+    "Z2UgZm9ybWF0CiBDb3B5cmlnaHQgMjAwOCBHb29nbGUgSW5jLiAgQWxsIHJpZ2h0cyByZXNlcnZl" +
+    "ZC4KIGh0dHBzOi8vZGV2ZWxvcGVycy5nb29nbGUuY29tL3Byb3RvY29sLWJ1ZmZlcnMvCgogUmVk" +
+    "aXN0cmlidXRpb24gYW5kIHVzZSBpbiBzb3VyY2UgYW5kIGJpbmFyeSBmb3Jtcywgd2l0aCBvciB3" +
+    "aXRob3V0CiBtb2RpZmljYXRpb24sIGFyZSBwZXJtaXR0ZWQgcHJvdmlkZWQgdGhhdCB0aGUgZm9s" +
+    "bG93aW5nIGNvbmRpdGlvbnMgYXJlCiBtZXQ6CgogICAgICogUmVkaXN0cmlidXRpb25zIG9mIHNv" +
+    "dXJjZSBjb2RlIG11c3QgcmV0YWluIHRoZSBhYm92ZSBjb3B5cmlnaHQKIG5vdGljZSwgdGhpcyBs" +
+    "aXN0IG9mIGNvbmRpdGlvbnMgYW5kIHRoZSBmb2xsb3dpbmcgZGlzY2xhaW1lci4KICAgICAqIFJl" +
+    "ZGlzdHJpYnV0aW9ucyBpbiBiaW5hcnkgZm9ybSBtdXN0IHJlcHJvZHVjZSB0aGUgYWJvdmUKIGNv" +
+    "cHlyaWdodCBub3RpY2UsIHRoaXMgbGlzdCBvZiBjb25kaXRpb25zIGFuZCB0aGUgZm9sbG93aW5n" +
+    "IGRpc2NsYWltZXIKIGluIHRoZSBkb2N1bWVudGF0aW9uIGFuZC9vciBvdGhlciBtYXRlcmlhbHMg" +
+    "cHJvdmlkZWQgd2l0aCB0aGUKIGRpc3RyaWJ1dGlvbi4KICAgICAqIE5laXRoZXIgdGhlIG5hbWUg" +
+    "b2YgR29vZ2xlIEluYy4gbm9yIHRoZSBuYW1lcyBvZiBpdHMKIGNvbnRyaWJ1dG9ycyBtYXkgYmUg" +
+    "dXNlZCB0byBlbmRvcnNlIG9yIHByb21vdGUgcHJvZHVjdHMgZGVyaXZlZCBmcm9tCiB0aGlzIHNv" +
+    "ZnR3YXJlIHdpdGhvdXQgc3BlY2lmaWMgcHJpb3Igd3JpdHRlbiBwZXJtaXNzaW9uLgoKIFRISVMg" +
+    "U09GVFdBUkUgSVMgUFJPVklERUQgQlkgVEhFIENPUFlSSUdIVCBIT0xERVJTIEFORCBDT05UUklC" +
+    "VVRPUlMKICJBUyBJUyIgQU5EIEFOWSBFWFBSRVNTIE9SIElNUExJRUQgV0FSUkFOVElFUywgSU5D" +
+    "TFVESU5HLCBCVVQgTk9UCiBMSU1JVEVEIFRPLCBUSEUgSU1QTElFRCBXQVJSQU5USUVTIE9GIE1F" +
+    "UkNIQU5UQUJJTElUWSBBTkQgRklUTkVTUyBGT1IKIEEgUEFSVElDVUxBUiBQVVJQT1NFIEFSRSBE" +
+    "SVNDTEFJTUVELiBJTiBOTyBFVkVOVCBTSEFMTCBUSEUgQ09QWVJJR0hUCiBPV05FUiBPUiBDT05U" +
+    "UklCVVRPUlMgQkUgTElBQkxFIEZPUiBBTlkgRElSRUNULCBJTkRJUkVDVCwgSU5DSURFTlRBTCwK" +
+    "IFNQRUNJQUwsIEVYRU1QTEFSWSwgT1IgQ09OU0VRVUVOVElBTCBEQU1BR0VTIChJTkNMVURJTkcs" +
+    "IEJVVCBOT1QKIExJTUlURUQgVE8sIFBST0NVUkVNRU5UIE9GIFNVQlNUSVRVVEUgR09PRFMgT1Ig" +
+    "U0VSVklDRVM7IExPU1MgT0YgVVNFLAogREFUQSwgT1IgUFJPRklUUzsgT1IgQlVTSU5FU1MgSU5U" +
+    "RVJSVVBUSU9OKSBIT1dFVkVSIENBVVNFRCBBTkQgT04gQU5ZCiBUSEVPUlkgT0YgTElBQklMSVRZ" +
+    "LCBXSEVUSEVSIElOIENPTlRSQUNULCBTVFJJQ1QgTElBQklMSVRZLCBPUiBUT1JUCiAoSU5DTFVE" +
+    "SU5HIE5FR0xJR0VOQ0UgT1IgT1RIRVJXSVNFKSBBUklTSU5HIElOIEFOWSBXQVkgT1VUIE9GIFRI" +
+    "RSBVU0UKIE9GIFRISVMgU09GVFdBUkUsIEVWRU4gSUYgQURWSVNFRCBPRiBUSEUgUE9TU0lCSUxJ" +
+    "VFkgT0YgU1VDSCBEQU1BR0UuCjL0ASBXcmFwcGVycyBmb3IgcHJpbWl0aXZlIChub24tbWVzc2Fn" +
+    "ZSkgdHlwZXMuIFRoZXNlIHR5cGVzIGFyZSB1c2VmdWwKIGZvciBlbWJlZGRpbmcgcHJpbWl0aXZl" +
+    "cyBpbiB0aGUgYGdvb2dsZS5wcm90b2J1Zi5BbnlgIHR5cGUgYW5kIGZvciBwbGFjZXMKIHdoZXJl" +
+    "IHdlIG5lZWQgdG8gZGlzdGluZ3Vpc2ggYmV0d2VlbiB0aGUgYWJzZW5jZSBvZiBhIHByaW1pdGl2" +
+    "ZQogdHlwZWQgZmllbGQgYW5kIGl0cyBkZWZhdWx0IHZhbHVlLgoKCAoBAhIDJQAYCggKAQgSAycA" +
+    "OwoJCgIIJRIDJwA7CggKAQgSAygAHwoJCgIIHxIDKAAfCggKAQgSAykAQQoJCgIICxIDKQBBCggK" +
+    "AQgSAyoALAoJCgIIARIDKgAsCggKAQgSAysALgoJCgIICBIDKwAuCggKAQgSAywAIgoJCgIIChID" +
+    "LAAiCggKAQgSAy0AIQoJCgIIJBIDLQAhCmcKAgQAEgQyADUBGlsgV3JhcHBlciBtZXNzYWdlIGZv" +
+    "ciBgZG91YmxlYC4KCiBUaGUgSlNPTiByZXByZXNlbnRhdGlvbiBmb3IgYERvdWJsZVZhbHVlYCBp" +
+    "cyBKU09OIG51bWJlci4KCgoKAwQAARIDMggTCiAKBAQAAgASAzQCExoTIFRoZSBkb3VibGUgdmFs" +
+    "dWUuCgoMCgUEAAIABRIDNAIICgwKBQQAAgABEgM0CQ4KDAoFBAACAAMSAzQREgplCgIEARIEOgA9" +
+    "ARpZIFdyYXBwZXIgbWVzc2FnZSBmb3IgYGZsb2F0YC4KCiBUaGUgSlNPTiByZXByZXNlbnRhdGlv" +
+    "biBmb3IgYEZsb2F0VmFsdWVgIGlzIEpTT04gbnVtYmVyLgoKCgoDBAEBEgM6CBIKHwoEBAECABID" +
+    "PAISGhIgVGhlIGZsb2F0IHZhbHVlLgoKDAoFBAECAAUSAzwCBwoMCgUEAQIAARIDPAgNCgwKBQQB" +
+    "AgADEgM8EBEKZQoCBAISBEIARQEaWSBXcmFwcGVyIG1lc3NhZ2UgZm9yIGBpbnQ2NGAuCgogVGhl" +
+    "IEpTT04gcmVwcmVzZW50YXRpb24gZm9yIGBJbnQ2NFZhbHVlYCBpcyBKU09OIHN0cmluZy4KCgoK" +
+    "AwQCARIDQggSCh8KBAQCAgASA0QCEhoSIFRoZSBpbnQ2NCB2YWx1ZS4KCgwKBQQCAgAFEgNEAgcK" +
+    "DAoFBAICAAESA0QIDQoMCgUEAgIAAxIDRBARCmcKAgQDEgRKAE0BGlsgV3JhcHBlciBtZXNzYWdl" +
+    "IGZvciBgdWludDY0YC4KCiBUaGUgSlNPTiByZXByZXNlbnRhdGlvbiBmb3IgYFVJbnQ2NFZhbHVl" +
+    "YCBpcyBKU09OIHN0cmluZy4KCgoKAwQDARIDSggTCiAKBAQDAgASA0wCExoTIFRoZSB1aW50NjQg" +
+    "dmFsdWUuCgoMCgUEAwIABRIDTAIICgwKBQQDAgABEgNMCQ4KDAoFBAMCAAMSA0wREgplCgIEBBIE" +
+    "UgBVARpZIFdyYXBwZXIgbWVzc2FnZSBmb3IgYGludDMyYC4KCiBUaGUgSlNPTiByZXByZXNlbnRh" +
+    "dGlvbiBmb3IgYEludDMyVmFsdWVgIGlzIEpTT04gbnVtYmVyLgoKCgoDBAQBEgNSCBIKHwoEBAQC" +
+    "ABIDVAISGhIgVGhlIGludDMyIHZhbHVlLgoKDAoFBAQCAAUSA1QCBwoMCgUEBAIAARIDVAgNCgwK" +
+    "BQQEAgADEgNUEBEKZwoCBAUSBFoAXQEaWyBXcmFwcGVyIG1lc3NhZ2UgZm9yIGB1aW50MzJgLgoK" +
+    "IFRoZSBKU09OIHJlcHJlc2VudGF0aW9uIGZvciBgVUludDMyVmFsdWVgIGlzIEpTT04gbnVtYmVy" +
+    "LgoKCgoDBAUBEgNaCBMKIAoEBAUCABIDXAITGhMgVGhlIHVpbnQzMiB2YWx1ZS4KCgwKBQQFAgAF" +
+    "EgNcAggKDAoFBAUCAAESA1wJDgoMCgUEBQIAAxIDXBESCm8KAgQGEgRiAGUBGmMgV3JhcHBlciBt" +
+    "ZXNzYWdlIGZvciBgYm9vbGAuCgogVGhlIEpTT04gcmVwcmVzZW50YXRpb24gZm9yIGBCb29sVmFs" +
+    "dWVgIGlzIEpTT04gYHRydWVgIGFuZCBgZmFsc2VgLgoKCgoDBAYBEgNiCBEKHgoEBAYCABIDZAIR" +
+    "GhEgVGhlIGJvb2wgdmFsdWUuCgoMCgUEBgIABRIDZAIGCgwKBQQGAgABEgNkBwwKDAoFBAYCAAMS" +
+    "A2QPEApnCgIEBxIEagBtARpbIFdyYXBwZXIgbWVzc2FnZSBmb3IgYHN0cmluZ2AuCgogVGhlIEpT" +
+    "T04gcmVwcmVzZW50YXRpb24gZm9yIGBTdHJpbmdWYWx1ZWAgaXMgSlNPTiBzdHJpbmcuCgoKCgME" +
+    "BwESA2oIEwogCgQEBwIAEgNsAhMaEyBUaGUgc3RyaW5nIHZhbHVlLgoKDAoFBAcCAAUSA2wCCAoM" +
+    "CgUEBwIAARIDbAkOCgwKBQQHAgADEgNsERIKZQoCBAgSBHIAdQEaWSBXcmFwcGVyIG1lc3NhZ2Ug" +
+    "Zm9yIGBieXRlc2AuCgogVGhlIEpTT04gcmVwcmVzZW50YXRpb24gZm9yIGBCeXRlc1ZhbHVlYCBp" +
+    "cyBKU09OIHN0cmluZy4KCgoKAwQIARIDcggSCh8KBAQIAgASA3QCEhoSIFRoZSBieXRlcyB2YWx1" +
+    "ZS4KCgwKBQQIAgAFEgN0AgcKDAoFBAgCAAESA3QIDQoMCgUECAIAAxIDdBARYgZwcm90bzM=",
+  []
+);
+
+__pb__.globalRegistry.add(DoubleValue);
+__pb__.globalRegistry.add(FloatValue);
+__pb__.globalRegistry.add(Int64Value);
+__pb__.globalRegistry.add(UInt64Value);
+__pb__.globalRegistry.add(Int32Value);
+__pb__.globalRegistry.add(UInt32Value);
+__pb__.globalRegistry.add(BoolValue);
+__pb__.globalRegistry.add(StringValue);
+__pb__.globalRegistry.add(BytesValue);
+__pb__.globalRegistry.addFile(fileDescriptor);
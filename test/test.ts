@@ -2,6 +2,20 @@ import * as fs from "fs";
 import * as pb from "../lib/protobuf";
 import * as e1pb from "./gen-src/example1_pb";
 import * as e2pb from "./gen-src/example2_pb";
+import * as e4pb from "./gen-src/example4_pb";
+import * as e5pb from "./gen-src/example5_pb";
+import * as e6pb from "./gen-src/example6_pb";
+import * as e7pb from "./gen-src/example7_pb";
+import * as e8pb from "./gen-src/example8_pb";
+import * as e9pb from "./gen-src/example9_pb";
+import * as e10pb from "./gen-src/example10_pb";
+import * as e11pb from "./gen-src/example11_pb";
+import * as e12pb from "./gen-src/example12_pb";
+import * as e13pb from "./gen-src/example13_pb";
+import * as e14pb from "./gen-src/example14_pb";
+import * as anypb from "./gen-src/google/protobuf/any_pb";
+import * as fmpb from "./gen-src/google/protobuf/field_mask_pb";
+import * as structpb from "./gen-src/google/protobuf/struct_pb";
 
 import { diff } from "deep-diff";
 import { fromInt } from "long";
@@ -118,3 +132,1414 @@ got = new e1pb.example1();
 pb.Unmarshal(ua, got);
 
 diffMsg(got, example1(), "after remarshal");
+
+function assert(cond: boolean, msg: string): void {
+  if (!cond) {
+    throw new Error(`assertion failed: ${msg}`);
+  }
+}
+
+// proto2: presence, defaults, closed enums, packing and groups.
+let e4 = new e4pb.example4();
+assert(!e4.has_aint32() && e4.aint32 == -7, "proto2 default int32");
+assert(e4.aint64.toString() == "1234567890123", "proto2 default int64");
+assert(e4.adouble == Infinity, "proto2 default double");
+assert(e4.astring == 'hi "there"', "proto2 default string");
+assert(e4.abytes.join(",") == "97,1,98", "proto2 default bytes");
+assert(e4.acolor == e4pb.Color.GREEN, "proto2 default enum");
+assert(e4.acolor2 == e4pb.Color.RED, "proto2 implicit enum default");
+assert(pb.Marshal(e4).length == 0, "proto2 unset fields are not encoded");
+
+e4.nodefault = 0;
+assert(e4.has_nodefault(), "proto2 has after set");
+assert(pb.Marshal(e4).length == 2, "proto2 set zero value is encoded");
+e4.clear_nodefault();
+assert(!e4.has_nodefault(), "proto2 has after clear");
+
+e4.arequired = 5;
+e4.astring = "set";
+e4.unpacked = [1, 2];
+e4.packed = [1, 2];
+e4.colors = [e4pb.Color.BLUE];
+e4.agroup = new e4pb.example4.AGroup();
+e4.agroup.astring = "in a group";
+let e4got = new e4pb.example4();
+pb.Unmarshal(pb.Marshal(e4), e4got);
+assert(e4got.arequired == 5 && e4got.has_arequired(), "proto2 required");
+assert(e4got.astring == "set", "proto2 string round trip");
+assert(e4got.unpacked.join(",") == "1,2", "proto2 unpacked round trip");
+assert(e4got.packed.join(",") == "1,2", "proto2 packed round trip");
+assert(e4got.colors[0] == e4pb.Color.BLUE, "proto2 repeated enum");
+assert(
+  e4got.agroup != null && e4got.agroup.astring == "in a group",
+  "proto2 group round trip"
+);
+
+let e4packing = new e4pb.example4();
+e4packing.unpacked = [1, 2];
+e4packing.packed = [1, 2];
+assert(
+  pb.Marshal(e4packing).join(",") == "160,1,1,160,1,2,170,1,2,1,2",
+  "proto2 packing"
+);
+
+// Unknown values of closed enums are unknown fields.
+e4got = new e4pb.example4();
+pb.Unmarshal(new Uint8Array([9 << 3, 7]), e4got);
+assert(!e4got.has_acolor(), "proto2 closed enum");
+assert(pb.Marshal(e4got).join(",") == "72,7", "proto2 closed enum unknown");
+
+// proto3 optional: presence is tracked and only set fields are encoded.
+let e5 = new e5pb.example5();
+assert(!e5.has_aint32() && e5.aint32 == 0, "proto3 optional default");
+assert(pb.Marshal(e5).length == 0, "proto3 optional unset");
+e5.aint32 = 0;
+e5.akind = e5pb.Kind.KIND_UNSPECIFIED;
+assert(pb.Marshal(e5).length == 4, "proto3 optional zero values are encoded");
+let e5got = new e5pb.example5();
+pb.Unmarshal(pb.Marshal(e5), e5got);
+assert(e5got.has_aint32() && e5got.has_akind(), "proto3 optional has");
+assert(!e5got.has_astring(), "proto3 optional not has");
+e5got.clear_aint32();
+assert(!e5got.has_aint32(), "proto3 optional clear");
+
+// Editions: presence, packing, closed enums and delimited encoding are driven
+// by features.
+let e6 = new e6pb.example6();
+e6.explicit = 0;
+e6.implicit = 0;
+e6.expanded = [1, 2];
+e6.packed = [1, 2];
+e6.delimited = new e6pb.example6.Inner();
+e6.delimited.aint32 = 1;
+assert(
+  pb.Marshal(e6).join(",") == "8,0,34,2,1,2,40,1,40,2,83,8,1,84",
+  "editions encoding"
+);
+let e6got = new e6pb.example6();
+pb.Unmarshal(pb.Marshal(e6), e6got);
+assert(e6got.has_explicit(), "editions explicit presence");
+assert(
+  e6got.delimited != null && e6got.delimited.aint32 == 1,
+  "editions delimited round trip"
+);
+let e6map = new e6pb.example6({ inners: new Map([["a", { aint32: 1 }]]) });
+assert(
+  pb.Marshal(e6map).join(",") == "98,7,10,1,97,18,2,8,1",
+  "editions map values length prefixed"
+);
+e6got = new e6pb.example6();
+pb.Unmarshal(pb.Marshal(e6map), e6got);
+assert(e6got.inners.get("a")!.aint32 == 1, "editions map round trip");
+e6got = new e6pb.example6();
+pb.Unmarshal(new Uint8Array([6 << 3, 7, 7 << 3, 7]), e6got);
+assert(!e6got.has_aclosed(), "editions closed enum");
+assert(e6got.aopen == 7, "editions open enum");
+
+let invalidUtf8 = new Uint8Array([8 << 3 | 2, 1, 0xff]);
+let threw = false;
+try {
+  pb.Unmarshal(invalidUtf8, new e6pb.example6());
+} catch (e) {
+  threw = true;
+}
+assert(threw, "editions utf8 validation");
+invalidUtf8[0] = 9 << 3 | 2;
+pb.Unmarshal(invalidUtf8, new e6pb.example6());
+
+// proto3 JSON mapping.
+let e7 = new e7pb.example7();
+assert(pb.MarshalJSON(e7) == "{}", "json empty message");
+e7.snake_case = 1;
+e7.renamed = "r";
+e7.big_number = fromInt(5, true).shiftLeft(40);
+e7.some_bytes = new Uint8Array([0xfb, 0xff, 0x01]);
+e7.a_double = NaN;
+e7.a_color = e7pb.Color.COLOR_RED;
+e7.many_colors = [e7pb.Color.COLOR_BLUE, 7];
+e7.maybe = 0;
+e7.an_inner = new e7pb.example7.Inner();
+e7.many_inners.push(new e7pb.example7.Inner());
+e7.many_inners[0].value = "v";
+e7.int_map.set(-3, new e7pb.example7.Inner());
+e7.bool_map.set(true, "yes");
+e7.choice = new e7pb.example7.choice.choice_string("c");
+let e7json = pb.MarshalJSON(e7);
+assert(
+  e7json ==
+    '{"snakeCase":1,"otherName":"r","bigNumber":"5497558138880",' +
+      '"someBytes":"+/8B","aDouble":"NaN","aColor":"COLOR_RED",' +
+      '"manyColors":["COLOR_BLUE",7],"maybe":0,"anInner":{},' +
+      '"manyInners":[{"value":"v"}],"intMap":{"-3":{}},' +
+      '"boolMap":{"true":"yes"},"choiceString":"c"}',
+  "json marshal"
+);
+let e7got = new e7pb.example7();
+pb.UnmarshalJSON(e7json, e7got);
+assert(pb.MarshalJSON(e7got) == e7json, "json round trip");
+assert(
+  pb.Marshal(e7got).join(",") == pb.Marshal(e7).join(","),
+  "json round trip binary"
+);
+
+let e7names = pb.MarshalJSON(e7, { useProtoNames: true });
+assert(e7names.indexOf('"snake_case":1') >= 0, "json proto names");
+assert(e7names.indexOf('"renamed":"r"') >= 0, "json proto names json_name");
+e7got = new e7pb.example7();
+pb.UnmarshalJSON(e7names, e7got);
+assert(pb.MarshalJSON(e7got) == e7json, "json accepts proto names");
+
+let e7defaults = new e7pb.example7().ToJSON({ emitDefaults: true }) as any;
+assert(e7defaults.snakeCase === 0, "json emit default scalar");
+assert(e7defaults.aColor === "COLOR_UNSPECIFIED", "json emit default enum");
+assert(e7defaults.anInner === null, "json emit default message");
+assert(e7defaults.manyColors.length == 0, "json emit default repeated");
+assert(e7defaults.choiceString === undefined, "json emit default oneof");
+
+e7got = new e7pb.example7();
+pb.UnmarshalJSON(
+  '{"snakeCase":"2","bigNumber":1e3,"aDouble":"-Infinity","aColor":2,' +
+    '"someBytes":"-_8B","choiceInner":{"value":"i"},"anInner":null}',
+  e7got
+);
+assert(e7got.snake_case == 2, "json int from string");
+assert(e7got.big_number.toString() == "1000", "json int64 from number");
+assert(e7got.a_double == -Infinity, "json double from string");
+assert(e7got.a_color == e7pb.Color.COLOR_BLUE, "json enum from number");
+assert(e7got.some_bytes.join(",") == "251,255,1", "json url safe base64");
+assert(e7got.an_inner == null, "json null is unset");
+assert(
+  e7got.choice instanceof e7pb.example7.choice.choice_inner &&
+    e7got.choice.value != null &&
+    e7got.choice.value.value == "i",
+  "json oneof message"
+);
+
+function jsonThrows(json: string, o?: pb.JsonOptions): boolean {
+  try {
+    pb.UnmarshalJSON(json, new e7pb.example7(), o);
+  } catch (e) {
+    return e instanceof pb.ProtobufError;
+  }
+  return false;
+}
+assert(jsonThrows('{"unknown":1}'), "json unknown field");
+assert(!jsonThrows('{"unknown":1}', { ignoreUnknown: true }), "json ignore");
+assert(jsonThrows('{"aColor":"NOPE"}'), "json unknown enum");
+assert(
+  !jsonThrows('{"aColor":"NOPE"}', { ignoreUnknown: true }),
+  "json ignore enum"
+);
+assert(jsonThrows('{"snakeCase":1.5}'), "json fractional int");
+assert(jsonThrows('{"snakeCase":2147483648}'), "json int32 range");
+assert(jsonThrows('{"bigNumber":"-1"}'), "json uint64 range");
+assert(jsonThrows('{"manyColors":1}'), "json repeated not array");
+assert(jsonThrows("[]"), "json not object");
+
+// Well known types as library types: Timestamp as Date and Duration as
+// pb.Duration, with the same wire encoding as the generated classes.
+let e8 = new e8pb.example8();
+e8.created = new Date(Date.UTC(2001, 1, 3, 4, 5, 6, 7));
+e8.history = [new Date(-1500)];
+e8.deadlines.set("d", new Date(0));
+e8.timeout = pb.Duration.fromMillis(1500);
+e8.when = new e8pb.example8.when.after(pb.Duration.fromMillis(-2));
+assert(
+  pb.Marshal(e8).join(",") ==
+    "10,11,8,242,134,238,211,3,16,192,159,171,3," +
+      "18,17,8,254,255,255,255,255,255,255,255,255,1,16,128,202,181,238,1," +
+      "26,5,10,1,100,18,0," +
+      "34,8,8,1,16,128,202,181,238,1," +
+      "50,6,16,128,247,133,255,15",
+  "wkt encoding"
+);
+let e8got = new e8pb.example8();
+pb.Unmarshal(pb.Marshal(e8), e8got);
+assert(
+  e8got.created != null && e8got.created.getTime() == e8.created.getTime(),
+  "wkt timestamp as date"
+);
+assert(e8got.history[0].getTime() == -1500, "wkt repeated timestamp");
+assert(e8got.deadlines.get("d")!.getTime() == 0, "wkt timestamp map value");
+assert(
+  e8got.timeout != null && e8got.timeout.toMillis() == 1500,
+  "wkt duration helper"
+);
+assert(
+  pb.MarshalJSON(e8got) ==
+    '{"created":"2001-02-03T04:05:06.007Z",' +
+      '"history":["1969-12-31T23:59:58.500Z"],' +
+      '"deadlines":{"d":"1970-01-01T00:00:00Z"},' +
+      '"timeout":"1.500s","after":"-0.002s"}',
+  "wkt json"
+);
+e8got = new e8pb.example8();
+pb.UnmarshalJSON(
+  '{"created":"2001-02-03T05:05:06.007000001+01:00","timeout":"-3s"}',
+  e8got
+);
+assert(
+  e8got.created != null && e8got.created.getTime() == e8.created.getTime(),
+  "wkt timestamp from json"
+);
+assert(
+  e8got.timeout != null && e8got.timeout.toMillis() == -3000,
+  "wkt duration from json"
+);
+
+// Wrapper well known types as nullable primitives.
+let e9 = new e9pb.example9();
+assert(pb.Marshal(e9).length == 0, "wrappers unset");
+e9.astring = "";
+e9.aint64 = fromInt(-1);
+e9.abool = true;
+e9.many = [0, 1];
+e9.amap.set("k", 0.5);
+e9.aoneof = new e9pb.example9.aoneof.oneint(3);
+assert(
+  pb.Marshal(e9).join(",") ==
+    "10,0,18,11,8,255,255,255,255,255,255,255,255,255,1,26,2,8,1," +
+      "50,0,50,2,8,1,58,10,10,1,107,18,5,13,0,0,0,63,66,2,8,3",
+  "wrappers encoding"
+);
+let e9got = new e9pb.example9();
+pb.Unmarshal(pb.Marshal(e9), e9got);
+assert(e9got.astring === "", "wrappers empty string is set");
+assert(
+  e9got.aint64 != null && e9got.aint64.toString() == "-1",
+  "wrappers int64"
+);
+assert(e9got.abool === true && e9got.abytes === null, "wrappers presence");
+assert(e9got.many.join(",") == "0,1", "wrappers repeated");
+assert(e9got.amap.get("k") == 0.5, "wrappers map");
+assert(
+  e9got.aoneof instanceof e9pb.example9.aoneof.oneint &&
+    e9got.aoneof.value == 3,
+  "wrappers oneof"
+);
+assert(
+  pb.MarshalJSON(e9got) ==
+    '{"astring":"","aint64":"-1","abool":true,"many":[0,1],' +
+      '"amap":{"k":0.5},"oneint":3}',
+  "wrappers json"
+);
+e9got = new e9pb.example9();
+pb.UnmarshalJSON('{"abytes":"AQI=","adouble":"NaN","astring":null}', e9got);
+assert(
+  e9got.abytes != null && e9got.abytes.join(",") == "1,2",
+  "wrappers bytes"
+);
+assert(e9got.adouble != null && isNaN(e9got.adouble), "wrappers double");
+assert(e9got.astring === null, "wrappers json null");
+
+// google.protobuf.Any holds a packed message and its type URL.
+function throws(f: () => void): boolean {
+  try {
+    f();
+  } catch (e) {
+    return e instanceof pb.ProtobufError;
+  }
+  return false;
+}
+assert(e7pb.example7.Inner.typeName == "foo.json.example7.Inner", "type name");
+let inner = new e7pb.example7.Inner();
+inner.value = "packed";
+let anyMsg = anypb.Any.pack(inner);
+assert(
+  anyMsg.type_url == "type.googleapis.com/foo.json.example7.Inner",
+  "any type url"
+);
+assert(anyMsg.is(e7pb.example7.Inner), "any is");
+assert(!anyMsg.is(e7pb.example7), "any is not");
+assert(anyMsg.unpack(e7pb.example7.Inner).value == "packed", "any unpack");
+assert(
+  throws(() => anyMsg.unpackTo(new e7pb.example7())),
+  "any unpack type check"
+);
+let e1 = example1();
+e1.anany = anyMsg;
+got = new e1pb.example1();
+pb.Unmarshal(pb.Marshal(e1), got);
+assert(
+  got.anany != null && got.anany.unpack(e7pb.example7.Inner).value == "packed",
+  "any field round trip"
+);
+
+let registry = new pb.TypeRegistry(e7pb.example7.Inner, pb.Duration);
+let anyJSON = pb.MarshalJSON(anyMsg, { typeRegistry: registry });
+assert(
+  anyJSON ==
+    '{"@type":"type.googleapis.com/foo.json.example7.Inner","value":"packed"}',
+  "any json"
+);
+let anyGot = new anypb.Any();
+pb.UnmarshalJSON(anyJSON, anyGot, { typeRegistry: registry });
+assert(anyGot.unpack(e7pb.example7.Inner).value == "packed", "any from json");
+anyMsg = anypb.Any.pack(pb.Duration.fromMillis(1500), "example.com/");
+anyJSON = pb.MarshalJSON(anyMsg, { typeRegistry: registry });
+assert(
+  anyJSON == '{"@type":"example.com/google.protobuf.Duration","value":"1.500s"}',
+  "any json well known type"
+);
+anyGot = new anypb.Any();
+pb.UnmarshalJSON(anyJSON, anyGot, { typeRegistry: registry });
+assert(
+  anyGot.unpack(pb.Duration).toMillis() == 1500,
+  "any from json well known type"
+);
+assert(pb.MarshalJSON(new anypb.Any()) == "{}", "any json empty");
+assert(
+  throws(() =>
+    pb.UnmarshalJSON(anyJSON, new anypb.Any(), {
+      typeRegistry: new pb.TypeRegistry(),
+    })
+  ),
+  "any json unknown type"
+);
+
+// FieldMask as a comma separated string of lowerCamelCase paths.
+let mask = new fmpb.FieldMask({ paths: ["foo_bar", "baz.qux_quux"] });
+assert(pb.MarshalJSON(mask) == '"fooBar,baz.quxQuux"', "field mask json");
+let maskGot = new fmpb.FieldMask();
+pb.UnmarshalJSON('"fooBar,baz.quxQuux"', maskGot);
+assert(maskGot.equals(mask), "field mask from json");
+pb.UnmarshalJSON('""', maskGot);
+assert(maskGot.paths.length == 0, "field mask empty");
+assert(
+  throws(() => pb.MarshalJSON(new fmpb.FieldMask({ paths: ["foo_3"] }))),
+  "field mask path without json form"
+);
+assert(
+  throws(() => pb.UnmarshalJSON('"foo_bar"', new fmpb.FieldMask())),
+  "field mask invalid json path"
+);
+anyJSON = pb.MarshalJSON(anypb.Any.pack(mask), {
+  typeRegistry: new pb.TypeRegistry(fmpb.FieldMask),
+});
+assert(
+  anyJSON ==
+    '{"@type":"type.googleapis.com/google.protobuf.FieldMask",' +
+      '"value":"fooBar,baz.quxQuux"}',
+  "any json field mask"
+);
+
+// Struct, Value and ListValue as JSON values.
+let e10 = new e10pb.example10();
+assert(e10.avalue === undefined, "struct value unset");
+assert(pb.Marshal(e10).length == 0, "struct unset");
+e10.avalue = null;
+assert(pb.Marshal(e10).join(",") == "18,2,8,0", "struct null value");
+let e10got = new e10pb.example10();
+pb.Unmarshal(pb.Marshal(e10), e10got);
+assert(e10got.avalue === null, "struct null value round trip");
+
+e10.astruct = { a: 1.5, b: [true, "x"], c: { d: null } };
+e10.alist = [1, {}];
+e10.many = ["s", null];
+e10.amap.set("k", [false]);
+e10.aoneof = new e10pb.example10.aoneof.onevalue(null);
+e10got = new e10pb.example10();
+pb.Unmarshal(pb.Marshal(e10), e10got);
+let e10json =
+  '{"astruct":{"a":1.5,"b":[true,"x"],"c":{"d":null}},"avalue":null,' +
+  '"alist":[1,{}],"many":["s",null],"amap":{"k":[false]},"onevalue":null}';
+assert(pb.MarshalJSON(e10got) == e10json, "struct round trip");
+e10got = new e10pb.example10();
+pb.UnmarshalJSON(e10json, e10got);
+assert(
+  pb.Marshal(e10got).join(",") == pb.Marshal(e10).join(","),
+  "struct from json"
+);
+e10got = new e10pb.example10();
+pb.UnmarshalJSON('{"astruct":null}', e10got);
+assert(
+  e10got.astruct === null && e10got.avalue === undefined,
+  "struct json null"
+);
+
+// The generated classes have the same encoding and JSON.
+let s = new structpb.Struct();
+pb.Unmarshal(pb.Marshal(new pb.Struct(e10.astruct)), s);
+let sv = s.fields.get("c");
+assert(
+  sv != null && sv.kind instanceof structpb.Value.kind.struct_value,
+  "struct generated class"
+);
+assert(
+  pb.MarshalJSON(s) == '{"a":1.5,"b":[true,"x"],"c":{"d":null}}',
+  "struct generated class json"
+);
+s = new structpb.Struct();
+pb.UnmarshalJSON('{"n":null}', s);
+assert(
+  pb.Marshal(s).join(",") == pb.Marshal(new pb.Struct({ n: null })).join(","),
+  "struct generated class from json"
+);
+
+// Embedded file descriptors.
+function descriptorFields(f: pb.FileDescriptor): number[] {
+  let d = new pb.Internal.Decoder(f.serialized());
+  let fields: number[] = [];
+  while (!d.isEOF()) {
+    let [fn, wt] = d.readTag();
+    fields.push(fn);
+    d.skipWireType(wt, fn);
+  }
+  return fields;
+}
+assert(e1pb.fileDescriptor.name == "example1.proto", "descriptor name");
+assert(
+  e1pb.fileDescriptor.dependencies().indexOf(anypb.fileDescriptor) >= 0,
+  "descriptor dependencies"
+);
+assert(
+  pb.globalRegistry.findFile("example1.proto") === e1pb.fileDescriptor,
+  "descriptor registered"
+);
+// Struct is generated as JSON in example10, so struct_pb isn't imported and
+// its descriptor is found by name.
+assert(
+  e10pb.fileDescriptor.dependencies()[0] === structpb.fileDescriptor,
+  "descriptor dependency by name"
+);
+// example14 imports google/api/annotations.proto only for its options.
+assert(
+  e14pb.fileDescriptor.dependencyNames.join(",") ==
+    "google/api/annotations.proto",
+  "descriptor option dependency"
+);
+threw = false;
+try {
+  e14pb.fileDescriptor.dependencies();
+} catch (e) {
+  threw = e instanceof pb.ProtobufError;
+}
+assert(threw, "descriptor missing dependency");
+let e1files = e1pb.fileDescriptor.transitiveDependencies().map(f => f.name);
+assert(
+  e1files.join(",") ==
+    "google/protobuf/any.proto,example3.proto,example2.proto,example1.proto",
+  "descriptor transitive dependencies"
+);
+// FileDescriptorProto.source_code_info is field 9.
+assert(descriptorFields(e1pb.fileDescriptor).indexOf(9) >= 0, "source info");
+assert(
+  descriptorFields(e10pb.fileDescriptor).indexOf(9) < 0,
+  "strip_source_info"
+);
+
+// Reflection over the static field tables.
+let rf = pb.findField(new e1pb.example1(), "aexample2");
+assert(
+  rf !== undefined &&
+    rf.number == 40 &&
+    rf.type == pb.FieldType.MESSAGE &&
+    rf.messageType !== undefined &&
+    rf.messageType() === e1pb.example1.example2,
+  "reflect message field"
+);
+rf = pb.findField(new e1pb.example1(), 21);
+assert(
+  rf !== undefined &&
+    rf.enumType !== undefined &&
+    rf.enumType() === e1pb.example1.AEnum2Info &&
+    rf.enumType().typeName == "foo.bar.example1.AEnum2",
+  "reflect enum field"
+);
+rf = pb.findField(new e7pb.example7(), "intMap");
+assert(
+  rf !== undefined &&
+    rf.name == "int_map" &&
+    rf.label == pb.FieldLabel.REPEATED &&
+    rf.map !== undefined &&
+    rf.map.key == pb.FieldType.INT32 &&
+    rf.map.value == pb.FieldType.MESSAGE &&
+    rf.messageType !== undefined &&
+    rf.messageType() === e7pb.example7.Inner,
+  "reflect map field"
+);
+assert(
+  pb.fieldsOf(new e7pb.example7()).map(f => f.number).join(",") ==
+    "1,2,3,4,5,6,7,8,10,11,12,13,20,21",
+  "reflect fields"
+);
+let r7 = new e7pb.example7();
+pb.setField(r7, "snake_case", 3);
+pb.setField(r7, 20, "set");
+assert(r7.snake_case == 3, "reflect set field");
+assert(pb.getField(r7, "snakeCase") == 3, "reflect get field");
+assert(
+  r7.choice instanceof e7pb.example7.choice.choice_string &&
+    pb.getField(r7, "choice_string") == "set" &&
+    pb.getField(r7, "choice_inner") === undefined,
+  "reflect oneof"
+);
+pb.setField(r7, "maybe", 0);
+assert(r7.has_maybe(), "reflect presence");
+assert(pb.findField(r7, "nope") === undefined, "reflect unknown field");
+
+// Generated types register themselves in the global registry.
+assert(
+  pb.globalRegistry.findMessage("foo.bar.example1") === e1pb.example1 &&
+    pb.globalRegistry.findMessage(".foo.bar.example1.example2") ===
+      e1pb.example1.example2,
+  "registry messages"
+);
+assert(
+  pb.globalRegistry.findEnum(".foo.json.Color") === e7pb.ColorInfo,
+  "registry enums"
+);
+assert(
+  pb.globalRegistry.findMessage("foo.bar.example1.AmapEntry") === undefined,
+  "registry skips map entries"
+);
+anyGot = new anypb.Any();
+pb.UnmarshalJSON(
+  '{"@type":"type.googleapis.com/foo.json.example7","snakeCase":4}',
+  anyGot
+);
+assert(
+  pb.MarshalJSON(anyGot.unpack(e7pb.example7)) == '{"snakeCase":4}',
+  "registry resolves any"
+);
+
+// Unknown fields are preserved through decoding and encoding.
+let unknownBytes = [
+  ...[0xf8, 0x06, 0x96, 0x01], // 111: varint 150
+  ...[0x81, 0x07, 1, 2, 3, 4, 5, 6, 7, 8], // 112: fixed64
+  ...[0x8a, 0x07, 0x02, 0x68, 0x69], // 113: "hi"
+  ...[0x93, 0x07, 0x08, 0x01, 0x94, 0x07], // 114: group
+  ...[0x9d, 0x07, 1, 2, 3, 4], // 115: fixed32
+];
+let e7unknown = new e7pb.example7();
+pb.Unmarshal(new Uint8Array([0x08, 0x05, ...unknownBytes]), e7unknown);
+assert(e7unknown.snake_case == 5, "unknown fields known field");
+assert(e7unknown.unknownFields.length == 5, "unknown fields captured");
+assert(
+  pb.Marshal(e7unknown).join(",") == [0x08, 0x05, ...unknownBytes].join(","),
+  "unknown fields written"
+);
+e7unknown.unknownFields = [];
+assert(pb.Marshal(e7unknown).join(",") == "8,5", "unknown fields cleared");
+e10got = new e10pb.example10();
+pb.Unmarshal(new Uint8Array(unknownBytes), e10got);
+assert(pb.Marshal(e10got).length == 0, "discard_unknown_fields");
+
+// Constructors accept a partial init object, with nested messages given
+// either as instances or as their own init objects.
+let e1init = new e1pb.example1({
+  adouble: 13.37,
+  afloat: repackFloat(100.1),
+  aint32: 1,
+  aint64: fromInt(12),
+  auint32: 123,
+  auint64: fromInt(1234, true),
+  asint32: 12345,
+  asint64: fromInt(123456),
+  afixed32: 1234567,
+  afixed64: fromInt(12345678, true),
+  asfixed32: 123456789,
+  asfixed64: fromInt(-1234567890),
+  abool: true,
+  astring: "foobar",
+  abytes: new TextEncoder().encode("hello world"),
+  aenum1: e1pb.AEnum1.B,
+  aenum2: e1pb.example1.AEnum2.D,
+  aenum22: e2pb.AEnum2.Z,
+  manystring: ["ms1", "ms2", "ms3"],
+  manyint64: [fromInt(1), fromInt(2), fromInt(3)],
+  aexample2: { astring: "zomg" },
+  aexample22: new e1pb.example2({ aint32: 123 }),
+  aexample23: { zomg: -12 },
+  amap: new Map([["k1", "v1"], ["k2", "v2"]]),
+  outoforder: fromInt(1),
+  oostring: "oneofstring",
+  longmap: new Map([["-33", "value"]]),
+});
+diffMsg(example1(), e1init, "init");
+assert(e1init.aexample2 instanceof e1pb.example1.example2, "init nested");
+let initOneof = new e1pb.example1({
+  aoneof: new e1pb.example1.aoneof.ooint(3),
+});
+assert(initOneof.aoneof instanceof e1pb.example1.aoneof.ooint, "init oneof");
+let e5init = new e5pb.example5({ aint32: 0, nested: { astring: "n" } });
+assert(e5init.has_aint32() && !e5init.has_astring(), "init presence");
+assert(e5init.nested!.astring == "n", "init nested presence");
+
+// Plain object forms convert recursively and survive a JSON round trip.
+let e1obj = example1().toObject();
+assert(e1obj.aint64 === "12", "toObject int64 string");
+assert(e1obj.abytes === "aGVsbG8gd29ybGQ=", "toObject bytes base64");
+assert(e1obj.aexample2!.astring == "zomg", "toObject nested");
+assert(e1obj.amap!["k2"] == "v2", "toObject map");
+assert(
+  e1obj.oostring == "oneofstring" && !("ooint" in e1obj),
+  "toObject oneof"
+);
+diffMsg(
+  example1(),
+  e1pb.example1.fromObject(JSON.parse(JSON.stringify(e1obj))),
+  "fromObject"
+);
+let e5obj = e5pb.example5.fromObject({ aint32: 0 }).toObject();
+assert(e5obj.aint32 === 0 && !("astring" in e5obj), "toObject presence");
+let e8obj = e8.toObject();
+assert(e8obj.created == "2001-02-03T04:05:06.007Z", "toObject date");
+assert(
+  pb.Marshal(e8pb.example8.fromObject(e8obj)).join(",") ==
+    pb.Marshal(e8).join(","),
+  "fromObject date"
+);
+let e11 = new e11pb.example11({
+  aint64: fromInt(-5),
+  manyuint64: [fromInt(7, true)],
+  abytes: new Uint8Array([1, 2]),
+  entries: new Map([[3, { name: "three" }]]),
+  longmap: new Map([["9", new Uint8Array([4])]]),
+});
+let e11obj = e11.toObject();
+assert(
+  JSON.stringify(e11obj) ==
+    '{"aint64":-5,"manyuint64":[7],"abytes":[1,2],' +
+      '"entries":[[3,{"name":"three"}]],"longmap":[["9",[4]]]}',
+  "toObject options"
+);
+assert(
+  pb.Marshal(e11pb.example11.fromObject(e11obj)).join(",") ==
+    pb.Marshal(e11).join(","),
+  "fromObject options"
+);
+
+// Generated equals, clone and hashCode.
+let e1a = example1();
+let e1b = e1a.clone();
+assert(e1a.equals(e1b) && e1a.hashCode() == e1b.hashCode(), "clone equals");
+diffMsg(e1a, e1b, "clone");
+e1b.aexample2!.astring = "changed";
+e1b.abytes[0] = 0;
+assert(e1a.aexample2!.astring == "zomg", "clone is deep");
+assert(e1a.abytes[0] == "h".charCodeAt(0), "clone copies bytes");
+assert(!e1a.equals(e1b), "clone differs");
+e1b = e1a.clone();
+e1b.amap = new Map([["k2", "v2"], ["k1", "v1"]]);
+assert(e1a.equals(e1b), "equals maps ignore order");
+assert(e1a.hashCode() == e1b.hashCode(), "hashCode maps ignore order");
+e1b.aoneof = new e1pb.example1.aoneof.ooint(1);
+assert(!e1a.equals(e1b), "equals oneof case");
+e1b = e1a.clone();
+e1b.aint64 = fromInt(13);
+assert(!e1a.equals(e1b), "equals long");
+e1a.adouble = NaN;
+e1b = e1a.clone();
+assert(e1a.equals(e1b), "equals NaN");
+assert(e1a.hashCode() == e1b.hashCode(), "hashCode NaN");
+let e5a = new e5pb.example5({ aint32: 0 });
+assert(!e5a.equals(new e5pb.example5()), "equals presence");
+assert(e5a.clone().has_aint32(), "clone presence");
+// Hashes depend only on the values, so they are the same in every run.
+assert(
+  new e5pb.example5({ astring: "x" }).hashCode() == 110822520,
+  "hashCode stable"
+);
+let e10a = new e10pb.example10({ astruct: { a: [1, { b: null }] } });
+let e10b = e10a.clone();
+assert(e10a.equals(e10b), "equals struct");
+(e10b.astruct!["a"] as pb.JsonValue[])[0] = 2;
+assert(!e10a.equals(e10b), "clone struct is deep");
+let e7u = new e7pb.example7();
+pb.Unmarshal(new Uint8Array(unknownBytes), e7u);
+e7unknown = new e7pb.example7();
+e7unknown.unknownFields = [new Uint8Array(unknownBytes)];
+assert(e7u.equals(e7unknown), "equals unknown fields");
+e7unknown.unknownFields = [];
+assert(!e7u.equals(e7unknown), "equals unknown fields differ");
+
+// Comments in the .proto source are generated as TSDoc blocks.
+let e5src = fs.readFileSync("./gen-src/example5_pb.ts", "utf8");
+assert(
+  e5src.includes("/**\n   * Tracks presence.\n   */\n  get aint32()"),
+  "trailing comment"
+);
+assert(
+  e5src.includes(" * Comment markers *\\/ and tags \\@like {\\@link this}"),
+  "comments escaped"
+);
+
+// Deprecated elements are tagged, and omit_deprecated leaves deprecated
+// fields out while still skipping them on the wire and in JSON.
+let e12src = fs.readFileSync("./gen-src/example12_pb.ts", "utf8");
+assert(
+  e12src.includes("/**\n   * @deprecated\n   */\n  export class Old ") &&
+    e12src.includes("/**\n     * @deprecated\n     */\n    STATE_OLD = 1,"),
+  "deprecated tag"
+);
+assert(
+  !/\b(dropped|old_?choice)\??:/i.test(e12src),
+  "deprecated not generated"
+);
+let e12 = new e12pb.example12();
+assert(!("dropped" in e12) && !("choice" in e12), "deprecated omitted");
+pb.Unmarshal(new Uint8Array([0x08, 0x01, 0x10, 0x02]), e12);
+assert(e12.kept == 1 && e12.unknownFields.length == 1, "deprecated decoded");
+assert(pb.Marshal(e12).join(",") == "8,1,16,2", "deprecated kept unknown");
+e12 = new e12pb.example12();
+pb.UnmarshalJSON('{"kept":3,"dropped":4,"oldChoice":"x"}', e12);
+assert(e12.kept == 3, "deprecated skipped in JSON");
+
+// Services export a descriptor of each method, which clients pass to the
+// ClientConn.
+const serviceDesc: pb.Grpc.ServiceDescriptor = e1pb.ExampleServiceDescriptor;
+assert(serviceDesc.typeName == "foo.bar.ExampleService", "service typeName");
+assert(
+  Object.keys(serviceDesc.methods).join(",") ==
+    "OneToTwo,ServerStream,ClientStream,Bidi",
+  "service methods"
+);
+const oneToTwo = e1pb.ExampleServiceDescriptor.methods.OneToTwo;
+assert(oneToTwo.path == "/foo.bar.ExampleService/OneToTwo", "method path");
+assert(oneToTwo.service == "foo.bar.ExampleService", "method service");
+assert(oneToTwo.name == "OneToTwo", "method name");
+assert(
+  oneToTwo.input === e1pb.example1 && oneToTwo.output === e1pb.example2,
+  "method types"
+);
+assert(oneToTwo.kind == pb.Grpc.MethodKind.Unary, "unary kind");
+assert(
+  pb.Grpc.methodDescriptor([{}, oneToTwo]) === oneToTwo &&
+    pb.Grpc.methodDescriptor([{}]) === undefined,
+  "descriptor among options"
+);
+assert(
+  oneToTwo.idempotency == pb.Grpc.IdempotencyLevel.NoSideEffects,
+  "idempotency level"
+);
+const bidiDesc = e1pb.ExampleServiceDescriptor.methods.Bidi;
+assert(bidiDesc.kind == pb.Grpc.MethodKind.BidiStreaming, "bidi kind");
+assert(
+  bidiDesc.idempotency == pb.Grpc.IdempotencyLevel.IdempotencyUnknown,
+  "default idempotency level"
+);
+
+// Generated clients call the ClientConn for unary and streaming methods,
+// passing the method's descriptor last. This one answers in process; each
+// response's aint32 counts the requests so far.
+class LoopbackConn implements pb.Grpc.ClientConn {
+  methods: string[] = [];
+
+  reply<Out extends pb.Message>(m: Out, n: number): Out {
+    pb.UnmarshalJSON(`{"aint32":${n}}`, m);
+    return m;
+  }
+
+  record(method: string, co: pb.Grpc.CallOption[]) {
+    const d = pb.Grpc.methodDescriptor(co);
+    assert(d !== undefined && d.path == method, "descriptor option");
+    this.methods.push(method);
+  }
+
+  async Invoke(
+    method: string,
+    _: pb.Message,
+    mout: pb.Message,
+    ...co: pb.Grpc.CallOption[]
+  ) {
+    this.record(method, co);
+    this.reply(mout, 1);
+  }
+
+  async *ServerStream<Out extends pb.Message>(
+    method: string,
+    _: pb.Message,
+    newOut: () => Out,
+    ...co: pb.Grpc.CallOption[]
+  ): AsyncIterable<Out> {
+    this.record(method, co);
+    for (let i = 1; i <= 3; i++) {
+      yield this.reply(newOut(), i);
+    }
+  }
+
+  async ClientStream<In extends pb.Message>(
+    method: string,
+    mins: AsyncIterable<In>,
+    mout: pb.Message,
+    ...co: pb.Grpc.CallOption[]
+  ) {
+    this.record(method, co);
+    let n = 0;
+    for await (const _ of mins) {
+      n++;
+    }
+    this.reply(mout, n);
+  }
+
+  BidiStream<In extends pb.Message, Out extends pb.Message>(
+    method: string,
+    newOut: () => Out,
+    ...co: pb.Grpc.CallOption[]
+  ): pb.Grpc.BidiStream<In, Out> {
+    this.record(method, co);
+    const conn = this;
+    const outs: Out[] = [];
+    return {
+      async send(_: In) {
+        outs.push(conn.reply(newOut(), outs.length + 1));
+      },
+      async closeSend() {},
+      async *[Symbol.asyncIterator]() {
+        yield* outs;
+      },
+    };
+  }
+}
+
+async function* requests(n: number): AsyncIterable<e1pb.example1> {
+  for (let i = 0; i < n; i++) {
+    yield example1();
+  }
+}
+
+// failure returns the code of the Grpc.GrpcError thrown by f, or OK if it
+// succeeds.
+async function failure(f: () => Promise<unknown>): Promise<pb.Grpc.Code> {
+  try {
+    await f();
+  } catch (e) {
+    return (e as pb.Grpc.GrpcError).grpc_code;
+  }
+  return pb.Grpc.Code.OK;
+}
+
+async function testClient(): Promise<void> {
+  const conn = new LoopbackConn();
+  const client = new e1pb.ExampleServiceClient(conn);
+  assert((await client.OneToTwo(example1())).aint32 == 1, "unary call");
+  const streamed: number[] = [];
+  for await (const m of client.ServerStream(example1())) {
+    streamed.push(m.aint32);
+  }
+  assert(streamed.join(",") == "1,2,3", "server streaming call");
+  const sum = await client.ClientStream(requests(4));
+  assert(sum.aint32 == 4, "client streaming call");
+  const bidi = client.Bidi();
+  await bidi.send(example1());
+  await bidi.send(example1());
+  await bidi.closeSend();
+  const replies: number[] = [];
+  for await (const m of bidi) {
+    replies.push(m.aint32);
+  }
+  assert(replies.join(",") == "1,2", "bidi streaming call");
+  assert(
+    conn.methods.join(",") ==
+      "/foo.bar.ExampleService/OneToTwo,/foo.bar.ExampleService/ServerStream," +
+        "/foo.bar.ExampleService/ClientStream,/foo.bar.ExampleService/Bidi",
+    "client method names"
+  );
+
+  // Connections may carry only unary calls.
+  const unary = new e1pb.ExampleServiceClient({
+    Invoke: conn.Invoke.bind(conn),
+  });
+  assert((await unary.OneToTwo(example1())).aint32 == 1, "unary only call");
+  for (const f of [
+    async () => unary.ServerStream(example1()),
+    () => unary.ClientStream(requests(1)),
+    async () => unary.Bidi(),
+  ]) {
+    assert((await failure(f)) == pb.Grpc.Code.Unimplemented, "no streaming");
+  }
+}
+
+// Generated dispatchers route encoded calls to a Server implementation.
+function negative(m: e1pb.example1): void {
+  if (m.aint32 < 0) {
+    throw new pb.Grpc.GrpcError(pb.Grpc.Code.InvalidArgument, "negative");
+  }
+}
+
+class ExampleServer implements e1pb.ExampleServiceServer {
+  async OneToTwo(min: e1pb.example1) {
+    negative(min);
+    return new e1pb.example2({ aint32: min.aint32 + 1 });
+  }
+
+  async *ServerStream(min: e1pb.example1) {
+    negative(min);
+    for (let i = 0; i < min.aint32; i++) {
+      yield new e1pb.example2({ aint32: i });
+    }
+  }
+
+  async ClientStream(mins: AsyncIterable<e1pb.example1>) {
+    let sum = 0;
+    for await (const m of mins) {
+      sum += m.aint32;
+    }
+    return new e1pb.example2({ aint32: sum });
+  }
+
+  async *Bidi(mins: AsyncIterable<e1pb.example1>) {
+    for await (const m of mins) {
+      yield new e1pb.example2({ aint32: m.aint32 * 2 });
+    }
+  }
+}
+
+async function* encoded(...ns: number[]): AsyncIterable<Uint8Array> {
+  for (const n of ns) {
+    yield pb.Marshal(new e1pb.example1({ aint32: n }));
+  }
+}
+
+async function decoded(bs: AsyncIterable<Uint8Array>): Promise<string> {
+  const out: number[] = [];
+  for await (const b of bs) {
+    const m = new e1pb.example2();
+    pb.Unmarshal(b, m);
+    out.push(m.aint32);
+  }
+  return out.join(",");
+}
+
+async function testServer(): Promise<void> {
+  const d = e1pb.ExampleServiceDispatcher(new ExampleServer());
+  const call = (method: string, ...ns: number[]) =>
+    decoded(d.dispatch("/foo.bar.ExampleService/" + method, encoded(...ns)));
+  assert((await call("OneToTwo", 1)) == "2", "unary dispatch");
+  assert((await call("ServerStream", 3)) == "0,1,2", "server dispatch");
+  assert((await call("ClientStream", 1, 2, 3)) == "6", "client dispatch");
+  assert((await call("Bidi", 1, 2)) == "2,4", "bidi dispatch");
+  assert(
+    (await failure(() => call("Missing", 1))) == pb.Grpc.Code.Unimplemented,
+    "unknown method"
+  );
+  // A varint field without its value.
+  const malformed = () => iterate(new Uint8Array([0x08]));
+  assert(
+    (await failure(() => decoded(d.dispatch(oneToTwo.path, malformed())))) ==
+      pb.Grpc.Code.InvalidArgument,
+    "malformed request"
+  );
+  assert(
+    (await failure(() => decoded(d.dispatch(bidiDesc.path, malformed())))) ==
+      pb.Grpc.Code.InvalidArgument,
+    "malformed stream request"
+  );
+  assert(
+    (await failure(() => call("OneToTwo", 1, 2))) == pb.Grpc.Code.Unimplemented,
+    "unary cardinality"
+  );
+}
+
+async function* iterate<T>(...ts: T[]): AsyncIterable<T> {
+  yield* ts;
+}
+
+// connectFetch answers Connect requests in process with the methods of a
+// dispatcher, sending streamed responses a byte at a time. It records the
+// URL and headers of each request in seen.
+function connectFetch(d: pb.Grpc.Dispatcher, seen: string[]): pb.Http.Fetch {
+  return async (url: string, init: RequestInit) => {
+    const headers = init.headers as { [name: string]: string };
+    const contentType = headers["Content-Type"];
+    seen.push(
+      [
+        url,
+        contentType,
+        headers["Connect-Protocol-Version"],
+        headers["Authorization"],
+      ].join(" ")
+    );
+    const m = d.methods.get(url.slice("http://connect.test".length));
+    if (m === undefined) {
+      return new Response("not found", { status: 404 });
+    }
+    const json = contentType.endsWith("json");
+    const toBinary = (b: Uint8Array) => {
+      if (!json) {
+        return b;
+      }
+      const min = new m.descriptor.input();
+      pb.UnmarshalJSON(new TextDecoder().decode(b), min);
+      return pb.Marshal(min);
+    };
+    const fromBinary = (b: Uint8Array) => {
+      if (!json) {
+        return b;
+      }
+      const mout = new m.descriptor.output();
+      pb.Unmarshal(b, mout);
+      return new TextEncoder().encode(pb.MarshalJSON(mout));
+    };
+    const body = init.body as Uint8Array;
+
+    if (!contentType.startsWith("application/connect+")) {
+      try {
+        const outs: Uint8Array[] = [];
+        for await (const b of m.call(iterate(toBinary(body)))) {
+          outs.push(fromBinary(b));
+        }
+        return new Response(outs[0], { status: 200 });
+      } catch (e) {
+        const err = e as pb.Grpc.GrpcError;
+        const j = {
+          code: pb.Connect.codeName(err.grpc_code),
+          message: err.grpc_message,
+        };
+        return new Response(JSON.stringify(j), { status: 400 });
+      }
+    }
+
+    async function* requests() {
+      for await (const e of pb.Connect.envelopes(iterate(body))) {
+        yield toBinary(e.data);
+      }
+    }
+    const frames: Uint8Array[] = [];
+    let end: pb.JsonObject = {};
+    try {
+      for await (const b of m.call(requests())) {
+        frames.push(pb.Connect.envelope(0, fromBinary(b)));
+      }
+    } catch (e) {
+      const err = e as pb.Grpc.GrpcError;
+      end = {
+        error: {
+          code: pb.Connect.codeName(err.grpc_code),
+          message: err.grpc_message,
+        },
+      };
+    }
+    const endData = new TextEncoder().encode(JSON.stringify(end));
+    frames.push(pb.Connect.envelope(pb.Connect.endStreamFlag, endData));
+    const bytes = pb.Internal.concatBytes(frames);
+    const stream = new ReadableStream<Uint8Array>({
+      start(c) {
+        for (const b of bytes) {
+          c.enqueue(new Uint8Array([b]));
+        }
+        c.close();
+      },
+    });
+    return new Response(stream, { status: 200 });
+  };
+}
+
+async function testConnect(): Promise<void> {
+  const d = e1pb.ExampleServiceDispatcher(new ExampleServer());
+  const min = (n: number) => new e1pb.example1({ aint32: n });
+  for (const json of [false, true]) {
+    const codec = json ? "json" : "proto";
+    const seen: string[] = [];
+    const url = "http://connect.test/";
+    const client = new e1pb.ExampleServiceConnectClient(url, {
+      fetch: connectFetch(d, seen),
+      json,
+      headers: { Authorization: "token" },
+    });
+    assert((await client.OneToTwo(min(1))).aint32 == 2, `${codec} unary`);
+    const streamed: number[] = [];
+    for await (const m of client.ServerStream(min(3))) {
+      streamed.push(m.aint32);
+    }
+    assert(streamed.join(",") == "0,1,2", `${codec} server streaming`);
+    assert(
+      seen[0] ==
+        "http://connect.test/foo.bar.ExampleService/OneToTwo " +
+          `application/${codec} 1 token`,
+      `${codec} unary request`
+    );
+    assert(
+      seen[1] ==
+        "http://connect.test/foo.bar.ExampleService/ServerStream " +
+          `application/connect+${codec} 1 token`,
+      `${codec} streaming request`
+    );
+
+    let err: pb.Grpc.GrpcError | undefined;
+    try {
+      await client.OneToTwo(min(-1));
+    } catch (e) {
+      err = e as pb.Grpc.GrpcError;
+    }
+    assert(
+      err !== undefined &&
+        err.grpc_code == pb.Grpc.Code.InvalidArgument &&
+        err.grpc_message == "negative",
+      `${codec} unary error`
+    );
+    const streamErr = await failure(async () => {
+      for await (const _ of client.ServerStream(min(-1))) {
+      }
+    });
+    assert(
+      streamErr == pb.Grpc.Code.InvalidArgument,
+      `${codec} end of stream error`
+    );
+  }
+
+  // Client and bidirectional streams need a streaming request body, which
+  // fetch doesn't support everywhere.
+  const connect = new e1pb.ExampleServiceConnectClient("http://x");
+  assert(!("ClientStream" in connect) && !("Bidi" in connect), "no streams");
+  const grpc = new e1pb.ExampleServiceClient(
+    new pb.Connect.Transport("http://x")
+  );
+  assert(
+    (await failure(() => grpc.ClientStream(iterate(min(1))))) ==
+      pb.Grpc.Code.Unimplemented,
+    "no client streams"
+  );
+
+  const unavailable = new e1pb.ExampleServiceConnectClient("http://x", {
+    fetch: async () => new Response("try later", { status: 503 }),
+  });
+  assert(
+    (await failure(() => unavailable.OneToTwo(min(1)))) ==
+      pb.Grpc.Code.Unavailable,
+    "HTTP status error"
+  );
+}
+
+// Generated Twirp clients call a Twirp.Handler serving the generated
+// handler in process.
+class Haberdasher implements e13pb.HaberdasherTwirpServer {
+  async MakeHat(req: e13pb.SizeRequest) {
+    if (req.inches <= 0) {
+      throw new pb.Twirp.TwirpError(
+        pb.Twirp.ErrorCode.InvalidArgument,
+        "inches must be positive",
+        { argument: "inches" }
+      );
+    }
+    return new e13pb.Hat({ size: req.inches, color: "red" });
+  }
+}
+
+async function testTwirp(): Promise<void> {
+  const handler = e13pb.HaberdasherTwirpHandler(new Haberdasher());
+  const seen: string[] = [];
+  const local: pb.Http.Fetch = (url: string, init: RequestInit) => {
+    const headers = init.headers as { [name: string]: string };
+    seen.push(`${url} ${headers["Content-Type"]}`);
+    return handler.handle(new Request(url, init));
+  };
+  const size = (inches: number) => new e13pb.SizeRequest({ inches });
+
+  for (const json of [false, true]) {
+    const codec = json ? "json" : "protobuf";
+    const client = new e13pb.HaberdasherTwirpClient("http://twirp.test/", {
+      fetch: local,
+      json,
+    });
+    const hat = await client.MakeHat(size(12));
+    assert(hat.size == 12 && hat.color == "red", `twirp ${codec} call`);
+    assert(
+      seen[seen.length - 1] ==
+        "http://twirp.test/twirp/foo.twirp.Haberdasher/MakeHat " +
+          `application/${codec}`,
+      `twirp ${codec} request`
+    );
+    let err: pb.Twirp.TwirpError | undefined;
+    try {
+      await client.MakeHat(size(0));
+    } catch (e) {
+      err = e as pb.Twirp.TwirpError;
+    }
+    assert(
+      err instanceof pb.Twirp.TwirpError &&
+        err.code == pb.Twirp.ErrorCode.InvalidArgument &&
+        err.msg == "inches must be positive" &&
+        err.meta.argument == "inches",
+      `twirp ${codec} error`
+    );
+  }
+
+  const post = (path: string, contentType: string, body: string) =>
+    handler.handle(
+      new Request("http://twirp.test" + path, {
+        method: "POST",
+        headers: { "Content-Type": contentType },
+        body,
+      })
+    );
+  const errorCode = async (res: Promise<Response>) => {
+    const r = await res;
+    return `${r.status} ${JSON.parse(await r.text()).code}`;
+  };
+  const makeHat = "/twirp/foo.twirp.Haberdasher/MakeHat";
+  const missing = "/twirp/foo.twirp.Haberdasher/Missing";
+  assert(
+    (await errorCode(post(missing, "application/json", "{}"))) ==
+      "404 bad_route",
+    "twirp unknown method"
+  );
+  assert(
+    (await errorCode(post(makeHat, "text/plain", "{}"))) == "404 bad_route",
+    "twirp content type"
+  );
+  assert(
+    (await errorCode(post(makeHat, "application/json", "{"))) ==
+      "400 malformed",
+    "twirp malformed request"
+  );
+  const res = await post(
+    makeHat,
+    "application/json; charset=utf-8",
+    '{"inches":3}'
+  );
+  assert(
+    res.status == 200 && (await res.text()) == '{"size":3,"color":"red"}',
+    "twirp JSON response"
+  );
+
+  const prefixed = e13pb.HaberdasherTwirpHandler(new Haberdasher(), {
+    prefix: "/api",
+  });
+  const url = "http://twirp.test";
+  const prefixedClient = new e13pb.HaberdasherTwirpClient(url, {
+    prefix: "/api",
+    fetch: (url, init) => prefixed.handle(new Request(url, init)),
+  });
+  assert((await prefixedClient.MakeHat(size(7))).size == 7, "twirp prefix");
+
+  const proxied = new e13pb.HaberdasherTwirpClient(url, {
+    fetch: async () => new Response("bad gateway", { status: 502 }),
+  });
+  let proxyErr: pb.Twirp.TwirpError | undefined;
+  try {
+    await proxied.MakeHat(size(1));
+  } catch (e) {
+    proxyErr = e as pb.Twirp.TwirpError;
+  }
+  assert(
+    proxyErr !== undefined &&
+      proxyErr.code == pb.Twirp.ErrorCode.Unavailable &&
+      proxyErr.meta.body == "bad gateway",
+    "twirp intermediary error"
+  );
+}
+
+// Generated REST clients expand google.api.http rules into requests.
+async function testRest(): Promise<void> {
+  const requests: string[] = [];
+  let reply = "{}";
+  let status = 200;
+  const client = new e14pb.LibraryRestClient("http://rest.test/", {
+    fetch: async (url: string, init: RequestInit) => {
+      requests.push(`${init.method} ${url} ${init.body}`);
+      return new Response(reply, { status });
+    },
+  });
+  const sent = () => requests[requests.length - 1];
+
+  reply = '{"name":"shelves/1/books/2","title":"Dune","pageCount":412}';
+  const book = await client.GetBook(
+    new e14pb.GetBookRequest({
+      name: "shelves/a b/books/2",
+      include_reviews: true,
+      fields: ["title", "page count"],
+      shelf: { id: fromInt(7) },
+    })
+  );
+  assert(book.title == "Dune" && book.page_count == 412, "rest response");
+  assert(
+    sent() ==
+      "GET http://rest.test/v1/shelves/a%20b/books/2?includeReviews=true&" +
+        "fields=title&fields=page%20count&shelf.id=7 undefined",
+    "rest path and query"
+  );
+
+  reply = '"Dune"';
+  const title = await client.GetBookTitle(
+    new e14pb.GetBookRequest({ name: "shelves/1/books/2" })
+  );
+  assert(title.title == "Dune", "rest response_body");
+
+  reply = "{}";
+  await client.CreateBook(
+    new e14pb.CreateBookRequest({
+      shelf: { id: fromInt(7) },
+      book: { title: "Emma" },
+      book_id: "emma",
+    })
+  );
+  assert(
+    sent() ==
+      'POST http://rest.test/v1/shelves/7/books?bookId=emma {"title":"Emma"}',
+    "rest body field"
+  );
+
+  await client.UpdateBook(
+    new e14pb.UpdateBookRequest({
+      book: { name: "shelves/1/books/2", title: "Persuasion" },
+    })
+  );
+  assert(
+    sent() ==
+      "PATCH http://rest.test/v1/shelves/1/books/2 " +
+        '{"book":{"title":"Persuasion"}}',
+    "rest body *"
+  );
+
+  await client.DeleteBook(
+    new e14pb.DeleteBookRequest({ name: "shelves/1/books/2" })
+  );
+  assert(
+    sent() == "DELETE http://rest.test/v1/shelves/1/books/2 undefined",
+    "rest delete"
+  );
+
+  await client.PublishBook(
+    new e14pb.DeleteBookRequest({ name: "shelves/1/books/2" })
+  );
+  assert(
+    sent() == "POST http://rest.test/v1/shelves/1/books/2:publish {}",
+    "rest custom pattern"
+  );
+  assert(
+    !("Unmapped" in client) && requests.length == 6,
+    "rest unmapped method"
+  );
+
+  let err: unknown;
+  try {
+    await client.CreateBook(new e14pb.CreateBookRequest());
+  } catch (e) {
+    err = e;
+  }
+  assert(err instanceof pb.ProtobufError, "rest unset path field");
+
+  reply = '{"code":5,"message":"no such book","details":[]}';
+  status = 404;
+  let grpcErr: pb.Grpc.GrpcError | undefined;
+  try {
+    await client.DeleteBook(new e14pb.DeleteBookRequest({ name: "x" }));
+  } catch (e) {
+    grpcErr = e as pb.Grpc.GrpcError;
+  }
+  assert(
+    grpcErr !== undefined &&
+      grpcErr.grpc_code == pb.Grpc.Code.NotFound &&
+      grpcErr.grpc_message == "no such book",
+    "rest status error"
+  );
+  reply = "unavailable";
+  status = 503;
+  assert(
+    (await failure(() => client.DeleteBook(new e14pb.DeleteBookRequest()))) ==
+      pb.Grpc.Code.Unavailable,
+    "rest HTTP status error"
+  );
+}
+
+testClient()
+  .then(testServer)
+  .then(testConnect)
+  .then(testTwirp)
+  .then(testRest)
+  .catch((e) => {
+    console.error(e);
+    process.exit(1);
+  });